@@ -28,3 +28,173 @@ export const deleteJobApplication = Service.method.deleteJobApplication;
  * @generated from rpc api.v1.Service.UpdateJobApplicationStatus
  */
 export const updateJobApplicationStatus = Service.method.updateJobApplicationStatus;
+
+/**
+ * @generated from rpc api.v1.Service.GetJobApplicationHistory
+ */
+export const getJobApplicationHistory = Service.method.getJobApplicationHistory;
+
+/**
+ * @generated from rpc api.v1.Service.SearchJobApplications
+ */
+export const searchJobApplications = Service.method.searchJobApplications;
+
+/**
+ * @generated from rpc api.v1.Service.GetUserSettings
+ */
+export const getUserSettings = Service.method.getUserSettings;
+
+/**
+ * @generated from rpc api.v1.Service.UpdateUserSettings
+ */
+export const updateUserSettings = Service.method.updateUserSettings;
+
+/**
+ * @generated from rpc api.v1.Service.ListDeletedJobApplications
+ */
+export const listDeletedJobApplications = Service.method.listDeletedJobApplications;
+
+/**
+ * @generated from rpc api.v1.Service.RestoreJobApplication
+ */
+export const restoreJobApplication = Service.method.restoreJobApplication;
+
+/**
+ * @generated from rpc api.v1.Service.CreateInterview
+ */
+export const createInterview = Service.method.createInterview;
+
+/**
+ * @generated from rpc api.v1.Service.ListInterviews
+ */
+export const listInterviews = Service.method.listInterviews;
+
+/**
+ * @generated from rpc api.v1.Service.UpdateInterview
+ */
+export const updateInterview = Service.method.updateInterview;
+
+/**
+ * @generated from rpc api.v1.Service.DeleteInterview
+ */
+export const deleteInterview = Service.method.deleteInterview;
+
+/**
+ * @generated from rpc api.v1.Service.ListUpcomingInterviews
+ */
+export const listUpcomingInterviews = Service.method.listUpcomingInterviews;
+
+/**
+ * @generated from rpc api.v1.Service.UploadDocument
+ */
+export const uploadDocument = Service.method.uploadDocument;
+
+/**
+ * @generated from rpc api.v1.Service.GetDocument
+ */
+export const getDocument = Service.method.getDocument;
+
+/**
+ * @generated from rpc api.v1.Service.DownloadDocument
+ */
+export const downloadDocument = Service.method.downloadDocument;
+
+/**
+ * @generated from rpc api.v1.Service.DeleteDocument
+ */
+export const deleteDocument = Service.method.deleteDocument;
+
+/**
+ * @generated from rpc api.v1.Service.UpdateDocument
+ */
+export const updateDocument = Service.method.updateDocument;
+
+/**
+ * @generated from rpc api.v1.Service.ListDocuments
+ */
+export const listDocuments = Service.method.listDocuments;
+
+/**
+ * @generated from rpc api.v1.Service.SearchDocuments
+ */
+export const searchDocuments = Service.method.searchDocuments;
+
+/**
+ * @generated from rpc api.v1.Service.ScoreJobApplication
+ */
+export const scoreJobApplication = Service.method.scoreJobApplication;
+
+/**
+ * @generated from rpc api.v1.Service.ImportJobApplications
+ */
+export const importJobApplications = Service.method.importJobApplications;
+
+/**
+ * @generated from rpc api.v1.Service.GetPipelineStats
+ */
+export const getPipelineStats = Service.method.getPipelineStats;
+
+/**
+ * @generated from rpc api.v1.Service.ListDueFollowUps
+ */
+export const listDueFollowUps = Service.method.listDueFollowUps;
+
+/**
+ * @generated from rpc api.v1.Service.Unsubscribe
+ */
+export const unsubscribe = Service.method.unsubscribe;
+
+/**
+ * @generated from rpc api.v1.Service.CreateCompany
+ */
+export const createCompany = Service.method.createCompany;
+
+/**
+ * @generated from rpc api.v1.Service.UpdateCompany
+ */
+export const updateCompany = Service.method.updateCompany;
+
+/**
+ * @generated from rpc api.v1.Service.ListCompanies
+ */
+export const listCompanies = Service.method.listCompanies;
+
+/**
+ * @generated from rpc api.v1.Service.CreateContact
+ */
+export const createContact = Service.method.createContact;
+
+/**
+ * @generated from rpc api.v1.Service.GetContact
+ */
+export const getContact = Service.method.getContact;
+
+/**
+ * @generated from rpc api.v1.Service.UpdateContact
+ */
+export const updateContact = Service.method.updateContact;
+
+/**
+ * @generated from rpc api.v1.Service.DeleteContact
+ */
+export const deleteContact = Service.method.deleteContact;
+
+/**
+ * @generated from rpc api.v1.Service.ListContacts
+ */
+export const listContacts = Service.method.listContacts;
+
+/**
+ * @generated from rpc api.v1.Service.LinkContact
+ */
+export const linkContact = Service.method.linkContact;
+
+/**
+ * @generated from rpc api.v1.Service.UnlinkContact
+ */
+export const unlinkContact = Service.method.unlinkContact;
+
+/**
+ * @generated from rpc api.v1.Service.GetContactTimeline
+ */
+export const getContactTimeline = Service.method.getContactTimeline;
@@ -14,8 +14,10 @@ import {
   messageDesc,
   serviceDesc,
 } from "@bufbuild/protobuf/codegenv2";
-import type { Timestamp } from "@bufbuild/protobuf/wkt";
+import type { Duration, FieldMask, Timestamp } from "@bufbuild/protobuf/wkt";
 import {
+  file_google_protobuf_duration,
+  file_google_protobuf_field_mask,
   file_google_protobuf_timestamp,
   file_google_protobuf_wrappers,
 } from "@bufbuild/protobuf/wkt";
@@ -27,8 +29,13 @@ import type { Message } from "@bufbuild/protobuf";
 export const file_api_v1_api: GenFile =
   /*@__PURE__*/
   fileDesc(
-    "ChBhcGkvdjEvYXBpLnByb3RvEgZhcGkudjEi6wIKG0NyZWF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBIPCgdjb21wYW55GAEgASgJEg0KBXRpdGxlGAIgASgJEjEKC2Rlc2NyaXB0aW9uGAMgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBW5vdGVzGAQgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEigKAmN2GAUgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjIKDGNvdmVyX2xldHRlchgGIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIuCgphcHBsaWVkX29uGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCgZzdGF0dXMYCCABKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSEAoIcG9zaXRpb24YCSABKAkiTwocQ3JlYXRlSm9iQXBwbGljYXRpb25SZXNwb25zZRIvCg9qb2JfYXBwbGljYXRpb24YASABKAsyFi5hcGkudjEuSm9iQXBwbGljYXRpb24iHAoaTGlzdEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QiTwobTGlzdEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEjAKEGpvYl9hcHBsaWNhdGlvbnMYASADKAsyFi5hcGkudjEuSm9iQXBwbGljYXRpb24i9wIKG1VwZGF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBIKCgJpZBgBIAEoCRIPCgdjb21wYW55GAIgASgJEg0KBXRpdGxlGAMgASgJEjEKC2Rlc2NyaXB0aW9uGAQgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBW5vdGVzGAUgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEigKAmN2GAYgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjIKDGNvdmVyX2xldHRlchgHIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIsCgZzdGF0dXMYCCABKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSLgoKYXBwbGllZF9vbhgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIcG9zaXRpb24YCiABKAkiTwocVXBkYXRlSm9iQXBwbGljYXRpb25SZXNwb25zZRIvCg9qb2JfYXBwbGljYXRpb24YASABKAsyFi5hcGkudjEuSm9iQXBwbGljYXRpb24iKQobRGVsZXRlSm9iQXBwbGljYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIh4KHERlbGV0ZUpvYkFwcGxpY2F0aW9uUmVzcG9uc2UiygMKDkpvYkFwcGxpY2F0aW9uEgoKAmlkGAEgASgJEg8KB2NvbXBhbnkYAiABKAkSDQoFdGl0bGUYAyABKAkSLAoGc3RhdHVzGAQgASgOMhwuYXBpLnYxLkpvYkFwcGxpY2F0aW9uU3RhdHVzEjEKC2Rlc2NyaXB0aW9uGAUgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBW5vdGVzGAYgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEigKAmN2GAcgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjIKDGNvdmVyX2xldHRlchgIIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIuCgphcHBsaWVkX29uGAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgpjcmVhdGVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIQCghwb3NpdGlvbhgMIAEoCSKNAQohVXBkYXRlSm9iQXBwbGljYXRpb25TdGF0dXNSZXF1ZXN0EgoKAmlkGAEgASgJEiwKBnN0YXR1cxgCIAEoDjIcLmFwaS52MS5Kb2JBcHBsaWNhdGlvblN0YXR1cxIuCghwb3NpdGlvbhgDIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZSJVCiJVcGRhdGVKb2JBcHBsaWNhdGlvblN0YXR1c1Jlc3BvbnNlEi8KD2pvYl9hcHBsaWNhdGlvbhgBIAEoCzIWLmFwaS52MS5Kb2JBcHBsaWNhdGlvbirAAgoUSm9iQXBwbGljYXRpb25TdGF0dXMSJgoiSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19VTlNQRUNJRklFRBAAEiIKHkpPQl9BUFBMSUNBVElPTl9TVEFUVVNfQVBQTElFRBABEiQKIEpPQl9BUFBMSUNBVElPTl9TVEFUVVNfU0NSRUVOSU5HEAISJAogSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19JTlRFUlZJRVcQAxIgChxKT0JfQVBQTElDQVRJT05fU1RBVFVTX09GRkVSEAQSIwofSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19SRUpFQ1RFRBAFEiQKIEpPQl9BUFBMSUNBVElPTl9TVEFUVVNfV0lUSERSQVdOEAYSIwofSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19BQ0NFUFRFRBAHMocECgdTZXJ2aWNlEmEKFENyZWF0ZUpvYkFwcGxpY2F0aW9uEiMuYXBpLnYxLkNyZWF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBokLmFwaS52MS5DcmVhdGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEl4KE0xpc3RKb2JBcHBsaWNhdGlvbnMSIi5hcGkudjEuTGlzdEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QaIy5hcGkudjEuTGlzdEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEmEKFFVwZGF0ZUpvYkFwcGxpY2F0aW9uEiMuYXBpLnYxLlVwZGF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBokLmFwaS52MS5VcGRhdGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEmEKFERlbGV0ZUpvYkFwcGxpY2F0aW9uEiMuYXBpLnYxLkRlbGV0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBokLmFwaS52MS5EZWxldGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEnMKGlVwZGF0ZUpvYkFwcGxpY2F0aW9uU3RhdHVzEikuYXBpLnYxLlVwZGF0ZUpvYkFwcGxpY2F0aW9uU3RhdHVzUmVxdWVzdBoqLmFwaS52MS5VcGRhdGVKb2JBcHBsaWNhdGlvblN0YXR1c1Jlc3BvbnNlQhNaEWtpc2VraS9hcGkvdjE7YXBpYgZwcm90bzM",
-    [file_google_protobuf_timestamp, file_google_protobuf_wrappers]
+    "ChBhcGkvdjEvYXBpLnByb3RvEgZhcGkudjEixQQKG0NyZWF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBIPCgdjb21wYW55GAEgASgJEg0KBXRpdGxlGAIgASgJEjEKC2Rlc2NyaXB0aW9uGAMgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBW5vdGVzGAQgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEigKAmN2GAUgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjIKDGNvdmVyX2xldHRlchgGIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIuCgphcHBsaWVkX29uGAcgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIsCgZzdGF0dXMYCCABKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSEAoIcG9zaXRpb24YCSABKAkSNAoOY3ZfZG9jdW1lbnRfaWQYCiABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSPgoYY292ZXJfbGV0dGVyX2RvY3VtZW50X2lkGAsgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjAKDGZvbGxvd191cF9vbhgMIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoKY29tcGFueV9pZBgNIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZSJPChxDcmVhdGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEi8KD2pvYl9hcHBsaWNhdGlvbhgBIAEoCzIWLmFwaS52MS5Kb2JBcHBsaWNhdGlvbiK2AQoaTGlzdEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkSLAoGZmlsdGVyGAMgASgLMhwuYXBpLnYxLkpvYkFwcGxpY2F0aW9uRmlsdGVyEi8KCHNvcnRfa2V5GAQgASgOMh0uYXBpLnYxLkpvYkFwcGxpY2F0aW9uU29ydEtleRISCgpkZXNjZW5kaW5nGAUgASgIImgKG0xpc3RKb2JBcHBsaWNhdGlvbnNSZXNwb25zZRIwChBqb2JfYXBwbGljYXRpb25zGAEgAygLMhYuYXBpLnYxLkpvYkFwcGxpY2F0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSLtAgoUSm9iQXBwbGljYXRpb25GaWx0ZXISLgoIc3RhdHVzZXMYASADKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSDwoHY29tcGFueRgCIAEoCRIzCg9hcHBsaWVkX29uX2Zyb20YAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWFwcGxpZWRfb25fdG8YBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEioKBmhhc19jdhgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5Cb29sVmFsdWUSNAoQaGFzX2NvdmVyX2xldHRlchgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5Cb29sVmFsdWUSFgoOY3ZfZG9jdW1lbnRfaWQYByABKAkSIAoYY292ZXJfbGV0dGVyX2RvY3VtZW50X2lkGAggASgJEhAKCGN2X3F1ZXJ5GAkgASgJIpMFChtVcGRhdGVKb2JBcHBsaWNhdGlvblJlcXVlc3QSCgoCaWQYASABKAkSDwoHY29tcGFueRgCIAEoCRINCgV0aXRsZRgDIAEoCRIxCgtkZXNjcmlwdGlvbhgEIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIrCgVub3RlcxgFIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIoCgJjdhgGIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIyCgxjb3Zlcl9sZXR0ZXIYByABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSLAoGc3RhdHVzGAggASgOMhwuYXBpLnYxLkpvYkFwcGxpY2F0aW9uU3RhdHVzEi4KCmFwcGxpZWRfb24YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCHBvc2l0aW9uGAogASgJEg8KB3ZlcnNpb24YCyABKAMSLwoLdXBkYXRlX21hc2sYDCABKAsyGi5nb29nbGUucHJvdG9idWYuRmllbGRNYXNrEjQKDmN2X2RvY3VtZW50X2lkGA0gASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEj4KGGNvdmVyX2xldHRlcl9kb2N1bWVudF9pZBgOIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIwCgxmb2xsb3dfdXBfb24YDyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKCmNvbXBhbnlfaWQYECABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUiTwocVXBkYXRlSm9iQXBwbGljYXRpb25SZXNwb25zZRIvCg9qb2JfYXBwbGljYXRpb24YASABKAsyFi5hcGkudjEuSm9iQXBwbGljYXRpb24iOgobRGVsZXRlSm9iQXBwbGljYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJEg8KB3ZlcnNpb24YAiABKAMiHgocRGVsZXRlSm9iQXBwbGljYXRpb25SZXNwb25zZSKBBwoOSm9iQXBwbGljYXRpb24SCgoCaWQYASABKAkSDwoHY29tcGFueRgCIAEoCRINCgV0aXRsZRgDIAEoCRIsCgZzdGF0dXMYBCABKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSMQoLZGVzY3JpcHRpb24YBSABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSKwoFbm90ZXMYBiABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSKAoCY3YYByABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSMgoMY292ZXJfbGV0dGVyGAggASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEi4KCmFwcGxpZWRfb24YCSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhAKCHBvc2l0aW9uGAwgASgJEg8KB3ZlcnNpb24YDSABKAMSLgoKZGVsZXRlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASNAoOY3ZfZG9jdW1lbnRfaWQYDyABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSPgoYY292ZXJfbGV0dGVyX2RvY3VtZW50X2lkGBAgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjAKC21hdGNoX3Njb3JlGBEgASgLMhsuZ29vZ2xlLnByb3RvYnVmLkludDMyVmFsdWUSMQoLZXh0ZXJuYWxfaWQYEiABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSNQoRc3RhdHVzX2NoYW5nZWRfYXQYEyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjAKDGZvbGxvd191cF9vbhgUIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMAoKY29tcGFueV9pZBgVIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZSLDAQohVXBkYXRlSm9iQXBwbGljYXRpb25TdGF0dXNSZXF1ZXN0EgoKAmlkGAEgASgJEiwKBnN0YXR1cxgCIAEoDjIcLmFwaS52MS5Kb2JBcHBsaWNhdGlvblN0YXR1cxIuCghwb3NpdGlvbhgDIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIQCghhZnRlcl9pZBgEIAEoCRIRCgliZWZvcmVfaWQYBSABKAkSDwoHdmVyc2lvbhgGIAEoAyJVCiJVcGRhdGVKb2JBcHBsaWNhdGlvblN0YXR1c1Jlc3BvbnNlEi8KD2pvYl9hcHBsaWNhdGlvbhgBIAEoCzIWLmFwaS52MS5Kb2JBcHBsaWNhdGlvbiItCh9HZXRKb2JBcHBsaWNhdGlvbkhpc3RvcnlSZXF1ZXN0EgoKAmlkGAEgASgJIk8KIEdldEpvYkFwcGxpY2F0aW9uSGlzdG9yeVJlc3BvbnNlEisKBmV2ZW50cxgBIAMoCzIbLmFwaS52MS5Kb2JBcHBsaWNhdGlvbkV2ZW50IowBChlKb2JBcHBsaWNhdGlvbkZpZWxkQ2hhbmdlEg0KBWZpZWxkGAEgASgJEi8KCW9sZF92YWx1ZRgCIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIvCgluZXdfdmFsdWUYAyABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUi4QEKE0pvYkFwcGxpY2F0aW9uRXZlbnQSCgoCaWQYASABKAkSGgoSam9iX2FwcGxpY2F0aW9uX2lkGAIgASgJEg8KB3VzZXJfaWQYAyABKAkSLQoEdHlwZRgEIAEoDjIfLmFwaS52MS5Kb2JBcHBsaWNhdGlvbkV2ZW50VHlwZRIyCgdjaGFuZ2VzGAUgAygLMiEuYXBpLnYxLkpvYkFwcGxpY2F0aW9uRmllbGRDaGFuZ2USLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiVAocU2VhcmNoSm9iQXBwbGljYXRpb25zUmVxdWVzdBINCgVxdWVyeRgBIAEoCRIRCglwYWdlX3NpemUYAiABKAUSEgoKcGFnZV90b2tlbhgDIAEoCSJtCh1TZWFyY2hKb2JBcHBsaWNhdGlvbnNSZXNwb25zZRIzCgdyZXN1bHRzGAEgAygLMiIuYXBpLnYxLkpvYkFwcGxpY2F0aW9uU2VhcmNoUmVzdWx0EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSKSAQoaSm9iQXBwbGljYXRpb25TZWFyY2hSZXN1bHQSLwoPam9iX2FwcGxpY2F0aW9uGAEgASgLMhYuYXBpLnYxLkpvYkFwcGxpY2F0aW9uEgwKBHJhbmsYAiABKAESNQoIc25pcHBldHMYAyADKAsyIy5hcGkudjEuSm9iQXBwbGljYXRpb25TZWFyY2hTbmlwcGV0IjoKG0pvYkFwcGxpY2F0aW9uU2VhcmNoU25pcHBldBINCgVmaWVsZBgBIAEoCRIMCgR0ZXh0GAIgASgJIr0BCgxVc2VyU2V0dGluZ3MSIQoZc3RyaWN0X3N0YXR1c190cmFuc2l0aW9ucxgBIAEoCBIsCg5naG9zdGluZ19ydWxlcxgCIAMoCzIULmFwaS52MS5HaG9zdGluZ1J1bGUSHgoWYXV0b19yZWplY3RfYWZ0ZXJfZGF5cxgDIAEoBRIUCgxlbWFpbF9kaWdlc3QYBCABKAgSFwoPZW1haWxfcmVtaW5kZXJzGAUgASgIEg0KBWVtYWlsGAYgASgJIlAKDEdob3N0aW5nUnVsZRIsCgZzdGF0dXMYASABKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSEgoKYWZ0ZXJfZGF5cxgCIAEoBSIYChZHZXRVc2VyU2V0dGluZ3NSZXF1ZXN0IkEKF0dldFVzZXJTZXR0aW5nc1Jlc3BvbnNlEiYKCHNldHRpbmdzGAEgASgLMhQuYXBpLnYxLlVzZXJTZXR0aW5ncyJ0ChlVcGRhdGVVc2VyU2V0dGluZ3NSZXF1ZXN0EiYKCHNldHRpbmdzGAEgASgLMhQuYXBpLnYxLlVzZXJTZXR0aW5ncxIvCgt1cGRhdGVfbWFzaxgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5GaWVsZE1hc2siRAoaVXBkYXRlVXNlclNldHRpbmdzUmVzcG9uc2USJgoIc2V0dGluZ3MYASABKAsyFC5hcGkudjEuVXNlclNldHRpbmdzIkoKIUxpc3REZWxldGVkSm9iQXBwbGljYXRpb25zUmVxdWVzdBIRCglwYWdlX3NpemUYASABKAUSEgoKcGFnZV90b2tlbhgCIAEoCSJvCiJMaXN0RGVsZXRlZEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEjAKEGpvYl9hcHBsaWNhdGlvbnMYASADKAsyFi5hcGkudjEuSm9iQXBwbGljYXRpb24SFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIjsKHFJlc3RvcmVKb2JBcHBsaWNhdGlvblJlcXVlc3QSCgoCaWQYASABKAkSDwoHdmVyc2lvbhgCIAEoAyJQCh1SZXN0b3JlSm9iQXBwbGljYXRpb25SZXNwb25zZRIvCg9qb2JfYXBwbGljYXRpb24YASABKAsyFi5hcGkudjEuSm9iQXBwbGljYXRpb24ikAMKCUludGVydmlldxIKCgJpZBgBIAEoCRIaChJqb2JfYXBwbGljYXRpb25faWQYAiABKAkSDQoFcm91bmQYAyABKAkSMAoMc2NoZWR1bGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIRCgl0aW1lX3pvbmUYBSABKAkSKwoIZHVyYXRpb24YBiABKAsyGS5nb29nbGUucHJvdG9idWYuRHVyYXRpb24SFAoMaW50ZXJ2aWV3ZXJzGAcgAygJEicKBmZvcm1hdBgIIAEoDjIXLmFwaS52MS5JbnRlcnZpZXdGb3JtYXQSEAoIbG9jYXRpb24YCSABKAkSKQoHb3V0Y29tZRgKIAEoDjIYLmFwaS52MS5JbnRlcnZpZXdPdXRjb21lEi4KCmNyZWF0ZWRfYXQYCyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi4KCnVwZGF0ZWRfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIoYCChZDcmVhdGVJbnRlcnZpZXdSZXF1ZXN0EhoKEmpvYl9hcHBsaWNhdGlvbl9pZBgBIAEoCRINCgVyb3VuZBgCIAEoCRIwCgxzY2hlZHVsZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXRpbWVfem9uZRgEIAEoCRIrCghkdXJhdGlvbhgFIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIUCgxpbnRlcnZpZXdlcnMYBiADKAkSJwoGZm9ybWF0GAcgASgOMhcuYXBpLnYxLkludGVydmlld0Zvcm1hdBIQCghsb2NhdGlvbhgIIAEoCSI/ChdDcmVhdGVJbnRlcnZpZXdSZXNwb25zZRIkCglpbnRlcnZpZXcYASABKAsyES5hcGkudjEuSW50ZXJ2aWV3IjMKFUxpc3RJbnRlcnZpZXdzUmVxdWVzdBIaChJqb2JfYXBwbGljYXRpb25faWQYASABKAkiPwoWTGlzdEludGVydmlld3NSZXNwb25zZRIlCgppbnRlcnZpZXdzGAEgAygLMhEuYXBpLnYxLkludGVydmlldyKhAgoWVXBkYXRlSW50ZXJ2aWV3UmVxdWVzdBIKCgJpZBgBIAEoCRINCgVyb3VuZBgCIAEoCRIwCgxzY2hlZHVsZWRfYXQYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEhEKCXRpbWVfem9uZRgEIAEoCRIrCghkdXJhdGlvbhgFIAEoCzIZLmdvb2dsZS5wcm90b2J1Zi5EdXJhdGlvbhIUCgxpbnRlcnZpZXdlcnMYBiADKAkSJwoGZm9ybWF0GAcgASgOMhcuYXBpLnYxLkludGVydmlld0Zvcm1hdBIQCghsb2NhdGlvbhgIIAEoCRIpCgdvdXRjb21lGAkgASgOMhguYXBpLnYxLkludGVydmlld091dGNvbWUiPwoXVXBkYXRlSW50ZXJ2aWV3UmVzcG9uc2USJAoJaW50ZXJ2aWV3GAEgASgLMhEuYXBpLnYxLkludGVydmlldyIkChZEZWxldGVJbnRlcnZpZXdSZXF1ZXN0EgoKAmlkGAEgASgJIhkKF0RlbGV0ZUludGVydmlld1Jlc3BvbnNlIkYKHUxpc3RVcGNvbWluZ0ludGVydmlld3NSZXF1ZXN0EhEKCXBhZ2Vfc2l6ZRgBIAEoBRISCgpwYWdlX3Rva2VuGAIgASgJImgKHkxpc3RVcGNvbWluZ0ludGVydmlld3NSZXNwb25zZRItCgppbnRlcnZpZXdzGAEgAygLMhkuYXBpLnYxLlVwY29taW5nSW50ZXJ2aWV3EhcKD25leHRfcGFnZV90b2tlbhgCIAEoCSJqChFVcGNvbWluZ0ludGVydmlldxIkCglpbnRlcnZpZXcYASABKAsyES5hcGkudjEuSW50ZXJ2aWV3Ei8KD2pvYl9hcHBsaWNhdGlvbhgCIAEoCzIWLmFwaS52MS5Kb2JBcHBsaWNhdGlvbiItChtXYXRjaEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QSDgoGY3Vyc29yGAEgASgJIp4BChxXYXRjaEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEioKBWV2ZW50GAEgASgLMhsuYXBpLnYxLkpvYkFwcGxpY2F0aW9uRXZlbnQSLwoPam9iX2FwcGxpY2F0aW9uGAIgASgLMhYuYXBpLnYxLkpvYkFwcGxpY2F0aW9uEg4KBmN1cnNvchgDIAEoCRIRCgloZWFydGJlYXQYBCABKAgiwQEKCERvY3VtZW50EgoKAmlkGAEgASgJEiIKBGtpbmQYAiABKA4yFC5hcGkudjEuRG9jdW1lbnRLaW5kEgwKBG5hbWUYAyABKAkSFAoMY29udGVudF90eXBlGAQgASgJEgwKBHNpemUYBSABKAMSLgoKY3JlYXRlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGFiZWwYByABKAkSFAoMdGV4dF9wcmV2aWV3GAggASgJIlAKD0RvY3VtZW50U3VtbWFyeRIiCghkb2N1bWVudBgBIAEoCzIQLmFwaS52MS5Eb2N1bWVudBIZChFhcHBsaWNhdGlvbl9jb3VudBgCIAEoBSJ/ChVVcGxvYWREb2N1bWVudFJlcXVlc3QSIgoEa2luZBgBIAEoDjIULmFwaS52MS5Eb2N1bWVudEtpbmQSDAoEbmFtZRgCIAEoCRIUCgxjb250ZW50X3R5cGUYAyABKAkSDwoHY29udGVudBgEIAEoDBINCgVsYWJlbBgFIAEoCSI8ChZVcGxvYWREb2N1bWVudFJlc3BvbnNlEiIKCGRvY3VtZW50GAEgASgLMhAuYXBpLnYxLkRvY3VtZW50IiAKEkdldERvY3VtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSI5ChNHZXREb2N1bWVudFJlc3BvbnNlEiIKCGRvY3VtZW50GAEgASgLMhAuYXBpLnYxLkRvY3VtZW50IiUKF0Rvd25sb2FkRG9jdW1lbnRSZXF1ZXN0EgoKAmlkGAEgASgJIk8KGERvd25sb2FkRG9jdW1lbnRSZXNwb25zZRIiCghkb2N1bWVudBgBIAEoCzIQLmFwaS52MS5Eb2N1bWVudBIPCgdjb250ZW50GAIgASgMIiMKFURlbGV0ZURvY3VtZW50UmVxdWVzdBIKCgJpZBgBIAEoCSIYChZEZWxldGVEb2N1bWVudFJlc3BvbnNlImQKFVVwZGF0ZURvY3VtZW50UmVxdWVzdBIKCgJpZBgBIAEoCRIiCgRraW5kGAIgASgOMhQuYXBpLnYxLkRvY3VtZW50S2luZBIMCgRuYW1lGAMgASgJEg0KBWxhYmVsGAQgASgJIjwKFlVwZGF0ZURvY3VtZW50UmVzcG9uc2USIgoIZG9jdW1lbnQYASABKAsyEC5hcGkudjEuRG9jdW1lbnQiYQoUTGlzdERvY3VtZW50c1JlcXVlc3QSEQoJcGFnZV9zaXplGAEgASgFEhIKCnBhZ2VfdG9rZW4YAiABKAkSIgoEa2luZBgDIAEoDjIULmFwaS52MS5Eb2N1bWVudEtpbmQiXAoVTGlzdERvY3VtZW50c1Jlc3BvbnNlEioKCWRvY3VtZW50cxgBIAMoCzIXLmFwaS52MS5Eb2N1bWVudFN1bW1hcnkSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJInIKFlNlYXJjaERvY3VtZW50c1JlcXVlc3QSDQoFcXVlcnkYASABKAkSIgoEa2luZBgCIAEoDjIULmFwaS52MS5Eb2N1bWVudEtpbmQSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkidAoURG9jdW1lbnRTZWFyY2hSZXN1bHQSIgoIZG9jdW1lbnQYASABKAsyEC5hcGkudjEuRG9jdW1lbnQSGQoRYXBwbGljYXRpb25fY291bnQYAiABKAUSDAoEcmFuaxgDIAEoARIPCgdzbmlwcGV0GAQgASgJImEKF1NlYXJjaERvY3VtZW50c1Jlc3BvbnNlEi0KB3Jlc3VsdHMYASADKAsyHC5hcGkudjEuRG9jdW1lbnRTZWFyY2hSZXN1bHQSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJIigKGlNjb3JlSm9iQXBwbGljYXRpb25SZXF1ZXN0EgoKAmlkGAEgASgJIqYBChtTY29yZUpvYkFwcGxpY2F0aW9uUmVzcG9uc2USLwoPam9iX2FwcGxpY2F0aW9uGAEgASgLMhYuYXBpLnYxLkpvYkFwcGxpY2F0aW9uEg0KBXNjb3JlGAIgASgFEhYKDm1hdGNoZWRfc2tpbGxzGAMgAygJEhYKDm1pc3Npbmdfc2tpbGxzGAQgAygJEhcKD2tleXdvcmRfb3ZlcmxhcBgFIAEoASI0ChNJbXBvcnRDb2x1bW5NYXBwaW5nEg0KBWZpZWxkGAEgASgJEg4KBmNvbHVtbhgCIAEoCSKnAQocSW1wb3J0Sm9iQXBwbGljYXRpb25zUmVxdWVzdBIkCgZmb3JtYXQYASABKA4yFC5hcGkudjEuSW1wb3J0Rm9ybWF0Eg8KB2NvbnRlbnQYAiABKAwSLAoHbWFwcGluZxgDIAMoCzIbLmFwaS52MS5JbXBvcnRDb2x1bW5NYXBwaW5nEhEKCWRheV9maXJzdBgEIAEoCBIPCgdkcnlfcnVuGAUgASgIIpgBCg9JbXBvcnRSb3dSZXN1bHQSCwoDcm93GAEgASgFEhMKC2V4dGVybmFsX2lkGAIgASgJEikKB291dGNvbWUYAyABKA4yGC5hcGkudjEuSW1wb3J0Um93T3V0Y29tZRINCgVmaWVsZBgEIAEoCRINCgVlcnJvchgFIAEoCRIaChJqb2JfYXBwbGljYXRpb25faWQYBiABKAkioQEKHUltcG9ydEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEigKB3Jlc3VsdHMYASADKAsyFy5hcGkudjEuSW1wb3J0Um93UmVzdWx0EhUKDWNyZWF0ZWRfY291bnQYAiABKAUSFQoNc2tpcHBlZF9jb3VudBgDIAEoBRIVCg1pbnZhbGlkX2NvdW50GAQgASgFEhEKCWNvbW1pdHRlZBgFIAEoCCJEChxFeHBvcnRKb2JBcHBsaWNhdGlvbnNSZXF1ZXN0EiQKBmZvcm1hdBgBIAEoDjIULmFwaS52MS5FeHBvcnRGb3JtYXQiVgodRXhwb3J0Sm9iQXBwbGljYXRpb25zUmVzcG9uc2USDAoEZGF0YRgBIAEoDBIUCgxjb250ZW50X3R5cGUYAiABKAkSEQoJZmlsZV9uYW1lGAMgASgJIoEBChdHZXRQaXBlbGluZVN0YXRzUmVxdWVzdBIzCg9hcHBsaWVkX29uX2Zyb20YASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKDWFwcGxpZWRfb25fdG8YAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIkoKC1N0YXR1c0NvdW50EiwKBnN0YXR1cxgBIAEoDjIcLmFwaS52MS5Kb2JBcHBsaWNhdGlvblN0YXR1cxINCgVjb3VudBgCIAEoBSKpAQoPU3RhZ2VDb252ZXJzaW9uEjEKC2Zyb21fc3RhdHVzGAEgASgOMhwuYXBpLnYxLkpvYkFwcGxpY2F0aW9uU3RhdHVzEi8KCXRvX3N0YXR1cxgCIAEoDjIcLmFwaS52MS5Kb2JBcHBsaWNhdGlvblN0YXR1cxISCgpmcm9tX2NvdW50GAMgASgFEhAKCHRvX2NvdW50GAQgASgFEgwKBHJhdGUYBSABKAEiYQoNU3RhZ2VEdXJhdGlvbhIsCgZzdGF0dXMYASABKA4yHC5hcGkudjEuSm9iQXBwbGljYXRpb25TdGF0dXMSEwoLbWVkaWFuX2RheXMYAiABKAESDQoFY291bnQYAyABKAUiggEKEldlZWtseVJlc3BvbnNlUmF0ZRIuCgp3ZWVrX3N0YXJ0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIVCg1hcHBsaWVkX2NvdW50GAIgASgFEhcKD3Jlc3BvbmRlZF9jb3VudBgDIAEoBRIMCgRyYXRlGAQgASgBIt0BChhHZXRQaXBlbGluZVN0YXRzUmVzcG9uc2USKgoNc3RhdHVzX2NvdW50cxgBIAMoCzITLmFwaS52MS5TdGF0dXNDb3VudBIsCgtjb252ZXJzaW9ucxgCIAMoCzIXLmFwaS52MS5TdGFnZUNvbnZlcnNpb24SLAoNdGltZV9pbl9zdGFnZRgDIAMoCzIVLmFwaS52MS5TdGFnZUR1cmF0aW9uEjkKFXdlZWtseV9yZXNwb25zZV9yYXRlcxgEIAMoCzIaLmFwaS52MS5XZWVrbHlSZXNwb25zZVJhdGUiGQoXTGlzdER1ZUZvbGxvd1Vwc1JlcXVlc3QikgEKC0R1ZUZvbGxvd1VwEi8KD2pvYl9hcHBsaWNhdGlvbhgBIAEoCzIWLmFwaS52MS5Kb2JBcHBsaWNhdGlvbhImCgZyZWFzb24YAiABKA4yFi5hcGkudjEuRm9sbG93VXBSZWFzb24SKgoGZHVlX2F0GAMgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJDChhMaXN0RHVlRm9sbG93VXBzUmVzcG9uc2USJwoKZm9sbG93X3VwcxgBIAMoCzITLmFwaS52MS5EdWVGb2xsb3dVcCIjChJVbnN1YnNjcmliZVJlcXVlc3QSDQoFdG9rZW4YASABKAkiFQoTVW5zdWJzY3JpYmVSZXNwb25zZSLiAgoHQ29tcGFueRIKCgJpZBgBIAEoCRIMCgRuYW1lGAIgASgJEi0KB3dlYnNpdGUYAyABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSLgoIbG9jYXRpb24YBCABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSIQoEc2l6ZRgFIAEoDjITLmFwaS52MS5Db21wYW55U2l6ZRIuCghpbmR1c3RyeRgGIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIrCgVub3RlcxgHIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKDAgoUQ3JlYXRlQ29tcGFueVJlcXVlc3QSDAoEbmFtZRgBIAEoCRItCgd3ZWJzaXRlGAIgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEi4KCGxvY2F0aW9uGAMgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEiEKBHNpemUYBCABKA4yEy5hcGkudjEuQ29tcGFueVNpemUSLgoIaW5kdXN0cnkYBSABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSKwoFbm90ZXMYBiABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUiOQoVQ3JlYXRlQ29tcGFueVJlc3BvbnNlEiAKB2NvbXBhbnkYASABKAsyDy5hcGkudjEuQ29tcGFueSKPAgoUVXBkYXRlQ29tcGFueVJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRItCgd3ZWJzaXRlGAMgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEi4KCGxvY2F0aW9uGAQgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEiEKBHNpemUYBSABKA4yEy5hcGkudjEuQ29tcGFueVNpemUSLgoIaW5kdXN0cnkYBiABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSKwoFbm90ZXMYByABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUiOQoVVXBkYXRlQ29tcGFueVJlc3BvbnNlEiAKB2NvbXBhbnkYASABKAsyDy5hcGkudjEuQ29tcGFueSIWChRMaXN0Q29tcGFuaWVzUmVxdWVzdCKuAQoOQ29tcGFueVN1bW1hcnkSIAoHY29tcGFueRgBIAEoCzIPLmFwaS52MS5Db21wYW55EhkKEWFwcGxpY2F0aW9uX2NvdW50GAIgASgFEioKDXN0YXR1c19jb3VudHMYAyADKAsyEy5hcGkudjEuU3RhdHVzQ291bnQSMwoPbGFzdF9hcHBsaWVkX29uGAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCJCChVMaXN0Q29tcGFuaWVzUmVzcG9uc2USKQoJY29tcGFuaWVzGAEgAygLMhYuYXBpLnYxLkNvbXBhbnlTdW1tYXJ5Iu8CCgdDb250YWN0EgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSKgoEcm9sZRgDIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIrCgVlbWFpbBgEIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIrCgVwaG9uZRgFIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIyCgxsaW5rZWRpbl91cmwYBiABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSMAoKY29tcGFueV9pZBgHIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIuCgpjcmVhdGVkX2F0GAggASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIuCgp1cGRhdGVkX2F0GAkgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKQAgoUQ3JlYXRlQ29udGFjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIqCgRyb2xlGAIgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBWVtYWlsGAMgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBXBob25lGAQgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjIKDGxpbmtlZGluX3VybBgFIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIwCgpjb21wYW55X2lkGAYgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlIjkKFUNyZWF0ZUNvbnRhY3RSZXNwb25zZRIgCgdjb250YWN0GAEgASgLMg8uYXBpLnYxLkNvbnRhY3QiHwoRR2V0Q29udGFjdFJlcXVlc3QSCgoCaWQYASABKAkiNgoSR2V0Q29udGFjdFJlc3BvbnNlEiAKB2NvbnRhY3QYASABKAsyDy5hcGkudjEuQ29udGFjdCKcAgoUVXBkYXRlQ29udGFjdFJlcXVlc3QSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIqCgRyb2xlGAMgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBWVtYWlsGAQgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEisKBXBob25lGAUgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlEjIKDGxpbmtlZGluX3VybBgGIAEoCzIcLmdvb2dsZS5wcm90b2J1Zi5TdHJpbmdWYWx1ZRIwCgpjb21wYW55X2lkGAcgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlIjkKFVVwZGF0ZUNvbnRhY3RSZXNwb25zZRIgCgdjb250YWN0GAEgASgLMg8uYXBpLnYxLkNvbnRhY3QiIgoURGVsZXRlQ29udGFjdFJlcXVlc3QSCgoCaWQYASABKAkiFwoVRGVsZXRlQ29udGFjdFJlc3BvbnNlIoEBChNMaXN0Q29udGFjdHNSZXF1ZXN0EjAKCmNvbXBhbnlfaWQYASABKAsyHC5nb29nbGUucHJvdG9idWYuU3RyaW5nVmFsdWUSOAoSam9iX2FwcGxpY2F0aW9uX2lkGAIgASgLMhwuZ29vZ2xlLnByb3RvYnVmLlN0cmluZ1ZhbHVlIjkKFExpc3RDb250YWN0c1Jlc3BvbnNlEiEKCGNvbnRhY3RzGAEgAygLMg8uYXBpLnYxLkNvbnRhY3QiRAoSTGlua0NvbnRhY3RSZXF1ZXN0EhIKCmNvbnRhY3RfaWQYASABKAkSGgoSam9iX2FwcGxpY2F0aW9uX2lkGAIgASgJIhUKE0xpbmtDb250YWN0UmVzcG9uc2UiRgoUVW5saW5rQ29udGFjdFJlcXVlc3QSEgoKY29udGFjdF9pZBgBIAEoCRIaChJqb2JfYXBwbGljYXRpb25faWQYAiABKAkiFwoVVW5saW5rQ29udGFjdFJlc3BvbnNlIicKGUdldENvbnRhY3RUaW1lbGluZVJlcXVlc3QSCgoCaWQYASABKAkiowEKFENvbnRhY3RUaW1lbGluZUVudHJ5Ei8KD2pvYl9hcHBsaWNhdGlvbhgBIAEoCzIWLmFwaS52MS5Kb2JBcHBsaWNhdGlvbhItCglsaW5rZWRfYXQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEisKBmV2ZW50cxgDIAMoCzIbLmFwaS52MS5Kb2JBcHBsaWNhdGlvbkV2ZW50IksKGkdldENvbnRhY3RUaW1lbGluZVJlc3BvbnNlEi0KB2VudHJpZXMYASADKAsyHC5hcGkudjEuQ29udGFjdFRpbWVsaW5lRW50cnkqjAIKFUpvYkFwcGxpY2F0aW9uU29ydEtleRIoCiRKT0JfQVBQTElDQVRJT05fU09SVF9LRVlfVU5TUEVDSUZJRUQQABInCiNKT0JfQVBQTElDQVRJT05fU09SVF9LRVlfQVBQTElFRF9PThABEicKI0pPQl9BUFBMSUNBVElPTl9TT1JUX0tFWV9DUkVBVEVEX0FUEAISJwojSk9CX0FQUExJQ0FUSU9OX1NPUlRfS0VZX1VQREFURURfQVQQAxIkCiBKT0JfQVBQTElDQVRJT05fU09SVF9LRVlfQ09NUEFOWRAEEigKJEpPQl9BUFBMSUNBVElPTl9TT1JUX0tFWV9NQVRDSF9TQ09SRRAFKsACChRKb2JBcHBsaWNhdGlvblN0YXR1cxImCiJKT0JfQVBQTElDQVRJT05fU1RBVFVTX1VOU1BFQ0lGSUVEEAASIgoeSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19BUFBMSUVEEAESJAogSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19TQ1JFRU5JTkcQAhIkCiBKT0JfQVBQTElDQVRJT05fU1RBVFVTX0lOVEVSVklFVxADEiAKHEpPQl9BUFBMSUNBVElPTl9TVEFUVVNfT0ZGRVIQBBIjCh9KT0JfQVBQTElDQVRJT05fU1RBVFVTX1JFSkVDVEVEEAUSJAogSk9CX0FQUExJQ0FUSU9OX1NUQVRVU19XSVRIRFJBV04QBhIjCh9KT0JfQVBQTElDQVRJT05fU1RBVFVTX0FDQ0VQVEVEEAcqlQIKF0pvYkFwcGxpY2F0aW9uRXZlbnRUeXBlEioKJkpPQl9BUFBMSUNBVElPTl9FVkVOVF9UWVBFX1VOU1BFQ0lGSUVEEAASJgoiSk9CX0FQUExJQ0FUSU9OX0VWRU5UX1RZUEVfQ1JFQVRFRBABEiYKIkpPQl9BUFBMSUNBVElPTl9FVkVOVF9UWVBFX1VQREFURUQQAhItCilKT0JfQVBQTElDQVRJT05fRVZFTlRfVFlQRV9TVEFUVVNfQ0hBTkdFRBADEiYKIkpPQl9BUFBMSUNBVElPTl9FVkVOVF9UWVBFX0RFTEVURUQQBBInCiNKT0JfQVBQTElDQVRJT05fRVZFTlRfVFlQRV9SRVNUT1JFRBAFKogBCg9JbnRlcnZpZXdGb3JtYXQSIAocSU5URVJWSUVXX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhoKFklOVEVSVklFV19GT1JNQVRfUEhPTkUQARIaChZJTlRFUlZJRVdfRk9STUFUX1ZJREVPEAISGwoXSU5URVJWSUVXX0ZPUk1BVF9PTlNJVEUQAyqxAQoQSW50ZXJ2aWV3T3V0Y29tZRIhCh1JTlRFUlZJRVdfT1VUQ09NRV9VTlNQRUNJRklFRBAAEh0KGUlOVEVSVklFV19PVVRDT01FX1BFTkRJTkcQARIcChhJTlRFUlZJRVdfT1VUQ09NRV9QQVNTRUQQAhIcChhJTlRFUlZJRVdfT1VUQ09NRV9GQUlMRUQQAxIfChtJTlRFUlZJRVdfT1VUQ09NRV9DQU5DRUxMRUQQBCpjCgxEb2N1bWVudEtpbmQSHQoZRE9DVU1FTlRfS0lORF9VTlNQRUNJRklFRBAAEhQKEERPQ1VNRU5UX0tJTkRfQ1YQARIeChpET0NVTUVOVF9LSU5EX0NPVkVSX0xFVFRFUhACKlwKDEltcG9ydEZvcm1hdBIdChlJTVBPUlRfRk9STUFUX1VOU1BFQ0lGSUVEEAASFQoRSU1QT1JUX0ZPUk1BVF9DU1YQARIWChJJTVBPUlRfRk9STUFUX0pTT04QAiqWAQoQSW1wb3J0Um93T3V0Y29tZRIiCh5JTVBPUlRfUk9XX09VVENPTUVfVU5TUEVDSUZJRUQQABIeChpJTVBPUlRfUk9XX09VVENPTUVfQ1JFQVRFRBABEh4KGklNUE9SVF9ST1dfT1VUQ09NRV9TS0lQUEVEEAISHgoaSU1QT1JUX1JPV19PVVRDT01FX0lOVkFMSUQQAyp1CgxFeHBvcnRGb3JtYXQSHQoZRVhQT1JUX0ZPUk1BVF9VTlNQRUNJRklFRBAAEhUKEUVYUE9SVF9GT1JNQVRfQ1NWEAESGAoURVhQT1JUX0ZPUk1BVF9OREpTT04QAhIVChFFWFBPUlRfRk9STUFUX0lDUxADKnAKDkZvbGxvd1VwUmVhc29uEiAKHEZPTExPV19VUF9SRUFTT05fVU5TUEVDSUZJRUQQABIeChpGT0xMT1dfVVBfUkVBU09OX1NDSEVEVUxFRBABEhwKGEZPTExPV19VUF9SRUFTT05fR0hPU1RFRBACKsYBCgtDb21wYW55U2l6ZRIcChhDT01QQU5ZX1NJWkVfVU5TUEVDSUZJRUQQABIVChFDT01QQU5ZX1NJWkVfMV8xMBABEhYKEkNPTVBBTllfU0laRV8xMV81MBACEhcKE0NPTVBBTllfU0laRV81MV8yMDAQAxIZChVDT01QQU5ZX1NJWkVfMjAxXzEwMDAQBBIaChZDT01QQU5ZX1NJWkVfMTAwMV81MDAwEAUSGgoWQ09NUEFOWV9TSVpFXzUwMDFfUExVUxAGMrIcCgdTZXJ2aWNlEmEKFENyZWF0ZUpvYkFwcGxpY2F0aW9uEiMuYXBpLnYxLkNyZWF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBokLmFwaS52MS5DcmVhdGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEl4KE0xpc3RKb2JBcHBsaWNhdGlvbnMSIi5hcGkudjEuTGlzdEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QaIy5hcGkudjEuTGlzdEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEmEKFFVwZGF0ZUpvYkFwcGxpY2F0aW9uEiMuYXBpLnYxLlVwZGF0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBokLmFwaS52MS5VcGRhdGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEmEKFERlbGV0ZUpvYkFwcGxpY2F0aW9uEiMuYXBpLnYxLkRlbGV0ZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBokLmFwaS52MS5EZWxldGVKb2JBcHBsaWNhdGlvblJlc3BvbnNlEnMKGlVwZGF0ZUpvYkFwcGxpY2F0aW9uU3RhdHVzEikuYXBpLnYxLlVwZGF0ZUpvYkFwcGxpY2F0aW9uU3RhdHVzUmVxdWVzdBoqLmFwaS52MS5VcGRhdGVKb2JBcHBsaWNhdGlvblN0YXR1c1Jlc3BvbnNlEm0KGEdldEpvYkFwcGxpY2F0aW9uSGlzdG9yeRInLmFwaS52MS5HZXRKb2JBcHBsaWNhdGlvbkhpc3RvcnlSZXF1ZXN0GiguYXBpLnYxLkdldEpvYkFwcGxpY2F0aW9uSGlzdG9yeVJlc3BvbnNlEmQKFVNlYXJjaEpvYkFwcGxpY2F0aW9ucxIkLmFwaS52MS5TZWFyY2hKb2JBcHBsaWNhdGlvbnNSZXF1ZXN0GiUuYXBpLnYxLlNlYXJjaEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlElIKD0dldFVzZXJTZXR0aW5ncxIeLmFwaS52MS5HZXRVc2VyU2V0dGluZ3NSZXF1ZXN0Gh8uYXBpLnYxLkdldFVzZXJTZXR0aW5nc1Jlc3BvbnNlElsKElVwZGF0ZVVzZXJTZXR0aW5ncxIhLmFwaS52MS5VcGRhdGVVc2VyU2V0dGluZ3NSZXF1ZXN0GiIuYXBpLnYxLlVwZGF0ZVVzZXJTZXR0aW5nc1Jlc3BvbnNlEnMKGkxpc3REZWxldGVkSm9iQXBwbGljYXRpb25zEikuYXBpLnYxLkxpc3REZWxldGVkSm9iQXBwbGljYXRpb25zUmVxdWVzdBoqLmFwaS52MS5MaXN0RGVsZXRlZEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlEmQKFVJlc3RvcmVKb2JBcHBsaWNhdGlvbhIkLmFwaS52MS5SZXN0b3JlSm9iQXBwbGljYXRpb25SZXF1ZXN0GiUuYXBpLnYxLlJlc3RvcmVKb2JBcHBsaWNhdGlvblJlc3BvbnNlElIKD0NyZWF0ZUludGVydmlldxIeLmFwaS52MS5DcmVhdGVJbnRlcnZpZXdSZXF1ZXN0Gh8uYXBpLnYxLkNyZWF0ZUludGVydmlld1Jlc3BvbnNlEk8KDkxpc3RJbnRlcnZpZXdzEh0uYXBpLnYxLkxpc3RJbnRlcnZpZXdzUmVxdWVzdBoeLmFwaS52MS5MaXN0SW50ZXJ2aWV3c1Jlc3BvbnNlElIKD1VwZGF0ZUludGVydmlldxIeLmFwaS52MS5VcGRhdGVJbnRlcnZpZXdSZXF1ZXN0Gh8uYXBpLnYxLlVwZGF0ZUludGVydmlld1Jlc3BvbnNlElIKD0RlbGV0ZUludGVydmlldxIeLmFwaS52MS5EZWxldGVJbnRlcnZpZXdSZXF1ZXN0Gh8uYXBpLnYxLkRlbGV0ZUludGVydmlld1Jlc3BvbnNlEmcKFkxpc3RVcGNvbWluZ0ludGVydmlld3MSJS5hcGkudjEuTGlzdFVwY29taW5nSW50ZXJ2aWV3c1JlcXVlc3QaJi5hcGkudjEuTGlzdFVwY29taW5nSW50ZXJ2aWV3c1Jlc3BvbnNlEmMKFFdhdGNoSm9iQXBwbGljYXRpb25zEiMuYXBpLnYxLldhdGNoSm9iQXBwbGljYXRpb25zUmVxdWVzdBokLmFwaS52MS5XYXRjaEpvYkFwcGxpY2F0aW9uc1Jlc3BvbnNlMAESTwoOVXBsb2FkRG9jdW1lbnQSHS5hcGkudjEuVXBsb2FkRG9jdW1lbnRSZXF1ZXN0Gh4uYXBpLnYxLlVwbG9hZERvY3VtZW50UmVzcG9uc2USRgoLR2V0RG9jdW1lbnQSGi5hcGkudjEuR2V0RG9jdW1lbnRSZXF1ZXN0GhsuYXBpLnYxLkdldERvY3VtZW50UmVzcG9uc2USVQoQRG93bmxvYWREb2N1bWVudBIfLmFwaS52MS5Eb3dubG9hZERvY3VtZW50UmVxdWVzdBogLmFwaS52MS5Eb3dubG9hZERvY3VtZW50UmVzcG9uc2USTwoORGVsZXRlRG9jdW1lbnQSHS5hcGkudjEuRGVsZXRlRG9jdW1lbnRSZXF1ZXN0Gh4uYXBpLnYxLkRlbGV0ZURvY3VtZW50UmVzcG9uc2USTwoOVXBkYXRlRG9jdW1lbnQSHS5hcGkudjEuVXBkYXRlRG9jdW1lbnRSZXF1ZXN0Gh4uYXBpLnYxLlVwZGF0ZURvY3VtZW50UmVzcG9uc2USTAoNTGlzdERvY3VtZW50cxIcLmFwaS52MS5MaXN0RG9jdW1lbnRzUmVxdWVzdBodLmFwaS52MS5MaXN0RG9jdW1lbnRzUmVzcG9uc2USUgoPU2VhcmNoRG9jdW1lbnRzEh4uYXBpLnYxLlNlYXJjaERvY3VtZW50c1JlcXVlc3QaHy5hcGkudjEuU2VhcmNoRG9jdW1lbnRzUmVzcG9uc2USXgoTU2NvcmVKb2JBcHBsaWNhdGlvbhIiLmFwaS52MS5TY29yZUpvYkFwcGxpY2F0aW9uUmVxdWVzdBojLmFwaS52MS5TY29yZUpvYkFwcGxpY2F0aW9uUmVzcG9uc2USZAoVSW1wb3J0Sm9iQXBwbGljYXRpb25zEiQuYXBpLnYxLkltcG9ydEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QaJS5hcGkudjEuSW1wb3J0Sm9iQXBwbGljYXRpb25zUmVzcG9uc2USZgoVRXhwb3J0Sm9iQXBwbGljYXRpb25zEiQuYXBpLnYxLkV4cG9ydEpvYkFwcGxpY2F0aW9uc1JlcXVlc3QaJS5hcGkudjEuRXhwb3J0Sm9iQXBwbGljYXRpb25zUmVzcG9uc2UwARJVChBHZXRQaXBlbGluZVN0YXRzEh8uYXBpLnYxLkdldFBpcGVsaW5lU3RhdHNSZXF1ZXN0GiAuYXBpLnYxLkdldFBpcGVsaW5lU3RhdHNSZXNwb25zZRJVChBMaXN0RHVlRm9sbG93VXBzEh8uYXBpLnYxLkxpc3REdWVGb2xsb3dVcHNSZXF1ZXN0GiAuYXBpLnYxLkxpc3REdWVGb2xsb3dVcHNSZXNwb25zZRJGCgtVbnN1YnNjcmliZRIaLmFwaS52MS5VbnN1YnNjcmliZVJlcXVlc3QaGy5hcGkudjEuVW5zdWJzY3JpYmVSZXNwb25zZRJMCg1DcmVhdGVDb21wYW55EhwuYXBpLnYxLkNyZWF0ZUNvbXBhbnlSZXF1ZXN0Gh0uYXBpLnYxLkNyZWF0ZUNvbXBhbnlSZXNwb25zZRJMCg1VcGRhdGVDb21wYW55EhwuYXBpLnYxLlVwZGF0ZUNvbXBhbnlSZXF1ZXN0Gh0uYXBpLnYxLlVwZGF0ZUNvbXBhbnlSZXNwb25zZRJMCg1MaXN0Q29tcGFuaWVzEhwuYXBpLnYxLkxpc3RDb21wYW5pZXNSZXF1ZXN0Gh0uYXBpLnYxLkxpc3RDb21wYW5pZXNSZXNwb25zZRJMCg1DcmVhdGVDb250YWN0EhwuYXBpLnYxLkNyZWF0ZUNvbnRhY3RSZXF1ZXN0Gh0uYXBpLnYxLkNyZWF0ZUNvbnRhY3RSZXNwb25zZRJDCgpHZXRDb250YWN0EhkuYXBpLnYxLkdldENvbnRhY3RSZXF1ZXN0GhouYXBpLnYxLkdldENvbnRhY3RSZXNwb25zZRJMCg1VcGRhdGVDb250YWN0EhwuYXBpLnYxLlVwZGF0ZUNvbnRhY3RSZXF1ZXN0Gh0uYXBpLnYxLlVwZGF0ZUNvbnRhY3RSZXNwb25zZRJMCg1EZWxldGVDb250YWN0EhwuYXBpLnYxLkRlbGV0ZUNvbnRhY3RSZXF1ZXN0Gh0uYXBpLnYxLkRlbGV0ZUNvbnRhY3RSZXNwb25zZRJJCgxMaXN0Q29udGFjdHMSGy5hcGkudjEuTGlzdENvbnRhY3RzUmVxdWVzdBocLmFwaS52MS5MaXN0Q29udGFjdHNSZXNwb25zZRJGCgtMaW5rQ29udGFjdBIaLmFwaS52MS5MaW5rQ29udGFjdFJlcXVlc3QaGy5hcGkudjEuTGlua0NvbnRhY3RSZXNwb25zZRJMCg1VbmxpbmtDb250YWN0EhwuYXBpLnYxLlVubGlua0NvbnRhY3RSZXF1ZXN0Gh0uYXBpLnYxLlVubGlua0NvbnRhY3RSZXNwb25zZRJbChJHZXRDb250YWN0VGltZWxpbmUSIS5hcGkudjEuR2V0Q29udGFjdFRpbWVsaW5lUmVxdWVzdBoiLmFwaS52MS5HZXRDb250YWN0VGltZWxpbmVSZXNwb25zZUITWhFraXNla2kvYXBpL3YxO2FwaWIGcHJvdG8z",
+    [
+      file_google_protobuf_duration,
+      file_google_protobuf_field_mask,
+      file_google_protobuf_timestamp,
+      file_google_protobuf_wrappers,
+    ]
   );
 
 /**
@@ -80,6 +87,26 @@ export type CreateJobApplicationRequest =
      * @generated from field: string position = 9;
      */
     position: string;
+
+    /**
+     * @generated from field: google.protobuf.StringValue cv_document_id = 10;
+     */
+    cvDocumentId?: string;
+
+    /**
+     * @generated from field: google.protobuf.StringValue cover_letter_document_id = 11;
+     */
+    coverLetterDocumentId?: string;
+
+    /**
+     * @generated from field: google.protobuf.Timestamp follow_up_on = 12;
+     */
+    followUpOn?: Timestamp;
+
+    /**
+     * @generated from field: google.protobuf.StringValue company_id = 13;
+     */
+    companyId?: string;
   };
 
 /**
@@ -113,7 +140,32 @@ export const CreateJobApplicationResponseSchema: GenMessage<CreateJobApplication
  * @generated from message api.v1.ListJobApplicationsRequest
  */
 export type ListJobApplicationsRequest =
-  Message<"api.v1.ListJobApplicationsRequest"> & {};
+  Message<"api.v1.ListJobApplicationsRequest"> & {
+    /**
+     * @generated from field: int32 page_size = 1;
+     */
+    pageSize: number;
+
+    /**
+     * @generated from field: string page_token = 2;
+     */
+    pageToken: string;
+
+    /**
+     * @generated from field: api.v1.JobApplicationFilter filter = 3;
+     */
+    filter?: JobApplicationFilter;
+
+    /**
+     * @generated from field: api.v1.JobApplicationSortKey sort_key = 4;
+     */
+    sortKey: JobApplicationSortKey;
+
+    /**
+     * @generated from field: bool descending = 5;
+     */
+    descending: boolean;
+  };
 
 /**
  * Describes the message api.v1.ListJobApplicationsRequest.
@@ -132,6 +184,11 @@ export type ListJobApplicationsResponse =
      * @generated from field: repeated api.v1.JobApplication job_applications = 1;
      */
     jobApplications: JobApplication[];
+
+    /**
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
   };
 
 /**
@@ -142,6 +199,64 @@ export const ListJobApplicationsResponseSchema: GenMessage<ListJobApplicationsRe
   /*@__PURE__*/
   messageDesc(file_api_v1_api, 3);
 
+/**
+ * @generated from message api.v1.JobApplicationFilter
+ */
+export type JobApplicationFilter = Message<"api.v1.JobApplicationFilter"> & {
+  /**
+   * @generated from field: repeated api.v1.JobApplicationStatus statuses = 1;
+   */
+  statuses: JobApplicationStatus[];
+
+  /**
+   * @generated from field: string company = 2;
+   */
+  company: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp applied_on_from = 3;
+   */
+  appliedOnFrom?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp applied_on_to = 4;
+   */
+  appliedOnTo?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.BoolValue has_cv = 5;
+   */
+  hasCv?: boolean;
+
+  /**
+   * @generated from field: google.protobuf.BoolValue has_cover_letter = 6;
+   */
+  hasCoverLetter?: boolean;
+
+  /**
+   * @generated from field: string cv_document_id = 7;
+   */
+  cvDocumentId: string;
+
+  /**
+   * @generated from field: string cover_letter_document_id = 8;
+   */
+  coverLetterDocumentId: string;
+
+  /**
+   * @generated from field: string cv_query = 9;
+   */
+  cvQuery: string;
+};
+
+/**
+ * Describes the message api.v1.JobApplicationFilter.
+ * Use `create(JobApplicationFilterSchema)` to create a new message.
+ */
+export const JobApplicationFilterSchema: GenMessage<JobApplicationFilter> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 4);
+
 /**
  * @generated from message api.v1.UpdateJobApplicationRequest
  */
@@ -196,6 +311,36 @@ export type UpdateJobApplicationRequest =
      * @generated from field: string position = 10;
      */
     position: string;
+
+    /**
+     * @generated from field: int64 version = 11;
+     */
+    version: bigint;
+
+    /**
+     * @generated from field: google.protobuf.FieldMask update_mask = 12;
+     */
+    updateMask?: FieldMask;
+
+    /**
+     * @generated from field: google.protobuf.StringValue cv_document_id = 13;
+     */
+    cvDocumentId?: string;
+
+    /**
+     * @generated from field: google.protobuf.StringValue cover_letter_document_id = 14;
+     */
+    coverLetterDocumentId?: string;
+
+    /**
+     * @generated from field: google.protobuf.Timestamp follow_up_on = 15;
+     */
+    followUpOn?: Timestamp;
+
+    /**
+     * @generated from field: google.protobuf.StringValue company_id = 16;
+     */
+    companyId?: string;
   };
 
 /**
@@ -204,7 +349,7 @@ export type UpdateJobApplicationRequest =
  */
 export const UpdateJobApplicationRequestSchema: GenMessage<UpdateJobApplicationRequest> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 4);
+  messageDesc(file_api_v1_api, 5);
 
 /**
  * @generated from message api.v1.UpdateJobApplicationResponse
@@ -223,7 +368,7 @@ export type UpdateJobApplicationResponse =
  */
 export const UpdateJobApplicationResponseSchema: GenMessage<UpdateJobApplicationResponse> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 5);
+  messageDesc(file_api_v1_api, 6);
 
 /**
  * @generated from message api.v1.DeleteJobApplicationRequest
@@ -234,6 +379,11 @@ export type DeleteJobApplicationRequest =
      * @generated from field: string id = 1;
      */
     id: string;
+
+    /**
+     * @generated from field: int64 version = 2;
+     */
+    version: bigint;
   };
 
 /**
@@ -242,7 +392,7 @@ export type DeleteJobApplicationRequest =
  */
 export const DeleteJobApplicationRequestSchema: GenMessage<DeleteJobApplicationRequest> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 6);
+  messageDesc(file_api_v1_api, 7);
 
 /**
  * @generated from message api.v1.DeleteJobApplicationResponse
@@ -256,7 +406,7 @@ export type DeleteJobApplicationResponse =
  */
 export const DeleteJobApplicationResponseSchema: GenMessage<DeleteJobApplicationResponse> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 7);
+  messageDesc(file_api_v1_api, 8);
 
 /**
  * @generated from message api.v1.JobApplication
@@ -321,6 +471,51 @@ export type JobApplication = Message<"api.v1.JobApplication"> & {
    * @generated from field: string position = 12;
    */
   position: string;
+
+  /**
+   * @generated from field: int64 version = 13;
+   */
+  version: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp deleted_at = 14;
+   */
+  deletedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.StringValue cv_document_id = 15;
+   */
+  cvDocumentId?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue cover_letter_document_id = 16;
+   */
+  coverLetterDocumentId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Int32Value match_score = 17;
+   */
+  matchScore?: number;
+
+  /**
+   * @generated from field: google.protobuf.StringValue external_id = 18;
+   */
+  externalId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp status_changed_at = 19;
+   */
+  statusChangedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp follow_up_on = 20;
+   */
+  followUpOn?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.StringValue company_id = 21;
+   */
+  companyId?: string;
 };
 
 /**
@@ -329,7 +524,7 @@ export type JobApplication = Message<"api.v1.JobApplication"> & {
  */
 export const JobApplicationSchema: GenMessage<JobApplication> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 8);
+  messageDesc(file_api_v1_api, 9);
 
 /**
  * @generated from message api.v1.UpdateJobApplicationStatusRequest
@@ -350,6 +545,21 @@ export type UpdateJobApplicationStatusRequest =
      * @generated from field: google.protobuf.StringValue position = 3;
      */
     position?: string;
+
+    /**
+     * @generated from field: string after_id = 4;
+     */
+    afterId: string;
+
+    /**
+     * @generated from field: string before_id = 5;
+     */
+    beforeId: string;
+
+    /**
+     * @generated from field: int64 version = 6;
+     */
+    version: bigint;
   };
 
 /**
@@ -358,7 +568,7 @@ export type UpdateJobApplicationStatusRequest =
  */
 export const UpdateJobApplicationStatusRequestSchema: GenMessage<UpdateJobApplicationStatusRequest> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 9);
+  messageDesc(file_api_v1_api, 10);
 
 /**
  * @generated from message api.v1.UpdateJobApplicationStatusResponse
@@ -377,97 +587,2951 @@ export type UpdateJobApplicationStatusResponse =
  */
 export const UpdateJobApplicationStatusResponseSchema: GenMessage<UpdateJobApplicationStatusResponse> =
   /*@__PURE__*/
-  messageDesc(file_api_v1_api, 10);
+  messageDesc(file_api_v1_api, 11);
 
 /**
- * @generated from enum api.v1.JobApplicationStatus
+ * @generated from message api.v1.GetJobApplicationHistoryRequest
  */
-export enum JobApplicationStatus {
-  /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_UNSPECIFIED = 0;
-   */
-  UNSPECIFIED = 0,
+export type GetJobApplicationHistoryRequest =
+  Message<"api.v1.GetJobApplicationHistoryRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+  };
 
-  /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_APPLIED = 1;
-   */
-  APPLIED = 1,
+/**
+ * Describes the message api.v1.GetJobApplicationHistoryRequest.
+ * Use `create(GetJobApplicationHistoryRequestSchema)` to create a new message.
+ */
+export const GetJobApplicationHistoryRequestSchema: GenMessage<GetJobApplicationHistoryRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 12);
+
+/**
+ * @generated from message api.v1.GetJobApplicationHistoryResponse
+ */
+export type GetJobApplicationHistoryResponse =
+  Message<"api.v1.GetJobApplicationHistoryResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.JobApplicationEvent events = 1;
+     */
+    events: JobApplicationEvent[];
+  };
+
+/**
+ * Describes the message api.v1.GetJobApplicationHistoryResponse.
+ * Use `create(GetJobApplicationHistoryResponseSchema)` to create a new message.
+ */
+export const GetJobApplicationHistoryResponseSchema: GenMessage<GetJobApplicationHistoryResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 13);
+
+/**
+ * @generated from message api.v1.JobApplicationFieldChange
+ */
+export type JobApplicationFieldChange =
+  Message<"api.v1.JobApplicationFieldChange"> & {
+    /**
+     * @generated from field: string field = 1;
+     */
+    field: string;
+
+    /**
+     * @generated from field: google.protobuf.StringValue old_value = 2;
+     */
+    oldValue?: string;
+
+    /**
+     * @generated from field: google.protobuf.StringValue new_value = 3;
+     */
+    newValue?: string;
+  };
+
+/**
+ * Describes the message api.v1.JobApplicationFieldChange.
+ * Use `create(JobApplicationFieldChangeSchema)` to create a new message.
+ */
+export const JobApplicationFieldChangeSchema: GenMessage<JobApplicationFieldChange> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 14);
 
+/**
+ * @generated from message api.v1.JobApplicationEvent
+ */
+export type JobApplicationEvent = Message<"api.v1.JobApplicationEvent"> & {
   /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_SCREENING = 2;
+   * @generated from field: string id = 1;
    */
-  SCREENING = 2,
+  id: string;
 
   /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_INTERVIEW = 3;
+   * @generated from field: string job_application_id = 2;
    */
-  INTERVIEW = 3,
+  jobApplicationId: string;
 
   /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_OFFER = 4;
+   * @generated from field: string user_id = 3;
    */
-  OFFER = 4,
+  userId: string;
 
   /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_REJECTED = 5;
+   * @generated from field: api.v1.JobApplicationEventType type = 4;
    */
-  REJECTED = 5,
+  type: JobApplicationEventType;
 
   /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_WITHDRAWN = 6;
+   * @generated from field: repeated api.v1.JobApplicationFieldChange changes = 5;
    */
-  WITHDRAWN = 6,
+  changes: JobApplicationFieldChange[];
 
   /**
-   * @generated from enum value: JOB_APPLICATION_STATUS_ACCEPTED = 7;
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
    */
-  ACCEPTED = 7,
-}
+  createdAt?: Timestamp;
+};
 
 /**
- * Describes the enum api.v1.JobApplicationStatus.
+ * Describes the message api.v1.JobApplicationEvent.
+ * Use `create(JobApplicationEventSchema)` to create a new message.
  */
-export const JobApplicationStatusSchema: GenEnum<JobApplicationStatus> =
+export const JobApplicationEventSchema: GenMessage<JobApplicationEvent> =
   /*@__PURE__*/
-  enumDesc(file_api_v1_api, 0);
+  messageDesc(file_api_v1_api, 15);
 
 /**
- * @generated from service api.v1.Service
+ * @generated from message api.v1.SearchJobApplicationsRequest
  */
-export const Service: GenService<{
-  /**
-   * @generated from rpc api.v1.Service.CreateJobApplication
-   */
-  createJobApplication: {
-    methodKind: "unary";
-    input: typeof CreateJobApplicationRequestSchema;
-    output: typeof CreateJobApplicationResponseSchema;
-  };
-  /**
-   * @generated from rpc api.v1.Service.ListJobApplications
-   */
-  listJobApplications: {
-    methodKind: "unary";
-    input: typeof ListJobApplicationsRequestSchema;
-    output: typeof ListJobApplicationsResponseSchema;
-  };
-  /**
-   * @generated from rpc api.v1.Service.UpdateJobApplication
-   */
-  updateJobApplication: {
-    methodKind: "unary";
-    input: typeof UpdateJobApplicationRequestSchema;
-    output: typeof UpdateJobApplicationResponseSchema;
+export type SearchJobApplicationsRequest =
+  Message<"api.v1.SearchJobApplicationsRequest"> & {
+    /**
+     * @generated from field: string query = 1;
+     */
+    query: string;
+
+    /**
+     * @generated from field: int32 page_size = 2;
+     */
+    pageSize: number;
+
+    /**
+     * @generated from field: string page_token = 3;
+     */
+    pageToken: string;
   };
-  /**
-   * @generated from rpc api.v1.Service.DeleteJobApplication
-   */
-  deleteJobApplication: {
-    methodKind: "unary";
-    input: typeof DeleteJobApplicationRequestSchema;
-    output: typeof DeleteJobApplicationResponseSchema;
+
+/**
+ * Describes the message api.v1.SearchJobApplicationsRequest.
+ * Use `create(SearchJobApplicationsRequestSchema)` to create a new message.
+ */
+export const SearchJobApplicationsRequestSchema: GenMessage<SearchJobApplicationsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 16);
+
+/**
+ * @generated from message api.v1.SearchJobApplicationsResponse
+ */
+export type SearchJobApplicationsResponse =
+  Message<"api.v1.SearchJobApplicationsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.JobApplicationSearchResult results = 1;
+     */
+    results: JobApplicationSearchResult[];
+
+    /**
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
   };
-  /**
+
+/**
+ * Describes the message api.v1.SearchJobApplicationsResponse.
+ * Use `create(SearchJobApplicationsResponseSchema)` to create a new message.
+ */
+export const SearchJobApplicationsResponseSchema: GenMessage<SearchJobApplicationsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 17);
+
+/**
+ * @generated from message api.v1.JobApplicationSearchResult
+ */
+export type JobApplicationSearchResult =
+  Message<"api.v1.JobApplicationSearchResult"> & {
+    /**
+     * @generated from field: api.v1.JobApplication job_application = 1;
+     */
+    jobApplication?: JobApplication;
+
+    /**
+     * @generated from field: double rank = 2;
+     */
+    rank: number;
+
+    /**
+     * @generated from field: repeated api.v1.JobApplicationSearchSnippet snippets = 3;
+     */
+    snippets: JobApplicationSearchSnippet[];
+  };
+
+/**
+ * Describes the message api.v1.JobApplicationSearchResult.
+ * Use `create(JobApplicationSearchResultSchema)` to create a new message.
+ */
+export const JobApplicationSearchResultSchema: GenMessage<JobApplicationSearchResult> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 18);
+
+/**
+ * @generated from message api.v1.JobApplicationSearchSnippet
+ */
+export type JobApplicationSearchSnippet =
+  Message<"api.v1.JobApplicationSearchSnippet"> & {
+    /**
+     * @generated from field: string field = 1;
+     */
+    field: string;
+
+    /**
+     * @generated from field: string text = 2;
+     */
+    text: string;
+  };
+
+/**
+ * Describes the message api.v1.JobApplicationSearchSnippet.
+ * Use `create(JobApplicationSearchSnippetSchema)` to create a new message.
+ */
+export const JobApplicationSearchSnippetSchema: GenMessage<JobApplicationSearchSnippet> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 19);
+
+/**
+ * @generated from message api.v1.UserSettings
+ */
+export type UserSettings = Message<"api.v1.UserSettings"> & {
+  /**
+   * @generated from field: bool strict_status_transitions = 1;
+   */
+  strictStatusTransitions: boolean;
+
+  /**
+   * @generated from field: repeated api.v1.GhostingRule ghosting_rules = 2;
+   */
+  ghostingRules: GhostingRule[];
+
+  /**
+   * @generated from field: int32 auto_reject_after_days = 3;
+   */
+  autoRejectAfterDays: number;
+
+  /**
+   * @generated from field: bool email_digest = 4;
+   */
+  emailDigest: boolean;
+
+  /**
+   * @generated from field: bool email_reminders = 5;
+   */
+  emailReminders: boolean;
+
+  /**
+   * @generated from field: string email = 6;
+   */
+  email: string;
+};
+
+/**
+ * Describes the message api.v1.UserSettings.
+ * Use `create(UserSettingsSchema)` to create a new message.
+ */
+export const UserSettingsSchema: GenMessage<UserSettings> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 20);
+
+/**
+ * @generated from message api.v1.GhostingRule
+ */
+export type GhostingRule = Message<"api.v1.GhostingRule"> & {
+  /**
+   * @generated from field: api.v1.JobApplicationStatus status = 1;
+   */
+  status: JobApplicationStatus;
+
+  /**
+   * @generated from field: int32 after_days = 2;
+   */
+  afterDays: number;
+};
+
+/**
+ * Describes the message api.v1.GhostingRule.
+ * Use `create(GhostingRuleSchema)` to create a new message.
+ */
+export const GhostingRuleSchema: GenMessage<GhostingRule> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 21);
+
+/**
+ * @generated from message api.v1.GetUserSettingsRequest
+ */
+export type GetUserSettingsRequest =
+  Message<"api.v1.GetUserSettingsRequest"> & {};
+
+/**
+ * Describes the message api.v1.GetUserSettingsRequest.
+ * Use `create(GetUserSettingsRequestSchema)` to create a new message.
+ */
+export const GetUserSettingsRequestSchema: GenMessage<GetUserSettingsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 22);
+
+/**
+ * @generated from message api.v1.GetUserSettingsResponse
+ */
+export type GetUserSettingsResponse =
+  Message<"api.v1.GetUserSettingsResponse"> & {
+    /**
+     * @generated from field: api.v1.UserSettings settings = 1;
+     */
+    settings?: UserSettings;
+  };
+
+/**
+ * Describes the message api.v1.GetUserSettingsResponse.
+ * Use `create(GetUserSettingsResponseSchema)` to create a new message.
+ */
+export const GetUserSettingsResponseSchema: GenMessage<GetUserSettingsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 23);
+
+/**
+ * @generated from message api.v1.UpdateUserSettingsRequest
+ */
+export type UpdateUserSettingsRequest =
+  Message<"api.v1.UpdateUserSettingsRequest"> & {
+    /**
+     * @generated from field: api.v1.UserSettings settings = 1;
+     */
+    settings?: UserSettings;
+
+    /**
+     * @generated from field: google.protobuf.FieldMask update_mask = 2;
+     */
+    updateMask?: FieldMask;
+  };
+
+/**
+ * Describes the message api.v1.UpdateUserSettingsRequest.
+ * Use `create(UpdateUserSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateUserSettingsRequestSchema: GenMessage<UpdateUserSettingsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 24);
+
+/**
+ * @generated from message api.v1.UpdateUserSettingsResponse
+ */
+export type UpdateUserSettingsResponse =
+  Message<"api.v1.UpdateUserSettingsResponse"> & {
+    /**
+     * @generated from field: api.v1.UserSettings settings = 1;
+     */
+    settings?: UserSettings;
+  };
+
+/**
+ * Describes the message api.v1.UpdateUserSettingsResponse.
+ * Use `create(UpdateUserSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateUserSettingsResponseSchema: GenMessage<UpdateUserSettingsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 25);
+
+/**
+ * @generated from message api.v1.ListDeletedJobApplicationsRequest
+ */
+export type ListDeletedJobApplicationsRequest =
+  Message<"api.v1.ListDeletedJobApplicationsRequest"> & {
+    /**
+     * @generated from field: int32 page_size = 1;
+     */
+    pageSize: number;
+
+    /**
+     * @generated from field: string page_token = 2;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message api.v1.ListDeletedJobApplicationsRequest.
+ * Use `create(ListDeletedJobApplicationsRequestSchema)` to create a new message.
+ */
+export const ListDeletedJobApplicationsRequestSchema: GenMessage<ListDeletedJobApplicationsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 26);
+
+/**
+ * @generated from message api.v1.ListDeletedJobApplicationsResponse
+ */
+export type ListDeletedJobApplicationsResponse =
+  Message<"api.v1.ListDeletedJobApplicationsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.JobApplication job_applications = 1;
+     */
+    jobApplications: JobApplication[];
+
+    /**
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+  };
+
+/**
+ * Describes the message api.v1.ListDeletedJobApplicationsResponse.
+ * Use `create(ListDeletedJobApplicationsResponseSchema)` to create a new message.
+ */
+export const ListDeletedJobApplicationsResponseSchema: GenMessage<ListDeletedJobApplicationsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 27);
+
+/**
+ * @generated from message api.v1.RestoreJobApplicationRequest
+ */
+export type RestoreJobApplicationRequest =
+  Message<"api.v1.RestoreJobApplicationRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+
+    /**
+     * @generated from field: int64 version = 2;
+     */
+    version: bigint;
+  };
+
+/**
+ * Describes the message api.v1.RestoreJobApplicationRequest.
+ * Use `create(RestoreJobApplicationRequestSchema)` to create a new message.
+ */
+export const RestoreJobApplicationRequestSchema: GenMessage<RestoreJobApplicationRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 28);
+
+/**
+ * @generated from message api.v1.RestoreJobApplicationResponse
+ */
+export type RestoreJobApplicationResponse =
+  Message<"api.v1.RestoreJobApplicationResponse"> & {
+    /**
+     * @generated from field: api.v1.JobApplication job_application = 1;
+     */
+    jobApplication?: JobApplication;
+  };
+
+/**
+ * Describes the message api.v1.RestoreJobApplicationResponse.
+ * Use `create(RestoreJobApplicationResponseSchema)` to create a new message.
+ */
+export const RestoreJobApplicationResponseSchema: GenMessage<RestoreJobApplicationResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 29);
+
+/**
+ * @generated from message api.v1.Interview
+ */
+export type Interview = Message<"api.v1.Interview"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string job_application_id = 2;
+   */
+  jobApplicationId: string;
+
+  /**
+   * @generated from field: string round = 3;
+   */
+  round: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp scheduled_at = 4;
+   */
+  scheduledAt?: Timestamp;
+
+  /**
+   * @generated from field: string time_zone = 5;
+   */
+  timeZone: string;
+
+  /**
+   * @generated from field: google.protobuf.Duration duration = 6;
+   */
+  duration?: Duration;
+
+  /**
+   * @generated from field: repeated string interviewers = 7;
+   */
+  interviewers: string[];
+
+  /**
+   * @generated from field: api.v1.InterviewFormat format = 8;
+   */
+  format: InterviewFormat;
+
+  /**
+   * @generated from field: string location = 9;
+   */
+  location: string;
+
+  /**
+   * @generated from field: api.v1.InterviewOutcome outcome = 10;
+   */
+  outcome: InterviewOutcome;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 11;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 12;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.Interview.
+ * Use `create(InterviewSchema)` to create a new message.
+ */
+export const InterviewSchema: GenMessage<Interview> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 30);
+
+/**
+ * @generated from message api.v1.CreateInterviewRequest
+ */
+export type CreateInterviewRequest =
+  Message<"api.v1.CreateInterviewRequest"> & {
+    /**
+     * @generated from field: string job_application_id = 1;
+     */
+    jobApplicationId: string;
+
+    /**
+     * @generated from field: string round = 2;
+     */
+    round: string;
+
+    /**
+     * @generated from field: google.protobuf.Timestamp scheduled_at = 3;
+     */
+    scheduledAt?: Timestamp;
+
+    /**
+     * @generated from field: string time_zone = 4;
+     */
+    timeZone: string;
+
+    /**
+     * @generated from field: google.protobuf.Duration duration = 5;
+     */
+    duration?: Duration;
+
+    /**
+     * @generated from field: repeated string interviewers = 6;
+     */
+    interviewers: string[];
+
+    /**
+     * @generated from field: api.v1.InterviewFormat format = 7;
+     */
+    format: InterviewFormat;
+
+    /**
+     * @generated from field: string location = 8;
+     */
+    location: string;
+  };
+
+/**
+ * Describes the message api.v1.CreateInterviewRequest.
+ * Use `create(CreateInterviewRequestSchema)` to create a new message.
+ */
+export const CreateInterviewRequestSchema: GenMessage<CreateInterviewRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 31);
+
+/**
+ * @generated from message api.v1.CreateInterviewResponse
+ */
+export type CreateInterviewResponse =
+  Message<"api.v1.CreateInterviewResponse"> & {
+    /**
+     * @generated from field: api.v1.Interview interview = 1;
+     */
+    interview?: Interview;
+  };
+
+/**
+ * Describes the message api.v1.CreateInterviewResponse.
+ * Use `create(CreateInterviewResponseSchema)` to create a new message.
+ */
+export const CreateInterviewResponseSchema: GenMessage<CreateInterviewResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 32);
+
+/**
+ * @generated from message api.v1.ListInterviewsRequest
+ */
+export type ListInterviewsRequest = Message<"api.v1.ListInterviewsRequest"> & {
+  /**
+   * @generated from field: string job_application_id = 1;
+   */
+  jobApplicationId: string;
+};
+
+/**
+ * Describes the message api.v1.ListInterviewsRequest.
+ * Use `create(ListInterviewsRequestSchema)` to create a new message.
+ */
+export const ListInterviewsRequestSchema: GenMessage<ListInterviewsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 33);
+
+/**
+ * @generated from message api.v1.ListInterviewsResponse
+ */
+export type ListInterviewsResponse =
+  Message<"api.v1.ListInterviewsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.Interview interviews = 1;
+     */
+    interviews: Interview[];
+  };
+
+/**
+ * Describes the message api.v1.ListInterviewsResponse.
+ * Use `create(ListInterviewsResponseSchema)` to create a new message.
+ */
+export const ListInterviewsResponseSchema: GenMessage<ListInterviewsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 34);
+
+/**
+ * @generated from message api.v1.UpdateInterviewRequest
+ */
+export type UpdateInterviewRequest =
+  Message<"api.v1.UpdateInterviewRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+
+    /**
+     * @generated from field: string round = 2;
+     */
+    round: string;
+
+    /**
+     * @generated from field: google.protobuf.Timestamp scheduled_at = 3;
+     */
+    scheduledAt?: Timestamp;
+
+    /**
+     * @generated from field: string time_zone = 4;
+     */
+    timeZone: string;
+
+    /**
+     * @generated from field: google.protobuf.Duration duration = 5;
+     */
+    duration?: Duration;
+
+    /**
+     * @generated from field: repeated string interviewers = 6;
+     */
+    interviewers: string[];
+
+    /**
+     * @generated from field: api.v1.InterviewFormat format = 7;
+     */
+    format: InterviewFormat;
+
+    /**
+     * @generated from field: string location = 8;
+     */
+    location: string;
+
+    /**
+     * @generated from field: api.v1.InterviewOutcome outcome = 9;
+     */
+    outcome: InterviewOutcome;
+  };
+
+/**
+ * Describes the message api.v1.UpdateInterviewRequest.
+ * Use `create(UpdateInterviewRequestSchema)` to create a new message.
+ */
+export const UpdateInterviewRequestSchema: GenMessage<UpdateInterviewRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 35);
+
+/**
+ * @generated from message api.v1.UpdateInterviewResponse
+ */
+export type UpdateInterviewResponse =
+  Message<"api.v1.UpdateInterviewResponse"> & {
+    /**
+     * @generated from field: api.v1.Interview interview = 1;
+     */
+    interview?: Interview;
+  };
+
+/**
+ * Describes the message api.v1.UpdateInterviewResponse.
+ * Use `create(UpdateInterviewResponseSchema)` to create a new message.
+ */
+export const UpdateInterviewResponseSchema: GenMessage<UpdateInterviewResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 36);
+
+/**
+ * @generated from message api.v1.DeleteInterviewRequest
+ */
+export type DeleteInterviewRequest =
+  Message<"api.v1.DeleteInterviewRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+  };
+
+/**
+ * Describes the message api.v1.DeleteInterviewRequest.
+ * Use `create(DeleteInterviewRequestSchema)` to create a new message.
+ */
+export const DeleteInterviewRequestSchema: GenMessage<DeleteInterviewRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 37);
+
+/**
+ * @generated from message api.v1.DeleteInterviewResponse
+ */
+export type DeleteInterviewResponse =
+  Message<"api.v1.DeleteInterviewResponse"> & {};
+
+/**
+ * Describes the message api.v1.DeleteInterviewResponse.
+ * Use `create(DeleteInterviewResponseSchema)` to create a new message.
+ */
+export const DeleteInterviewResponseSchema: GenMessage<DeleteInterviewResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 38);
+
+/**
+ * @generated from message api.v1.ListUpcomingInterviewsRequest
+ */
+export type ListUpcomingInterviewsRequest =
+  Message<"api.v1.ListUpcomingInterviewsRequest"> & {
+    /**
+     * @generated from field: int32 page_size = 1;
+     */
+    pageSize: number;
+
+    /**
+     * @generated from field: string page_token = 2;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message api.v1.ListUpcomingInterviewsRequest.
+ * Use `create(ListUpcomingInterviewsRequestSchema)` to create a new message.
+ */
+export const ListUpcomingInterviewsRequestSchema: GenMessage<ListUpcomingInterviewsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 39);
+
+/**
+ * @generated from message api.v1.ListUpcomingInterviewsResponse
+ */
+export type ListUpcomingInterviewsResponse =
+  Message<"api.v1.ListUpcomingInterviewsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.UpcomingInterview interviews = 1;
+     */
+    interviews: UpcomingInterview[];
+
+    /**
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+  };
+
+/**
+ * Describes the message api.v1.ListUpcomingInterviewsResponse.
+ * Use `create(ListUpcomingInterviewsResponseSchema)` to create a new message.
+ */
+export const ListUpcomingInterviewsResponseSchema: GenMessage<ListUpcomingInterviewsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 40);
+
+/**
+ * @generated from message api.v1.UpcomingInterview
+ */
+export type UpcomingInterview = Message<"api.v1.UpcomingInterview"> & {
+  /**
+   * @generated from field: api.v1.Interview interview = 1;
+   */
+  interview?: Interview;
+
+  /**
+   * @generated from field: api.v1.JobApplication job_application = 2;
+   */
+  jobApplication?: JobApplication;
+};
+
+/**
+ * Describes the message api.v1.UpcomingInterview.
+ * Use `create(UpcomingInterviewSchema)` to create a new message.
+ */
+export const UpcomingInterviewSchema: GenMessage<UpcomingInterview> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 41);
+
+/**
+ * @generated from message api.v1.WatchJobApplicationsRequest
+ */
+export type WatchJobApplicationsRequest =
+  Message<"api.v1.WatchJobApplicationsRequest"> & {
+    /**
+     * @generated from field: string cursor = 1;
+     */
+    cursor: string;
+  };
+
+/**
+ * Describes the message api.v1.WatchJobApplicationsRequest.
+ * Use `create(WatchJobApplicationsRequestSchema)` to create a new message.
+ */
+export const WatchJobApplicationsRequestSchema: GenMessage<WatchJobApplicationsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 42);
+
+/**
+ * @generated from message api.v1.WatchJobApplicationsResponse
+ */
+export type WatchJobApplicationsResponse =
+  Message<"api.v1.WatchJobApplicationsResponse"> & {
+    /**
+     * @generated from field: api.v1.JobApplicationEvent event = 1;
+     */
+    event?: JobApplicationEvent;
+
+    /**
+     * @generated from field: api.v1.JobApplication job_application = 2;
+     */
+    jobApplication?: JobApplication;
+
+    /**
+     * @generated from field: string cursor = 3;
+     */
+    cursor: string;
+
+    /**
+     * @generated from field: bool heartbeat = 4;
+     */
+    heartbeat: boolean;
+  };
+
+/**
+ * Describes the message api.v1.WatchJobApplicationsResponse.
+ * Use `create(WatchJobApplicationsResponseSchema)` to create a new message.
+ */
+export const WatchJobApplicationsResponseSchema: GenMessage<WatchJobApplicationsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 43);
+
+/**
+ * @generated from message api.v1.Document
+ */
+export type Document = Message<"api.v1.Document"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: api.v1.DocumentKind kind = 2;
+   */
+  kind: DocumentKind;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string content_type = 4;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: int64 size = 5;
+   */
+  size: bigint;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: string label = 7;
+   */
+  label: string;
+
+  /**
+   * @generated from field: string text_preview = 8;
+   */
+  textPreview: string;
+};
+
+/**
+ * Describes the message api.v1.Document.
+ * Use `create(DocumentSchema)` to create a new message.
+ */
+export const DocumentSchema: GenMessage<Document> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 44);
+
+/**
+ * @generated from message api.v1.DocumentSummary
+ */
+export type DocumentSummary = Message<"api.v1.DocumentSummary"> & {
+  /**
+   * @generated from field: api.v1.Document document = 1;
+   */
+  document?: Document;
+
+  /**
+   * @generated from field: int32 application_count = 2;
+   */
+  applicationCount: number;
+};
+
+/**
+ * Describes the message api.v1.DocumentSummary.
+ * Use `create(DocumentSummarySchema)` to create a new message.
+ */
+export const DocumentSummarySchema: GenMessage<DocumentSummary> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 45);
+
+/**
+ * @generated from message api.v1.UploadDocumentRequest
+ */
+export type UploadDocumentRequest = Message<"api.v1.UploadDocumentRequest"> & {
+  /**
+   * @generated from field: api.v1.DocumentKind kind = 1;
+   */
+  kind: DocumentKind;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string content_type = 3;
+   */
+  contentType: string;
+
+  /**
+   * @generated from field: bytes content = 4;
+   */
+  content: Uint8Array;
+
+  /**
+   * @generated from field: string label = 5;
+   */
+  label: string;
+};
+
+/**
+ * Describes the message api.v1.UploadDocumentRequest.
+ * Use `create(UploadDocumentRequestSchema)` to create a new message.
+ */
+export const UploadDocumentRequestSchema: GenMessage<UploadDocumentRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 46);
+
+/**
+ * @generated from message api.v1.UploadDocumentResponse
+ */
+export type UploadDocumentResponse =
+  Message<"api.v1.UploadDocumentResponse"> & {
+    /**
+     * @generated from field: api.v1.Document document = 1;
+     */
+    document?: Document;
+  };
+
+/**
+ * Describes the message api.v1.UploadDocumentResponse.
+ * Use `create(UploadDocumentResponseSchema)` to create a new message.
+ */
+export const UploadDocumentResponseSchema: GenMessage<UploadDocumentResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 47);
+
+/**
+ * @generated from message api.v1.GetDocumentRequest
+ */
+export type GetDocumentRequest = Message<"api.v1.GetDocumentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.GetDocumentRequest.
+ * Use `create(GetDocumentRequestSchema)` to create a new message.
+ */
+export const GetDocumentRequestSchema: GenMessage<GetDocumentRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 48);
+
+/**
+ * @generated from message api.v1.GetDocumentResponse
+ */
+export type GetDocumentResponse = Message<"api.v1.GetDocumentResponse"> & {
+  /**
+   * @generated from field: api.v1.Document document = 1;
+   */
+  document?: Document;
+};
+
+/**
+ * Describes the message api.v1.GetDocumentResponse.
+ * Use `create(GetDocumentResponseSchema)` to create a new message.
+ */
+export const GetDocumentResponseSchema: GenMessage<GetDocumentResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 49);
+
+/**
+ * @generated from message api.v1.DownloadDocumentRequest
+ */
+export type DownloadDocumentRequest =
+  Message<"api.v1.DownloadDocumentRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+  };
+
+/**
+ * Describes the message api.v1.DownloadDocumentRequest.
+ * Use `create(DownloadDocumentRequestSchema)` to create a new message.
+ */
+export const DownloadDocumentRequestSchema: GenMessage<DownloadDocumentRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 50);
+
+/**
+ * @generated from message api.v1.DownloadDocumentResponse
+ */
+export type DownloadDocumentResponse =
+  Message<"api.v1.DownloadDocumentResponse"> & {
+    /**
+     * @generated from field: api.v1.Document document = 1;
+     */
+    document?: Document;
+
+    /**
+     * @generated from field: bytes content = 2;
+     */
+    content: Uint8Array;
+  };
+
+/**
+ * Describes the message api.v1.DownloadDocumentResponse.
+ * Use `create(DownloadDocumentResponseSchema)` to create a new message.
+ */
+export const DownloadDocumentResponseSchema: GenMessage<DownloadDocumentResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 51);
+
+/**
+ * @generated from message api.v1.DeleteDocumentRequest
+ */
+export type DeleteDocumentRequest = Message<"api.v1.DeleteDocumentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.DeleteDocumentRequest.
+ * Use `create(DeleteDocumentRequestSchema)` to create a new message.
+ */
+export const DeleteDocumentRequestSchema: GenMessage<DeleteDocumentRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 52);
+
+/**
+ * @generated from message api.v1.DeleteDocumentResponse
+ */
+export type DeleteDocumentResponse =
+  Message<"api.v1.DeleteDocumentResponse"> & {};
+
+/**
+ * Describes the message api.v1.DeleteDocumentResponse.
+ * Use `create(DeleteDocumentResponseSchema)` to create a new message.
+ */
+export const DeleteDocumentResponseSchema: GenMessage<DeleteDocumentResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 53);
+
+/**
+ * @generated from message api.v1.UpdateDocumentRequest
+ */
+export type UpdateDocumentRequest = Message<"api.v1.UpdateDocumentRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: api.v1.DocumentKind kind = 2;
+   */
+  kind: DocumentKind;
+
+  /**
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string label = 4;
+   */
+  label: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateDocumentRequest.
+ * Use `create(UpdateDocumentRequestSchema)` to create a new message.
+ */
+export const UpdateDocumentRequestSchema: GenMessage<UpdateDocumentRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 54);
+
+/**
+ * @generated from message api.v1.UpdateDocumentResponse
+ */
+export type UpdateDocumentResponse =
+  Message<"api.v1.UpdateDocumentResponse"> & {
+    /**
+     * @generated from field: api.v1.Document document = 1;
+     */
+    document?: Document;
+  };
+
+/**
+ * Describes the message api.v1.UpdateDocumentResponse.
+ * Use `create(UpdateDocumentResponseSchema)` to create a new message.
+ */
+export const UpdateDocumentResponseSchema: GenMessage<UpdateDocumentResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 55);
+
+/**
+ * @generated from message api.v1.ListDocumentsRequest
+ */
+export type ListDocumentsRequest = Message<"api.v1.ListDocumentsRequest"> & {
+  /**
+   * @generated from field: int32 page_size = 1;
+   */
+  pageSize: number;
+
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken: string;
+
+  /**
+   * @generated from field: api.v1.DocumentKind kind = 3;
+   */
+  kind: DocumentKind;
+};
+
+/**
+ * Describes the message api.v1.ListDocumentsRequest.
+ * Use `create(ListDocumentsRequestSchema)` to create a new message.
+ */
+export const ListDocumentsRequestSchema: GenMessage<ListDocumentsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 56);
+
+/**
+ * @generated from message api.v1.ListDocumentsResponse
+ */
+export type ListDocumentsResponse = Message<"api.v1.ListDocumentsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.DocumentSummary documents = 1;
+   */
+  documents: DocumentSummary[];
+
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken: string;
+};
+
+/**
+ * Describes the message api.v1.ListDocumentsResponse.
+ * Use `create(ListDocumentsResponseSchema)` to create a new message.
+ */
+export const ListDocumentsResponseSchema: GenMessage<ListDocumentsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 57);
+
+/**
+ * @generated from message api.v1.SearchDocumentsRequest
+ */
+export type SearchDocumentsRequest =
+  Message<"api.v1.SearchDocumentsRequest"> & {
+    /**
+     * @generated from field: string query = 1;
+     */
+    query: string;
+
+    /**
+     * @generated from field: api.v1.DocumentKind kind = 2;
+     */
+    kind: DocumentKind;
+
+    /**
+     * @generated from field: int32 page_size = 3;
+     */
+    pageSize: number;
+
+    /**
+     * @generated from field: string page_token = 4;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message api.v1.SearchDocumentsRequest.
+ * Use `create(SearchDocumentsRequestSchema)` to create a new message.
+ */
+export const SearchDocumentsRequestSchema: GenMessage<SearchDocumentsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 58);
+
+/**
+ * @generated from message api.v1.DocumentSearchResult
+ */
+export type DocumentSearchResult = Message<"api.v1.DocumentSearchResult"> & {
+  /**
+   * @generated from field: api.v1.Document document = 1;
+   */
+  document?: Document;
+
+  /**
+   * @generated from field: int32 application_count = 2;
+   */
+  applicationCount: number;
+
+  /**
+   * @generated from field: double rank = 3;
+   */
+  rank: number;
+
+  /**
+   * @generated from field: string snippet = 4;
+   */
+  snippet: string;
+};
+
+/**
+ * Describes the message api.v1.DocumentSearchResult.
+ * Use `create(DocumentSearchResultSchema)` to create a new message.
+ */
+export const DocumentSearchResultSchema: GenMessage<DocumentSearchResult> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 59);
+
+/**
+ * @generated from message api.v1.SearchDocumentsResponse
+ */
+export type SearchDocumentsResponse =
+  Message<"api.v1.SearchDocumentsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.DocumentSearchResult results = 1;
+     */
+    results: DocumentSearchResult[];
+
+    /**
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+  };
+
+/**
+ * Describes the message api.v1.SearchDocumentsResponse.
+ * Use `create(SearchDocumentsResponseSchema)` to create a new message.
+ */
+export const SearchDocumentsResponseSchema: GenMessage<SearchDocumentsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 60);
+
+/**
+ * @generated from message api.v1.ScoreJobApplicationRequest
+ */
+export type ScoreJobApplicationRequest =
+  Message<"api.v1.ScoreJobApplicationRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+  };
+
+/**
+ * Describes the message api.v1.ScoreJobApplicationRequest.
+ * Use `create(ScoreJobApplicationRequestSchema)` to create a new message.
+ */
+export const ScoreJobApplicationRequestSchema: GenMessage<ScoreJobApplicationRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 61);
+
+/**
+ * @generated from message api.v1.ScoreJobApplicationResponse
+ */
+export type ScoreJobApplicationResponse =
+  Message<"api.v1.ScoreJobApplicationResponse"> & {
+    /**
+     * @generated from field: api.v1.JobApplication job_application = 1;
+     */
+    jobApplication?: JobApplication;
+
+    /**
+     * @generated from field: int32 score = 2;
+     */
+    score: number;
+
+    /**
+     * @generated from field: repeated string matched_skills = 3;
+     */
+    matchedSkills: string[];
+
+    /**
+     * @generated from field: repeated string missing_skills = 4;
+     */
+    missingSkills: string[];
+
+    /**
+     * @generated from field: double keyword_overlap = 5;
+     */
+    keywordOverlap: number;
+  };
+
+/**
+ * Describes the message api.v1.ScoreJobApplicationResponse.
+ * Use `create(ScoreJobApplicationResponseSchema)` to create a new message.
+ */
+export const ScoreJobApplicationResponseSchema: GenMessage<ScoreJobApplicationResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 62);
+
+/**
+ * @generated from message api.v1.ImportColumnMapping
+ */
+export type ImportColumnMapping = Message<"api.v1.ImportColumnMapping"> & {
+  /**
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string column = 2;
+   */
+  column: string;
+};
+
+/**
+ * Describes the message api.v1.ImportColumnMapping.
+ * Use `create(ImportColumnMappingSchema)` to create a new message.
+ */
+export const ImportColumnMappingSchema: GenMessage<ImportColumnMapping> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 63);
+
+/**
+ * @generated from message api.v1.ImportJobApplicationsRequest
+ */
+export type ImportJobApplicationsRequest =
+  Message<"api.v1.ImportJobApplicationsRequest"> & {
+    /**
+     * @generated from field: api.v1.ImportFormat format = 1;
+     */
+    format: ImportFormat;
+
+    /**
+     * @generated from field: bytes content = 2;
+     */
+    content: Uint8Array;
+
+    /**
+     * @generated from field: repeated api.v1.ImportColumnMapping mapping = 3;
+     */
+    mapping: ImportColumnMapping[];
+
+    /**
+     * @generated from field: bool day_first = 4;
+     */
+    dayFirst: boolean;
+
+    /**
+     * @generated from field: bool dry_run = 5;
+     */
+    dryRun: boolean;
+  };
+
+/**
+ * Describes the message api.v1.ImportJobApplicationsRequest.
+ * Use `create(ImportJobApplicationsRequestSchema)` to create a new message.
+ */
+export const ImportJobApplicationsRequestSchema: GenMessage<ImportJobApplicationsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 64);
+
+/**
+ * @generated from message api.v1.ImportRowResult
+ */
+export type ImportRowResult = Message<"api.v1.ImportRowResult"> & {
+  /**
+   * @generated from field: int32 row = 1;
+   */
+  row: number;
+
+  /**
+   * @generated from field: string external_id = 2;
+   */
+  externalId: string;
+
+  /**
+   * @generated from field: api.v1.ImportRowOutcome outcome = 3;
+   */
+  outcome: ImportRowOutcome;
+
+  /**
+   * @generated from field: string field = 4;
+   */
+  field: string;
+
+  /**
+   * @generated from field: string error = 5;
+   */
+  error: string;
+
+  /**
+   * @generated from field: string job_application_id = 6;
+   */
+  jobApplicationId: string;
+};
+
+/**
+ * Describes the message api.v1.ImportRowResult.
+ * Use `create(ImportRowResultSchema)` to create a new message.
+ */
+export const ImportRowResultSchema: GenMessage<ImportRowResult> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 65);
+
+/**
+ * @generated from message api.v1.ImportJobApplicationsResponse
+ */
+export type ImportJobApplicationsResponse =
+  Message<"api.v1.ImportJobApplicationsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.ImportRowResult results = 1;
+     */
+    results: ImportRowResult[];
+
+    /**
+     * @generated from field: int32 created_count = 2;
+     */
+    createdCount: number;
+
+    /**
+     * @generated from field: int32 skipped_count = 3;
+     */
+    skippedCount: number;
+
+    /**
+     * @generated from field: int32 invalid_count = 4;
+     */
+    invalidCount: number;
+
+    /**
+     * @generated from field: bool committed = 5;
+     */
+    committed: boolean;
+  };
+
+/**
+ * Describes the message api.v1.ImportJobApplicationsResponse.
+ * Use `create(ImportJobApplicationsResponseSchema)` to create a new message.
+ */
+export const ImportJobApplicationsResponseSchema: GenMessage<ImportJobApplicationsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 66);
+
+/**
+ * @generated from message api.v1.ExportJobApplicationsRequest
+ */
+export type ExportJobApplicationsRequest =
+  Message<"api.v1.ExportJobApplicationsRequest"> & {
+    /**
+     * @generated from field: api.v1.ExportFormat format = 1;
+     */
+    format: ExportFormat;
+  };
+
+/**
+ * Describes the message api.v1.ExportJobApplicationsRequest.
+ * Use `create(ExportJobApplicationsRequestSchema)` to create a new message.
+ */
+export const ExportJobApplicationsRequestSchema: GenMessage<ExportJobApplicationsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 67);
+
+/**
+ * @generated from message api.v1.ExportJobApplicationsResponse
+ */
+export type ExportJobApplicationsResponse =
+  Message<"api.v1.ExportJobApplicationsResponse"> & {
+    /**
+     * @generated from field: bytes data = 1;
+     */
+    data: Uint8Array;
+
+    /**
+     * @generated from field: string content_type = 2;
+     */
+    contentType: string;
+
+    /**
+     * @generated from field: string file_name = 3;
+     */
+    fileName: string;
+  };
+
+/**
+ * Describes the message api.v1.ExportJobApplicationsResponse.
+ * Use `create(ExportJobApplicationsResponseSchema)` to create a new message.
+ */
+export const ExportJobApplicationsResponseSchema: GenMessage<ExportJobApplicationsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 68);
+
+/**
+ * @generated from message api.v1.GetPipelineStatsRequest
+ */
+export type GetPipelineStatsRequest =
+  Message<"api.v1.GetPipelineStatsRequest"> & {
+    /**
+     * @generated from field: google.protobuf.Timestamp applied_on_from = 1;
+     */
+    appliedOnFrom?: Timestamp;
+
+    /**
+     * @generated from field: google.protobuf.Timestamp applied_on_to = 2;
+     */
+    appliedOnTo?: Timestamp;
+  };
+
+/**
+ * Describes the message api.v1.GetPipelineStatsRequest.
+ * Use `create(GetPipelineStatsRequestSchema)` to create a new message.
+ */
+export const GetPipelineStatsRequestSchema: GenMessage<GetPipelineStatsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 69);
+
+/**
+ * @generated from message api.v1.StatusCount
+ */
+export type StatusCount = Message<"api.v1.StatusCount"> & {
+  /**
+   * @generated from field: api.v1.JobApplicationStatus status = 1;
+   */
+  status: JobApplicationStatus;
+
+  /**
+   * @generated from field: int32 count = 2;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message api.v1.StatusCount.
+ * Use `create(StatusCountSchema)` to create a new message.
+ */
+export const StatusCountSchema: GenMessage<StatusCount> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 70);
+
+/**
+ * @generated from message api.v1.StageConversion
+ */
+export type StageConversion = Message<"api.v1.StageConversion"> & {
+  /**
+   * @generated from field: api.v1.JobApplicationStatus from_status = 1;
+   */
+  fromStatus: JobApplicationStatus;
+
+  /**
+   * @generated from field: api.v1.JobApplicationStatus to_status = 2;
+   */
+  toStatus: JobApplicationStatus;
+
+  /**
+   * @generated from field: int32 from_count = 3;
+   */
+  fromCount: number;
+
+  /**
+   * @generated from field: int32 to_count = 4;
+   */
+  toCount: number;
+
+  /**
+   * @generated from field: double rate = 5;
+   */
+  rate: number;
+};
+
+/**
+ * Describes the message api.v1.StageConversion.
+ * Use `create(StageConversionSchema)` to create a new message.
+ */
+export const StageConversionSchema: GenMessage<StageConversion> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 71);
+
+/**
+ * @generated from message api.v1.StageDuration
+ */
+export type StageDuration = Message<"api.v1.StageDuration"> & {
+  /**
+   * @generated from field: api.v1.JobApplicationStatus status = 1;
+   */
+  status: JobApplicationStatus;
+
+  /**
+   * @generated from field: double median_days = 2;
+   */
+  medianDays: number;
+
+  /**
+   * @generated from field: int32 count = 3;
+   */
+  count: number;
+};
+
+/**
+ * Describes the message api.v1.StageDuration.
+ * Use `create(StageDurationSchema)` to create a new message.
+ */
+export const StageDurationSchema: GenMessage<StageDuration> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 72);
+
+/**
+ * @generated from message api.v1.WeeklyResponseRate
+ */
+export type WeeklyResponseRate = Message<"api.v1.WeeklyResponseRate"> & {
+  /**
+   * @generated from field: google.protobuf.Timestamp week_start = 1;
+   */
+  weekStart?: Timestamp;
+
+  /**
+   * @generated from field: int32 applied_count = 2;
+   */
+  appliedCount: number;
+
+  /**
+   * @generated from field: int32 responded_count = 3;
+   */
+  respondedCount: number;
+
+  /**
+   * @generated from field: double rate = 4;
+   */
+  rate: number;
+};
+
+/**
+ * Describes the message api.v1.WeeklyResponseRate.
+ * Use `create(WeeklyResponseRateSchema)` to create a new message.
+ */
+export const WeeklyResponseRateSchema: GenMessage<WeeklyResponseRate> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 73);
+
+/**
+ * @generated from message api.v1.GetPipelineStatsResponse
+ */
+export type GetPipelineStatsResponse =
+  Message<"api.v1.GetPipelineStatsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.StatusCount status_counts = 1;
+     */
+    statusCounts: StatusCount[];
+
+    /**
+     * @generated from field: repeated api.v1.StageConversion conversions = 2;
+     */
+    conversions: StageConversion[];
+
+    /**
+     * @generated from field: repeated api.v1.StageDuration time_in_stage = 3;
+     */
+    timeInStage: StageDuration[];
+
+    /**
+     * @generated from field: repeated api.v1.WeeklyResponseRate weekly_response_rates = 4;
+     */
+    weeklyResponseRates: WeeklyResponseRate[];
+  };
+
+/**
+ * Describes the message api.v1.GetPipelineStatsResponse.
+ * Use `create(GetPipelineStatsResponseSchema)` to create a new message.
+ */
+export const GetPipelineStatsResponseSchema: GenMessage<GetPipelineStatsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 74);
+
+/**
+ * @generated from message api.v1.ListDueFollowUpsRequest
+ */
+export type ListDueFollowUpsRequest =
+  Message<"api.v1.ListDueFollowUpsRequest"> & {};
+
+/**
+ * Describes the message api.v1.ListDueFollowUpsRequest.
+ * Use `create(ListDueFollowUpsRequestSchema)` to create a new message.
+ */
+export const ListDueFollowUpsRequestSchema: GenMessage<ListDueFollowUpsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 75);
+
+/**
+ * @generated from message api.v1.DueFollowUp
+ */
+export type DueFollowUp = Message<"api.v1.DueFollowUp"> & {
+  /**
+   * @generated from field: api.v1.JobApplication job_application = 1;
+   */
+  jobApplication?: JobApplication;
+
+  /**
+   * @generated from field: api.v1.FollowUpReason reason = 2;
+   */
+  reason: FollowUpReason;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp due_at = 3;
+   */
+  dueAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.DueFollowUp.
+ * Use `create(DueFollowUpSchema)` to create a new message.
+ */
+export const DueFollowUpSchema: GenMessage<DueFollowUp> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 76);
+
+/**
+ * @generated from message api.v1.ListDueFollowUpsResponse
+ */
+export type ListDueFollowUpsResponse =
+  Message<"api.v1.ListDueFollowUpsResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.DueFollowUp follow_ups = 1;
+     */
+    followUps: DueFollowUp[];
+  };
+
+/**
+ * Describes the message api.v1.ListDueFollowUpsResponse.
+ * Use `create(ListDueFollowUpsResponseSchema)` to create a new message.
+ */
+export const ListDueFollowUpsResponseSchema: GenMessage<ListDueFollowUpsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 77);
+
+/**
+ * @generated from message api.v1.UnsubscribeRequest
+ */
+export type UnsubscribeRequest = Message<"api.v1.UnsubscribeRequest"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message api.v1.UnsubscribeRequest.
+ * Use `create(UnsubscribeRequestSchema)` to create a new message.
+ */
+export const UnsubscribeRequestSchema: GenMessage<UnsubscribeRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 78);
+
+/**
+ * @generated from message api.v1.UnsubscribeResponse
+ */
+export type UnsubscribeResponse = Message<"api.v1.UnsubscribeResponse"> & {};
+
+/**
+ * Describes the message api.v1.UnsubscribeResponse.
+ * Use `create(UnsubscribeResponseSchema)` to create a new message.
+ */
+export const UnsubscribeResponseSchema: GenMessage<UnsubscribeResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 79);
+
+/**
+ * @generated from message api.v1.Company
+ */
+export type Company = Message<"api.v1.Company"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue website = 3;
+   */
+  website?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue location = 4;
+   */
+  location?: string;
+
+  /**
+   * @generated from field: api.v1.CompanySize size = 5;
+   */
+  size: CompanySize;
+
+  /**
+   * @generated from field: google.protobuf.StringValue industry = 6;
+   */
+  industry?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue notes = 7;
+   */
+  notes?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.Company.
+ * Use `create(CompanySchema)` to create a new message.
+ */
+export const CompanySchema: GenMessage<Company> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 80);
+
+/**
+ * @generated from message api.v1.CreateCompanyRequest
+ */
+export type CreateCompanyRequest = Message<"api.v1.CreateCompanyRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue website = 2;
+   */
+  website?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue location = 3;
+   */
+  location?: string;
+
+  /**
+   * @generated from field: api.v1.CompanySize size = 4;
+   */
+  size: CompanySize;
+
+  /**
+   * @generated from field: google.protobuf.StringValue industry = 5;
+   */
+  industry?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue notes = 6;
+   */
+  notes?: string;
+};
+
+/**
+ * Describes the message api.v1.CreateCompanyRequest.
+ * Use `create(CreateCompanyRequestSchema)` to create a new message.
+ */
+export const CreateCompanyRequestSchema: GenMessage<CreateCompanyRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 81);
+
+/**
+ * @generated from message api.v1.CreateCompanyResponse
+ */
+export type CreateCompanyResponse = Message<"api.v1.CreateCompanyResponse"> & {
+  /**
+   * @generated from field: api.v1.Company company = 1;
+   */
+  company?: Company;
+};
+
+/**
+ * Describes the message api.v1.CreateCompanyResponse.
+ * Use `create(CreateCompanyResponseSchema)` to create a new message.
+ */
+export const CreateCompanyResponseSchema: GenMessage<CreateCompanyResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 82);
+
+/**
+ * @generated from message api.v1.UpdateCompanyRequest
+ */
+export type UpdateCompanyRequest = Message<"api.v1.UpdateCompanyRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue website = 3;
+   */
+  website?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue location = 4;
+   */
+  location?: string;
+
+  /**
+   * @generated from field: api.v1.CompanySize size = 5;
+   */
+  size: CompanySize;
+
+  /**
+   * @generated from field: google.protobuf.StringValue industry = 6;
+   */
+  industry?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue notes = 7;
+   */
+  notes?: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateCompanyRequest.
+ * Use `create(UpdateCompanyRequestSchema)` to create a new message.
+ */
+export const UpdateCompanyRequestSchema: GenMessage<UpdateCompanyRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 83);
+
+/**
+ * @generated from message api.v1.UpdateCompanyResponse
+ */
+export type UpdateCompanyResponse = Message<"api.v1.UpdateCompanyResponse"> & {
+  /**
+   * @generated from field: api.v1.Company company = 1;
+   */
+  company?: Company;
+};
+
+/**
+ * Describes the message api.v1.UpdateCompanyResponse.
+ * Use `create(UpdateCompanyResponseSchema)` to create a new message.
+ */
+export const UpdateCompanyResponseSchema: GenMessage<UpdateCompanyResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 84);
+
+/**
+ * @generated from message api.v1.ListCompaniesRequest
+ */
+export type ListCompaniesRequest = Message<"api.v1.ListCompaniesRequest"> & {};
+
+/**
+ * Describes the message api.v1.ListCompaniesRequest.
+ * Use `create(ListCompaniesRequestSchema)` to create a new message.
+ */
+export const ListCompaniesRequestSchema: GenMessage<ListCompaniesRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 85);
+
+/**
+ * @generated from message api.v1.CompanySummary
+ */
+export type CompanySummary = Message<"api.v1.CompanySummary"> & {
+  /**
+   * @generated from field: api.v1.Company company = 1;
+   */
+  company?: Company;
+
+  /**
+   * @generated from field: int32 application_count = 2;
+   */
+  applicationCount: number;
+
+  /**
+   * @generated from field: repeated api.v1.StatusCount status_counts = 3;
+   */
+  statusCounts: StatusCount[];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_applied_on = 4;
+   */
+  lastAppliedOn?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.CompanySummary.
+ * Use `create(CompanySummarySchema)` to create a new message.
+ */
+export const CompanySummarySchema: GenMessage<CompanySummary> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 86);
+
+/**
+ * @generated from message api.v1.ListCompaniesResponse
+ */
+export type ListCompaniesResponse = Message<"api.v1.ListCompaniesResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.CompanySummary companies = 1;
+   */
+  companies: CompanySummary[];
+};
+
+/**
+ * Describes the message api.v1.ListCompaniesResponse.
+ * Use `create(ListCompaniesResponseSchema)` to create a new message.
+ */
+export const ListCompaniesResponseSchema: GenMessage<ListCompaniesResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 87);
+
+/**
+ * @generated from message api.v1.Contact
+ */
+export type Contact = Message<"api.v1.Contact"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue role = 3;
+   */
+  role?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue email = 4;
+   */
+  email?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue phone = 5;
+   */
+  phone?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue linkedin_url = 6;
+   */
+  linkedinUrl?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue company_id = 7;
+   */
+  companyId?: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 8;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 9;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message api.v1.Contact.
+ * Use `create(ContactSchema)` to create a new message.
+ */
+export const ContactSchema: GenMessage<Contact> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 88);
+
+/**
+ * @generated from message api.v1.CreateContactRequest
+ */
+export type CreateContactRequest = Message<"api.v1.CreateContactRequest"> & {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue role = 2;
+   */
+  role?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue email = 3;
+   */
+  email?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue phone = 4;
+   */
+  phone?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue linkedin_url = 5;
+   */
+  linkedinUrl?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue company_id = 6;
+   */
+  companyId?: string;
+};
+
+/**
+ * Describes the message api.v1.CreateContactRequest.
+ * Use `create(CreateContactRequestSchema)` to create a new message.
+ */
+export const CreateContactRequestSchema: GenMessage<CreateContactRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 89);
+
+/**
+ * @generated from message api.v1.CreateContactResponse
+ */
+export type CreateContactResponse = Message<"api.v1.CreateContactResponse"> & {
+  /**
+   * @generated from field: api.v1.Contact contact = 1;
+   */
+  contact?: Contact;
+};
+
+/**
+ * Describes the message api.v1.CreateContactResponse.
+ * Use `create(CreateContactResponseSchema)` to create a new message.
+ */
+export const CreateContactResponseSchema: GenMessage<CreateContactResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 90);
+
+/**
+ * @generated from message api.v1.GetContactRequest
+ */
+export type GetContactRequest = Message<"api.v1.GetContactRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.GetContactRequest.
+ * Use `create(GetContactRequestSchema)` to create a new message.
+ */
+export const GetContactRequestSchema: GenMessage<GetContactRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 91);
+
+/**
+ * @generated from message api.v1.GetContactResponse
+ */
+export type GetContactResponse = Message<"api.v1.GetContactResponse"> & {
+  /**
+   * @generated from field: api.v1.Contact contact = 1;
+   */
+  contact?: Contact;
+};
+
+/**
+ * Describes the message api.v1.GetContactResponse.
+ * Use `create(GetContactResponseSchema)` to create a new message.
+ */
+export const GetContactResponseSchema: GenMessage<GetContactResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 92);
+
+/**
+ * @generated from message api.v1.UpdateContactRequest
+ */
+export type UpdateContactRequest = Message<"api.v1.UpdateContactRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue role = 3;
+   */
+  role?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue email = 4;
+   */
+  email?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue phone = 5;
+   */
+  phone?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue linkedin_url = 6;
+   */
+  linkedinUrl?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue company_id = 7;
+   */
+  companyId?: string;
+};
+
+/**
+ * Describes the message api.v1.UpdateContactRequest.
+ * Use `create(UpdateContactRequestSchema)` to create a new message.
+ */
+export const UpdateContactRequestSchema: GenMessage<UpdateContactRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 93);
+
+/**
+ * @generated from message api.v1.UpdateContactResponse
+ */
+export type UpdateContactResponse = Message<"api.v1.UpdateContactResponse"> & {
+  /**
+   * @generated from field: api.v1.Contact contact = 1;
+   */
+  contact?: Contact;
+};
+
+/**
+ * Describes the message api.v1.UpdateContactResponse.
+ * Use `create(UpdateContactResponseSchema)` to create a new message.
+ */
+export const UpdateContactResponseSchema: GenMessage<UpdateContactResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 94);
+
+/**
+ * @generated from message api.v1.DeleteContactRequest
+ */
+export type DeleteContactRequest = Message<"api.v1.DeleteContactRequest"> & {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message api.v1.DeleteContactRequest.
+ * Use `create(DeleteContactRequestSchema)` to create a new message.
+ */
+export const DeleteContactRequestSchema: GenMessage<DeleteContactRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 95);
+
+/**
+ * @generated from message api.v1.DeleteContactResponse
+ */
+export type DeleteContactResponse =
+  Message<"api.v1.DeleteContactResponse"> & {};
+
+/**
+ * Describes the message api.v1.DeleteContactResponse.
+ * Use `create(DeleteContactResponseSchema)` to create a new message.
+ */
+export const DeleteContactResponseSchema: GenMessage<DeleteContactResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 96);
+
+/**
+ * @generated from message api.v1.ListContactsRequest
+ */
+export type ListContactsRequest = Message<"api.v1.ListContactsRequest"> & {
+  /**
+   * @generated from field: google.protobuf.StringValue company_id = 1;
+   */
+  companyId?: string;
+
+  /**
+   * @generated from field: google.protobuf.StringValue job_application_id = 2;
+   */
+  jobApplicationId?: string;
+};
+
+/**
+ * Describes the message api.v1.ListContactsRequest.
+ * Use `create(ListContactsRequestSchema)` to create a new message.
+ */
+export const ListContactsRequestSchema: GenMessage<ListContactsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 97);
+
+/**
+ * @generated from message api.v1.ListContactsResponse
+ */
+export type ListContactsResponse = Message<"api.v1.ListContactsResponse"> & {
+  /**
+   * @generated from field: repeated api.v1.Contact contacts = 1;
+   */
+  contacts: Contact[];
+};
+
+/**
+ * Describes the message api.v1.ListContactsResponse.
+ * Use `create(ListContactsResponseSchema)` to create a new message.
+ */
+export const ListContactsResponseSchema: GenMessage<ListContactsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 98);
+
+/**
+ * @generated from message api.v1.LinkContactRequest
+ */
+export type LinkContactRequest = Message<"api.v1.LinkContactRequest"> & {
+  /**
+   * @generated from field: string contact_id = 1;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string job_application_id = 2;
+   */
+  jobApplicationId: string;
+};
+
+/**
+ * Describes the message api.v1.LinkContactRequest.
+ * Use `create(LinkContactRequestSchema)` to create a new message.
+ */
+export const LinkContactRequestSchema: GenMessage<LinkContactRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 99);
+
+/**
+ * @generated from message api.v1.LinkContactResponse
+ */
+export type LinkContactResponse = Message<"api.v1.LinkContactResponse"> & {};
+
+/**
+ * Describes the message api.v1.LinkContactResponse.
+ * Use `create(LinkContactResponseSchema)` to create a new message.
+ */
+export const LinkContactResponseSchema: GenMessage<LinkContactResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 100);
+
+/**
+ * @generated from message api.v1.UnlinkContactRequest
+ */
+export type UnlinkContactRequest = Message<"api.v1.UnlinkContactRequest"> & {
+  /**
+   * @generated from field: string contact_id = 1;
+   */
+  contactId: string;
+
+  /**
+   * @generated from field: string job_application_id = 2;
+   */
+  jobApplicationId: string;
+};
+
+/**
+ * Describes the message api.v1.UnlinkContactRequest.
+ * Use `create(UnlinkContactRequestSchema)` to create a new message.
+ */
+export const UnlinkContactRequestSchema: GenMessage<UnlinkContactRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 101);
+
+/**
+ * @generated from message api.v1.UnlinkContactResponse
+ */
+export type UnlinkContactResponse =
+  Message<"api.v1.UnlinkContactResponse"> & {};
+
+/**
+ * Describes the message api.v1.UnlinkContactResponse.
+ * Use `create(UnlinkContactResponseSchema)` to create a new message.
+ */
+export const UnlinkContactResponseSchema: GenMessage<UnlinkContactResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 102);
+
+/**
+ * @generated from message api.v1.GetContactTimelineRequest
+ */
+export type GetContactTimelineRequest =
+  Message<"api.v1.GetContactTimelineRequest"> & {
+    /**
+     * @generated from field: string id = 1;
+     */
+    id: string;
+  };
+
+/**
+ * Describes the message api.v1.GetContactTimelineRequest.
+ * Use `create(GetContactTimelineRequestSchema)` to create a new message.
+ */
+export const GetContactTimelineRequestSchema: GenMessage<GetContactTimelineRequest> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 103);
+
+/**
+ * @generated from message api.v1.ContactTimelineEntry
+ */
+export type ContactTimelineEntry = Message<"api.v1.ContactTimelineEntry"> & {
+  /**
+   * @generated from field: api.v1.JobApplication job_application = 1;
+   */
+  jobApplication?: JobApplication;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp linked_at = 2;
+   */
+  linkedAt?: Timestamp;
+
+  /**
+   * @generated from field: repeated api.v1.JobApplicationEvent events = 3;
+   */
+  events: JobApplicationEvent[];
+};
+
+/**
+ * Describes the message api.v1.ContactTimelineEntry.
+ * Use `create(ContactTimelineEntrySchema)` to create a new message.
+ */
+export const ContactTimelineEntrySchema: GenMessage<ContactTimelineEntry> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 104);
+
+/**
+ * @generated from message api.v1.GetContactTimelineResponse
+ */
+export type GetContactTimelineResponse =
+  Message<"api.v1.GetContactTimelineResponse"> & {
+    /**
+     * @generated from field: repeated api.v1.ContactTimelineEntry entries = 1;
+     */
+    entries: ContactTimelineEntry[];
+  };
+
+/**
+ * Describes the message api.v1.GetContactTimelineResponse.
+ * Use `create(GetContactTimelineResponseSchema)` to create a new message.
+ */
+export const GetContactTimelineResponseSchema: GenMessage<GetContactTimelineResponse> =
+  /*@__PURE__*/
+  messageDesc(file_api_v1_api, 105);
+
+/**
+ * @generated from enum api.v1.JobApplicationSortKey
+ */
+export enum JobApplicationSortKey {
+  /**
+   * @generated from enum value: JOB_APPLICATION_SORT_KEY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_SORT_KEY_APPLIED_ON = 1;
+   */
+  APPLIED_ON = 1,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_SORT_KEY_CREATED_AT = 2;
+   */
+  CREATED_AT = 2,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_SORT_KEY_UPDATED_AT = 3;
+   */
+  UPDATED_AT = 3,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_SORT_KEY_COMPANY = 4;
+   */
+  COMPANY = 4,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_SORT_KEY_MATCH_SCORE = 5;
+   */
+  MATCH_SCORE = 5,
+}
+
+/**
+ * Describes the enum api.v1.JobApplicationSortKey.
+ */
+export const JobApplicationSortKeySchema: GenEnum<JobApplicationSortKey> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 0);
+
+/**
+ * @generated from enum api.v1.JobApplicationStatus
+ */
+export enum JobApplicationStatus {
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_APPLIED = 1;
+   */
+  APPLIED = 1,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_SCREENING = 2;
+   */
+  SCREENING = 2,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_INTERVIEW = 3;
+   */
+  INTERVIEW = 3,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_OFFER = 4;
+   */
+  OFFER = 4,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_REJECTED = 5;
+   */
+  REJECTED = 5,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_WITHDRAWN = 6;
+   */
+  WITHDRAWN = 6,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_STATUS_ACCEPTED = 7;
+   */
+  ACCEPTED = 7,
+}
+
+/**
+ * Describes the enum api.v1.JobApplicationStatus.
+ */
+export const JobApplicationStatusSchema: GenEnum<JobApplicationStatus> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 1);
+
+/**
+ * @generated from enum api.v1.JobApplicationEventType
+ */
+export enum JobApplicationEventType {
+  /**
+   * @generated from enum value: JOB_APPLICATION_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_EVENT_TYPE_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_EVENT_TYPE_UPDATED = 2;
+   */
+  UPDATED = 2,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_EVENT_TYPE_STATUS_CHANGED = 3;
+   */
+  STATUS_CHANGED = 3,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_EVENT_TYPE_DELETED = 4;
+   */
+  DELETED = 4,
+
+  /**
+   * @generated from enum value: JOB_APPLICATION_EVENT_TYPE_RESTORED = 5;
+   */
+  RESTORED = 5,
+}
+
+/**
+ * Describes the enum api.v1.JobApplicationEventType.
+ */
+export const JobApplicationEventTypeSchema: GenEnum<JobApplicationEventType> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 2);
+
+/**
+ * @generated from enum api.v1.InterviewFormat
+ */
+export enum InterviewFormat {
+  /**
+   * @generated from enum value: INTERVIEW_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INTERVIEW_FORMAT_PHONE = 1;
+   */
+  PHONE = 1,
+
+  /**
+   * @generated from enum value: INTERVIEW_FORMAT_VIDEO = 2;
+   */
+  VIDEO = 2,
+
+  /**
+   * @generated from enum value: INTERVIEW_FORMAT_ONSITE = 3;
+   */
+  ONSITE = 3,
+}
+
+/**
+ * Describes the enum api.v1.InterviewFormat.
+ */
+export const InterviewFormatSchema: GenEnum<InterviewFormat> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 3);
+
+/**
+ * @generated from enum api.v1.InterviewOutcome
+ */
+export enum InterviewOutcome {
+  /**
+   * @generated from enum value: INTERVIEW_OUTCOME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: INTERVIEW_OUTCOME_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * @generated from enum value: INTERVIEW_OUTCOME_PASSED = 2;
+   */
+  PASSED = 2,
+
+  /**
+   * @generated from enum value: INTERVIEW_OUTCOME_FAILED = 3;
+   */
+  FAILED = 3,
+
+  /**
+   * @generated from enum value: INTERVIEW_OUTCOME_CANCELLED = 4;
+   */
+  CANCELLED = 4,
+}
+
+/**
+ * Describes the enum api.v1.InterviewOutcome.
+ */
+export const InterviewOutcomeSchema: GenEnum<InterviewOutcome> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 4);
+
+/**
+ * @generated from enum api.v1.DocumentKind
+ */
+export enum DocumentKind {
+  /**
+   * @generated from enum value: DOCUMENT_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DOCUMENT_KIND_CV = 1;
+   */
+  CV = 1,
+
+  /**
+   * @generated from enum value: DOCUMENT_KIND_COVER_LETTER = 2;
+   */
+  COVER_LETTER = 2,
+}
+
+/**
+ * Describes the enum api.v1.DocumentKind.
+ */
+export const DocumentKindSchema: GenEnum<DocumentKind> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 5);
+
+/**
+ * @generated from enum api.v1.ImportFormat
+ */
+export enum ImportFormat {
+  /**
+   * @generated from enum value: IMPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * @generated from enum value: IMPORT_FORMAT_JSON = 2;
+   */
+  JSON = 2,
+}
+
+/**
+ * Describes the enum api.v1.ImportFormat.
+ */
+export const ImportFormatSchema: GenEnum<ImportFormat> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 6);
+
+/**
+ * @generated from enum api.v1.ImportRowOutcome
+ */
+export enum ImportRowOutcome {
+  /**
+   * @generated from enum value: IMPORT_ROW_OUTCOME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: IMPORT_ROW_OUTCOME_CREATED = 1;
+   */
+  CREATED = 1,
+
+  /**
+   * @generated from enum value: IMPORT_ROW_OUTCOME_SKIPPED = 2;
+   */
+  SKIPPED = 2,
+
+  /**
+   * @generated from enum value: IMPORT_ROW_OUTCOME_INVALID = 3;
+   */
+  INVALID = 3,
+}
+
+/**
+ * Describes the enum api.v1.ImportRowOutcome.
+ */
+export const ImportRowOutcomeSchema: GenEnum<ImportRowOutcome> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 7);
+
+/**
+ * @generated from enum api.v1.ExportFormat
+ */
+export enum ExportFormat {
+  /**
+   * @generated from enum value: EXPORT_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_CSV = 1;
+   */
+  CSV = 1,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_NDJSON = 2;
+   */
+  NDJSON = 2,
+
+  /**
+   * @generated from enum value: EXPORT_FORMAT_ICS = 3;
+   */
+  ICS = 3,
+}
+
+/**
+ * Describes the enum api.v1.ExportFormat.
+ */
+export const ExportFormatSchema: GenEnum<ExportFormat> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 8);
+
+/**
+ * @generated from enum api.v1.FollowUpReason
+ */
+export enum FollowUpReason {
+  /**
+   * @generated from enum value: FOLLOW_UP_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: FOLLOW_UP_REASON_SCHEDULED = 1;
+   */
+  SCHEDULED = 1,
+
+  /**
+   * @generated from enum value: FOLLOW_UP_REASON_GHOSTED = 2;
+   */
+  GHOSTED = 2,
+}
+
+/**
+ * Describes the enum api.v1.FollowUpReason.
+ */
+export const FollowUpReasonSchema: GenEnum<FollowUpReason> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 9);
+
+/**
+ * @generated from enum api.v1.CompanySize
+ */
+export enum CompanySize {
+  /**
+   * @generated from enum value: COMPANY_SIZE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: COMPANY_SIZE_1_10 = 1;
+   */
+  1_10 = 1,
+
+  /**
+   * @generated from enum value: COMPANY_SIZE_11_50 = 2;
+   */
+  11_50 = 2,
+
+  /**
+   * @generated from enum value: COMPANY_SIZE_51_200 = 3;
+   */
+  51_200 = 3,
+
+  /**
+   * @generated from enum value: COMPANY_SIZE_201_1000 = 4;
+   */
+  201_1000 = 4,
+
+  /**
+   * @generated from enum value: COMPANY_SIZE_1001_5000 = 5;
+   */
+  1001_5000 = 5,
+
+  /**
+   * @generated from enum value: COMPANY_SIZE_5001_PLUS = 6;
+   */
+  5001_PLUS = 6,
+}
+
+/**
+ * Describes the enum api.v1.CompanySize.
+ */
+export const CompanySizeSchema: GenEnum<CompanySize> =
+  /*@__PURE__*/
+  enumDesc(file_api_v1_api, 10);
+
+/**
+ * @generated from service api.v1.Service
+ */
+export const Service: GenService<{
+  /**
+   * @generated from rpc api.v1.Service.CreateJobApplication
+   */
+  createJobApplication: {
+    methodKind: "unary";
+    input: typeof CreateJobApplicationRequestSchema;
+    output: typeof CreateJobApplicationResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListJobApplications
+   */
+  listJobApplications: {
+    methodKind: "unary";
+    input: typeof ListJobApplicationsRequestSchema;
+    output: typeof ListJobApplicationsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UpdateJobApplication
+   */
+  updateJobApplication: {
+    methodKind: "unary";
+    input: typeof UpdateJobApplicationRequestSchema;
+    output: typeof UpdateJobApplicationResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.DeleteJobApplication
+   */
+  deleteJobApplication: {
+    methodKind: "unary";
+    input: typeof DeleteJobApplicationRequestSchema;
+    output: typeof DeleteJobApplicationResponseSchema;
+  };
+  /**
    * @generated from rpc api.v1.Service.UpdateJobApplicationStatus
    */
   updateJobApplicationStatus: {
@@ -475,4 +3539,292 @@ export const Service: GenService<{
     input: typeof UpdateJobApplicationStatusRequestSchema;
     output: typeof UpdateJobApplicationStatusResponseSchema;
   };
+  /**
+   * @generated from rpc api.v1.Service.GetJobApplicationHistory
+   */
+  getJobApplicationHistory: {
+    methodKind: "unary";
+    input: typeof GetJobApplicationHistoryRequestSchema;
+    output: typeof GetJobApplicationHistoryResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.SearchJobApplications
+   */
+  searchJobApplications: {
+    methodKind: "unary";
+    input: typeof SearchJobApplicationsRequestSchema;
+    output: typeof SearchJobApplicationsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.GetUserSettings
+   */
+  getUserSettings: {
+    methodKind: "unary";
+    input: typeof GetUserSettingsRequestSchema;
+    output: typeof GetUserSettingsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UpdateUserSettings
+   */
+  updateUserSettings: {
+    methodKind: "unary";
+    input: typeof UpdateUserSettingsRequestSchema;
+    output: typeof UpdateUserSettingsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListDeletedJobApplications
+   */
+  listDeletedJobApplications: {
+    methodKind: "unary";
+    input: typeof ListDeletedJobApplicationsRequestSchema;
+    output: typeof ListDeletedJobApplicationsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.RestoreJobApplication
+   */
+  restoreJobApplication: {
+    methodKind: "unary";
+    input: typeof RestoreJobApplicationRequestSchema;
+    output: typeof RestoreJobApplicationResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.CreateInterview
+   */
+  createInterview: {
+    methodKind: "unary";
+    input: typeof CreateInterviewRequestSchema;
+    output: typeof CreateInterviewResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListInterviews
+   */
+  listInterviews: {
+    methodKind: "unary";
+    input: typeof ListInterviewsRequestSchema;
+    output: typeof ListInterviewsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UpdateInterview
+   */
+  updateInterview: {
+    methodKind: "unary";
+    input: typeof UpdateInterviewRequestSchema;
+    output: typeof UpdateInterviewResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.DeleteInterview
+   */
+  deleteInterview: {
+    methodKind: "unary";
+    input: typeof DeleteInterviewRequestSchema;
+    output: typeof DeleteInterviewResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListUpcomingInterviews
+   */
+  listUpcomingInterviews: {
+    methodKind: "unary";
+    input: typeof ListUpcomingInterviewsRequestSchema;
+    output: typeof ListUpcomingInterviewsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.WatchJobApplications
+   */
+  watchJobApplications: {
+    methodKind: "server_streaming";
+    input: typeof WatchJobApplicationsRequestSchema;
+    output: typeof WatchJobApplicationsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UploadDocument
+   */
+  uploadDocument: {
+    methodKind: "unary";
+    input: typeof UploadDocumentRequestSchema;
+    output: typeof UploadDocumentResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.GetDocument
+   */
+  getDocument: {
+    methodKind: "unary";
+    input: typeof GetDocumentRequestSchema;
+    output: typeof GetDocumentResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.DownloadDocument
+   */
+  downloadDocument: {
+    methodKind: "unary";
+    input: typeof DownloadDocumentRequestSchema;
+    output: typeof DownloadDocumentResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.DeleteDocument
+   */
+  deleteDocument: {
+    methodKind: "unary";
+    input: typeof DeleteDocumentRequestSchema;
+    output: typeof DeleteDocumentResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UpdateDocument
+   */
+  updateDocument: {
+    methodKind: "unary";
+    input: typeof UpdateDocumentRequestSchema;
+    output: typeof UpdateDocumentResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListDocuments
+   */
+  listDocuments: {
+    methodKind: "unary";
+    input: typeof ListDocumentsRequestSchema;
+    output: typeof ListDocumentsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.SearchDocuments
+   */
+  searchDocuments: {
+    methodKind: "unary";
+    input: typeof SearchDocumentsRequestSchema;
+    output: typeof SearchDocumentsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ScoreJobApplication
+   */
+  scoreJobApplication: {
+    methodKind: "unary";
+    input: typeof ScoreJobApplicationRequestSchema;
+    output: typeof ScoreJobApplicationResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ImportJobApplications
+   */
+  importJobApplications: {
+    methodKind: "unary";
+    input: typeof ImportJobApplicationsRequestSchema;
+    output: typeof ImportJobApplicationsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ExportJobApplications
+   */
+  exportJobApplications: {
+    methodKind: "server_streaming";
+    input: typeof ExportJobApplicationsRequestSchema;
+    output: typeof ExportJobApplicationsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.GetPipelineStats
+   */
+  getPipelineStats: {
+    methodKind: "unary";
+    input: typeof GetPipelineStatsRequestSchema;
+    output: typeof GetPipelineStatsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListDueFollowUps
+   */
+  listDueFollowUps: {
+    methodKind: "unary";
+    input: typeof ListDueFollowUpsRequestSchema;
+    output: typeof ListDueFollowUpsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.Unsubscribe
+   */
+  unsubscribe: {
+    methodKind: "unary";
+    input: typeof UnsubscribeRequestSchema;
+    output: typeof UnsubscribeResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.CreateCompany
+   */
+  createCompany: {
+    methodKind: "unary";
+    input: typeof CreateCompanyRequestSchema;
+    output: typeof CreateCompanyResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UpdateCompany
+   */
+  updateCompany: {
+    methodKind: "unary";
+    input: typeof UpdateCompanyRequestSchema;
+    output: typeof UpdateCompanyResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListCompanies
+   */
+  listCompanies: {
+    methodKind: "unary";
+    input: typeof ListCompaniesRequestSchema;
+    output: typeof ListCompaniesResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.CreateContact
+   */
+  createContact: {
+    methodKind: "unary";
+    input: typeof CreateContactRequestSchema;
+    output: typeof CreateContactResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.GetContact
+   */
+  getContact: {
+    methodKind: "unary";
+    input: typeof GetContactRequestSchema;
+    output: typeof GetContactResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UpdateContact
+   */
+  updateContact: {
+    methodKind: "unary";
+    input: typeof UpdateContactRequestSchema;
+    output: typeof UpdateContactResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.DeleteContact
+   */
+  deleteContact: {
+    methodKind: "unary";
+    input: typeof DeleteContactRequestSchema;
+    output: typeof DeleteContactResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.ListContacts
+   */
+  listContacts: {
+    methodKind: "unary";
+    input: typeof ListContactsRequestSchema;
+    output: typeof ListContactsResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.LinkContact
+   */
+  linkContact: {
+    methodKind: "unary";
+    input: typeof LinkContactRequestSchema;
+    output: typeof LinkContactResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.UnlinkContact
+   */
+  unlinkContact: {
+    methodKind: "unary";
+    input: typeof UnlinkContactRequestSchema;
+    output: typeof UnlinkContactResponseSchema;
+  };
+  /**
+   * @generated from rpc api.v1.Service.GetContactTimeline
+   */
+  getContactTimeline: {
+    methodKind: "unary";
+    input: typeof GetContactTimelineRequestSchema;
+    output: typeof GetContactTimelineResponseSchema;
+  };
 }> = /*@__PURE__*/ serviceDesc(file_api_v1_api, 0);
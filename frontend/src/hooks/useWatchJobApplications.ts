@@ -0,0 +1,58 @@
+import { useEffect, useRef } from "react";
+import { createClient } from "@connectrpc/connect";
+import { useTransport } from "@connectrpc/connect-query";
+import { useQueryClient } from "@tanstack/react-query";
+import { Service } from "@/api/v1/api_pb";
+import { useJobApplicationQueryKey } from "./useJobApplicationQueryKey";
+
+const RECONNECT_DELAY_MS = 2000;
+
+// Keeps the job application list in sync with changes made on other devices.
+// The stream resumes from the last cursor it saw after a disconnect, so no
+// change is missed while reconnecting.
+export function useWatchJobApplications() {
+  const transport = useTransport();
+  const queryClient = useQueryClient();
+  const listJobApplicationsKey = useJobApplicationQueryKey();
+
+  // The key is rebuilt on every render; keep the latest in a ref so it does
+  // not restart the stream.
+  const listJobApplicationsKeyRef = useRef(listJobApplicationsKey);
+  useEffect(() => {
+    listJobApplicationsKeyRef.current = listJobApplicationsKey;
+  });
+
+  useEffect(() => {
+    const client = createClient(Service, transport);
+    const abort = new AbortController();
+    let cursor = "";
+
+    const watch = async () => {
+      while (!abort.signal.aborted) {
+        try {
+          for await (const res of client.watchJobApplications(
+            { cursor },
+            { signal: abort.signal }
+          )) {
+            cursor = res.cursor;
+            if (!res.heartbeat) {
+              queryClient.invalidateQueries({
+                queryKey: listJobApplicationsKeyRef.current,
+              });
+            }
+          }
+        } catch {
+          // Reconnect below unless the component unmounted.
+        }
+        if (!abort.signal.aborted) {
+          await new Promise((resolve) =>
+            setTimeout(resolve, RECONNECT_DELAY_MS)
+          );
+        }
+      }
+    };
+    watch();
+
+    return () => abort.abort();
+  }, [transport, queryClient]);
+}
@@ -6,10 +6,12 @@ import { PlusCircleIcon } from "lucide-react";
 import { useState } from "react";
 import { useQuery } from "@connectrpc/connect-query";
 import { listJobApplications } from "@/api/v1/api-Service_connectquery";
+import { useWatchJobApplications } from "@/hooks/useWatchJobApplications";
 
 export const KanbanView = () => {
   const [open, setOpen] = useState(false);
   const { data } = useQuery(listJobApplications, {});
+  useWatchJobApplications();
 
   const jobApplications = data?.jobApplications || [];
 
@@ -9,6 +9,8 @@ package api
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -23,6 +25,64 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type JobApplicationSortKey int32
+
+const (
+	JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_UNSPECIFIED JobApplicationSortKey = 0
+	JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_APPLIED_ON  JobApplicationSortKey = 1
+	JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_CREATED_AT  JobApplicationSortKey = 2
+	JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_UPDATED_AT  JobApplicationSortKey = 3
+	JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_COMPANY     JobApplicationSortKey = 4
+	JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_MATCH_SCORE JobApplicationSortKey = 5
+)
+
+// Enum value maps for JobApplicationSortKey.
+var (
+	JobApplicationSortKey_name = map[int32]string{
+		0: "JOB_APPLICATION_SORT_KEY_UNSPECIFIED",
+		1: "JOB_APPLICATION_SORT_KEY_APPLIED_ON",
+		2: "JOB_APPLICATION_SORT_KEY_CREATED_AT",
+		3: "JOB_APPLICATION_SORT_KEY_UPDATED_AT",
+		4: "JOB_APPLICATION_SORT_KEY_COMPANY",
+		5: "JOB_APPLICATION_SORT_KEY_MATCH_SCORE",
+	}
+	JobApplicationSortKey_value = map[string]int32{
+		"JOB_APPLICATION_SORT_KEY_UNSPECIFIED": 0,
+		"JOB_APPLICATION_SORT_KEY_APPLIED_ON":  1,
+		"JOB_APPLICATION_SORT_KEY_CREATED_AT":  2,
+		"JOB_APPLICATION_SORT_KEY_UPDATED_AT":  3,
+		"JOB_APPLICATION_SORT_KEY_COMPANY":     4,
+		"JOB_APPLICATION_SORT_KEY_MATCH_SCORE": 5,
+	}
+)
+
+func (x JobApplicationSortKey) Enum() *JobApplicationSortKey {
+	p := new(JobApplicationSortKey)
+	*p = x
+	return p
+}
+
+func (x JobApplicationSortKey) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobApplicationSortKey) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[0].Descriptor()
+}
+
+func (JobApplicationSortKey) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[0]
+}
+
+func (x JobApplicationSortKey) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobApplicationSortKey.Descriptor instead.
+func (JobApplicationSortKey) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
 type JobApplicationStatus int32
 
 const (
@@ -66,57 +126,6095 @@ func (x JobApplicationStatus) Enum() *JobApplicationStatus {
 	return p
 }
 
-func (x JobApplicationStatus) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x JobApplicationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[1].Descriptor()
+}
+
+func (JobApplicationStatus) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[1]
+}
+
+func (x JobApplicationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobApplicationStatus.Descriptor instead.
+func (JobApplicationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+type JobApplicationEventType int32
+
+const (
+	JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_UNSPECIFIED    JobApplicationEventType = 0
+	JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_CREATED        JobApplicationEventType = 1
+	JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_UPDATED        JobApplicationEventType = 2
+	JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_STATUS_CHANGED JobApplicationEventType = 3
+	JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_DELETED        JobApplicationEventType = 4
+	JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_RESTORED       JobApplicationEventType = 5
+)
+
+// Enum value maps for JobApplicationEventType.
+var (
+	JobApplicationEventType_name = map[int32]string{
+		0: "JOB_APPLICATION_EVENT_TYPE_UNSPECIFIED",
+		1: "JOB_APPLICATION_EVENT_TYPE_CREATED",
+		2: "JOB_APPLICATION_EVENT_TYPE_UPDATED",
+		3: "JOB_APPLICATION_EVENT_TYPE_STATUS_CHANGED",
+		4: "JOB_APPLICATION_EVENT_TYPE_DELETED",
+		5: "JOB_APPLICATION_EVENT_TYPE_RESTORED",
+	}
+	JobApplicationEventType_value = map[string]int32{
+		"JOB_APPLICATION_EVENT_TYPE_UNSPECIFIED":    0,
+		"JOB_APPLICATION_EVENT_TYPE_CREATED":        1,
+		"JOB_APPLICATION_EVENT_TYPE_UPDATED":        2,
+		"JOB_APPLICATION_EVENT_TYPE_STATUS_CHANGED": 3,
+		"JOB_APPLICATION_EVENT_TYPE_DELETED":        4,
+		"JOB_APPLICATION_EVENT_TYPE_RESTORED":       5,
+	}
+)
+
+func (x JobApplicationEventType) Enum() *JobApplicationEventType {
+	p := new(JobApplicationEventType)
+	*p = x
+	return p
+}
+
+func (x JobApplicationEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobApplicationEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[2].Descriptor()
+}
+
+func (JobApplicationEventType) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[2]
+}
+
+func (x JobApplicationEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobApplicationEventType.Descriptor instead.
+func (JobApplicationEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{2}
+}
+
+type InterviewFormat int32
+
+const (
+	InterviewFormat_INTERVIEW_FORMAT_UNSPECIFIED InterviewFormat = 0
+	InterviewFormat_INTERVIEW_FORMAT_PHONE       InterviewFormat = 1
+	InterviewFormat_INTERVIEW_FORMAT_VIDEO       InterviewFormat = 2
+	InterviewFormat_INTERVIEW_FORMAT_ONSITE      InterviewFormat = 3
+)
+
+// Enum value maps for InterviewFormat.
+var (
+	InterviewFormat_name = map[int32]string{
+		0: "INTERVIEW_FORMAT_UNSPECIFIED",
+		1: "INTERVIEW_FORMAT_PHONE",
+		2: "INTERVIEW_FORMAT_VIDEO",
+		3: "INTERVIEW_FORMAT_ONSITE",
+	}
+	InterviewFormat_value = map[string]int32{
+		"INTERVIEW_FORMAT_UNSPECIFIED": 0,
+		"INTERVIEW_FORMAT_PHONE":       1,
+		"INTERVIEW_FORMAT_VIDEO":       2,
+		"INTERVIEW_FORMAT_ONSITE":      3,
+	}
+)
+
+func (x InterviewFormat) Enum() *InterviewFormat {
+	p := new(InterviewFormat)
+	*p = x
+	return p
+}
+
+func (x InterviewFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InterviewFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[3].Descriptor()
+}
+
+func (InterviewFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[3]
+}
+
+func (x InterviewFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InterviewFormat.Descriptor instead.
+func (InterviewFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+type InterviewOutcome int32
+
+const (
+	InterviewOutcome_INTERVIEW_OUTCOME_UNSPECIFIED InterviewOutcome = 0
+	InterviewOutcome_INTERVIEW_OUTCOME_PENDING     InterviewOutcome = 1
+	InterviewOutcome_INTERVIEW_OUTCOME_PASSED      InterviewOutcome = 2
+	InterviewOutcome_INTERVIEW_OUTCOME_FAILED      InterviewOutcome = 3
+	InterviewOutcome_INTERVIEW_OUTCOME_CANCELLED   InterviewOutcome = 4
+)
+
+// Enum value maps for InterviewOutcome.
+var (
+	InterviewOutcome_name = map[int32]string{
+		0: "INTERVIEW_OUTCOME_UNSPECIFIED",
+		1: "INTERVIEW_OUTCOME_PENDING",
+		2: "INTERVIEW_OUTCOME_PASSED",
+		3: "INTERVIEW_OUTCOME_FAILED",
+		4: "INTERVIEW_OUTCOME_CANCELLED",
+	}
+	InterviewOutcome_value = map[string]int32{
+		"INTERVIEW_OUTCOME_UNSPECIFIED": 0,
+		"INTERVIEW_OUTCOME_PENDING":     1,
+		"INTERVIEW_OUTCOME_PASSED":      2,
+		"INTERVIEW_OUTCOME_FAILED":      3,
+		"INTERVIEW_OUTCOME_CANCELLED":   4,
+	}
+)
+
+func (x InterviewOutcome) Enum() *InterviewOutcome {
+	p := new(InterviewOutcome)
+	*p = x
+	return p
+}
+
+func (x InterviewOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InterviewOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[4].Descriptor()
+}
+
+func (InterviewOutcome) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[4]
+}
+
+func (x InterviewOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InterviewOutcome.Descriptor instead.
+func (InterviewOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{4}
+}
+
+type DocumentKind int32
+
+const (
+	DocumentKind_DOCUMENT_KIND_UNSPECIFIED  DocumentKind = 0
+	DocumentKind_DOCUMENT_KIND_CV           DocumentKind = 1
+	DocumentKind_DOCUMENT_KIND_COVER_LETTER DocumentKind = 2
+)
+
+// Enum value maps for DocumentKind.
+var (
+	DocumentKind_name = map[int32]string{
+		0: "DOCUMENT_KIND_UNSPECIFIED",
+		1: "DOCUMENT_KIND_CV",
+		2: "DOCUMENT_KIND_COVER_LETTER",
+	}
+	DocumentKind_value = map[string]int32{
+		"DOCUMENT_KIND_UNSPECIFIED":  0,
+		"DOCUMENT_KIND_CV":           1,
+		"DOCUMENT_KIND_COVER_LETTER": 2,
+	}
+)
+
+func (x DocumentKind) Enum() *DocumentKind {
+	p := new(DocumentKind)
+	*p = x
+	return p
+}
+
+func (x DocumentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DocumentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[5].Descriptor()
+}
+
+func (DocumentKind) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[5]
+}
+
+func (x DocumentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DocumentKind.Descriptor instead.
+func (DocumentKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{5}
+}
+
+type ImportFormat int32
+
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0
+	ImportFormat_IMPORT_FORMAT_CSV         ImportFormat = 1
+	ImportFormat_IMPORT_FORMAT_JSON        ImportFormat = 2
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_JSON",
+	}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_JSON":        2,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[6].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[6]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{6}
+}
+
+type ImportRowOutcome int32
+
+const (
+	ImportRowOutcome_IMPORT_ROW_OUTCOME_UNSPECIFIED ImportRowOutcome = 0
+	ImportRowOutcome_IMPORT_ROW_OUTCOME_CREATED     ImportRowOutcome = 1
+	ImportRowOutcome_IMPORT_ROW_OUTCOME_SKIPPED     ImportRowOutcome = 2
+	ImportRowOutcome_IMPORT_ROW_OUTCOME_INVALID     ImportRowOutcome = 3
+)
+
+// Enum value maps for ImportRowOutcome.
+var (
+	ImportRowOutcome_name = map[int32]string{
+		0: "IMPORT_ROW_OUTCOME_UNSPECIFIED",
+		1: "IMPORT_ROW_OUTCOME_CREATED",
+		2: "IMPORT_ROW_OUTCOME_SKIPPED",
+		3: "IMPORT_ROW_OUTCOME_INVALID",
+	}
+	ImportRowOutcome_value = map[string]int32{
+		"IMPORT_ROW_OUTCOME_UNSPECIFIED": 0,
+		"IMPORT_ROW_OUTCOME_CREATED":     1,
+		"IMPORT_ROW_OUTCOME_SKIPPED":     2,
+		"IMPORT_ROW_OUTCOME_INVALID":     3,
+	}
+)
+
+func (x ImportRowOutcome) Enum() *ImportRowOutcome {
+	p := new(ImportRowOutcome)
+	*p = x
+	return p
+}
+
+func (x ImportRowOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportRowOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[7].Descriptor()
+}
+
+func (ImportRowOutcome) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[7]
+}
+
+func (x ImportRowOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportRowOutcome.Descriptor instead.
+func (ImportRowOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{7}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_NDJSON      ExportFormat = 2
+	ExportFormat_EXPORT_FORMAT_ICS         ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_NDJSON",
+		3: "EXPORT_FORMAT_ICS",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_NDJSON":      2,
+		"EXPORT_FORMAT_ICS":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[8].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[8]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{8}
+}
+
+type FollowUpReason int32
+
+const (
+	FollowUpReason_FOLLOW_UP_REASON_UNSPECIFIED FollowUpReason = 0
+	FollowUpReason_FOLLOW_UP_REASON_SCHEDULED   FollowUpReason = 1
+	FollowUpReason_FOLLOW_UP_REASON_GHOSTED     FollowUpReason = 2
+)
+
+// Enum value maps for FollowUpReason.
+var (
+	FollowUpReason_name = map[int32]string{
+		0: "FOLLOW_UP_REASON_UNSPECIFIED",
+		1: "FOLLOW_UP_REASON_SCHEDULED",
+		2: "FOLLOW_UP_REASON_GHOSTED",
+	}
+	FollowUpReason_value = map[string]int32{
+		"FOLLOW_UP_REASON_UNSPECIFIED": 0,
+		"FOLLOW_UP_REASON_SCHEDULED":   1,
+		"FOLLOW_UP_REASON_GHOSTED":     2,
+	}
+)
+
+func (x FollowUpReason) Enum() *FollowUpReason {
+	p := new(FollowUpReason)
+	*p = x
+	return p
+}
+
+func (x FollowUpReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FollowUpReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[9].Descriptor()
+}
+
+func (FollowUpReason) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[9]
+}
+
+func (x FollowUpReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FollowUpReason.Descriptor instead.
+func (FollowUpReason) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{9}
+}
+
+type CompanySize int32
+
+const (
+	CompanySize_COMPANY_SIZE_UNSPECIFIED CompanySize = 0
+	CompanySize_COMPANY_SIZE_1_10        CompanySize = 1
+	CompanySize_COMPANY_SIZE_11_50       CompanySize = 2
+	CompanySize_COMPANY_SIZE_51_200      CompanySize = 3
+	CompanySize_COMPANY_SIZE_201_1000    CompanySize = 4
+	CompanySize_COMPANY_SIZE_1001_5000   CompanySize = 5
+	CompanySize_COMPANY_SIZE_5001_PLUS   CompanySize = 6
+)
+
+// Enum value maps for CompanySize.
+var (
+	CompanySize_name = map[int32]string{
+		0: "COMPANY_SIZE_UNSPECIFIED",
+		1: "COMPANY_SIZE_1_10",
+		2: "COMPANY_SIZE_11_50",
+		3: "COMPANY_SIZE_51_200",
+		4: "COMPANY_SIZE_201_1000",
+		5: "COMPANY_SIZE_1001_5000",
+		6: "COMPANY_SIZE_5001_PLUS",
+	}
+	CompanySize_value = map[string]int32{
+		"COMPANY_SIZE_UNSPECIFIED": 0,
+		"COMPANY_SIZE_1_10":        1,
+		"COMPANY_SIZE_11_50":       2,
+		"COMPANY_SIZE_51_200":      3,
+		"COMPANY_SIZE_201_1000":    4,
+		"COMPANY_SIZE_1001_5000":   5,
+		"COMPANY_SIZE_5001_PLUS":   6,
+	}
+)
+
+func (x CompanySize) Enum() *CompanySize {
+	p := new(CompanySize)
+	*p = x
+	return p
+}
+
+func (x CompanySize) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompanySize) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_api_proto_enumTypes[10].Descriptor()
+}
+
+func (CompanySize) Type() protoreflect.EnumType {
+	return &file_api_v1_api_proto_enumTypes[10]
+}
+
+func (x CompanySize) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompanySize.Descriptor instead.
+func (CompanySize) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{10}
+}
+
+type CreateJobApplicationRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Company               string                  `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Title                 string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description           *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Notes                 *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Cv                    *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=cv,proto3" json:"cv,omitempty"`
+	CoverLetter           *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	AppliedOn             *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`
+	Status                JobApplicationStatus    `protobuf:"varint,8,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	Position              string                  `protobuf:"bytes,9,opt,name=position,proto3" json:"position,omitempty"`
+	CvDocumentId          *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=cv_document_id,json=cvDocumentId,proto3" json:"cv_document_id,omitempty"`
+	CoverLetterDocumentId *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=cover_letter_document_id,json=coverLetterDocumentId,proto3" json:"cover_letter_document_id,omitempty"`
+	FollowUpOn            *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=follow_up_on,json=followUpOn,proto3" json:"follow_up_on,omitempty"`
+	CompanyId             *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateJobApplicationRequest) Reset() {
+	*x = CreateJobApplicationRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobApplicationRequest) ProtoMessage() {}
+
+func (x *CreateJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*CreateJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateJobApplicationRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *CreateJobApplicationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateJobApplicationRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetCv() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cv
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetCoverLetter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverLetter
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetAppliedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOn
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *CreateJobApplicationRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *CreateJobApplicationRequest) GetCvDocumentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CvDocumentId
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetCoverLetterDocumentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverLetterDocumentId
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetFollowUpOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowUpOn
+	}
+	return nil
+}
+
+func (x *CreateJobApplicationRequest) GetCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CompanyId
+	}
+	return nil
+}
+
+type CreateJobApplicationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateJobApplicationResponse) Reset() {
+	*x = CreateJobApplicationResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateJobApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateJobApplicationResponse) ProtoMessage() {}
+
+func (x *CreateJobApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateJobApplicationResponse.ProtoReflect.Descriptor instead.
+func (*CreateJobApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateJobApplicationResponse) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+type ListJobApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Filter        *JobApplicationFilter  `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortKey       JobApplicationSortKey  `protobuf:"varint,4,opt,name=sort_key,json=sortKey,proto3,enum=api.v1.JobApplicationSortKey" json:"sort_key,omitempty"`
+	Descending    bool                   `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobApplicationsRequest) Reset() {
+	*x = ListJobApplicationsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobApplicationsRequest) ProtoMessage() {}
+
+func (x *ListJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListJobApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJobApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListJobApplicationsRequest) GetFilter() *JobApplicationFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListJobApplicationsRequest) GetSortKey() JobApplicationSortKey {
+	if x != nil {
+		return x.SortKey
+	}
+	return JobApplicationSortKey_JOB_APPLICATION_SORT_KEY_UNSPECIFIED
+}
+
+func (x *ListJobApplicationsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+type ListJobApplicationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobApplications []*JobApplication      `protobuf:"bytes,1,rep,name=job_applications,json=jobApplications,proto3" json:"job_applications,omitempty"`
+	NextPageToken   string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListJobApplicationsResponse) Reset() {
+	*x = ListJobApplicationsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobApplicationsResponse) ProtoMessage() {}
+
+func (x *ListJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListJobApplicationsResponse) GetJobApplications() []*JobApplication {
+	if x != nil {
+		return x.JobApplications
+	}
+	return nil
+}
+
+func (x *ListJobApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type JobApplicationFilter struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Statuses              []JobApplicationStatus `protobuf:"varint,1,rep,packed,name=statuses,proto3,enum=api.v1.JobApplicationStatus" json:"statuses,omitempty"`
+	Company               string                 `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	AppliedOnFrom         *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=applied_on_from,json=appliedOnFrom,proto3" json:"applied_on_from,omitempty"`
+	AppliedOnTo           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=applied_on_to,json=appliedOnTo,proto3" json:"applied_on_to,omitempty"`
+	HasCv                 *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=has_cv,json=hasCv,proto3" json:"has_cv,omitempty"`
+	HasCoverLetter        *wrapperspb.BoolValue  `protobuf:"bytes,6,opt,name=has_cover_letter,json=hasCoverLetter,proto3" json:"has_cover_letter,omitempty"`
+	CvDocumentId          string                 `protobuf:"bytes,7,opt,name=cv_document_id,json=cvDocumentId,proto3" json:"cv_document_id,omitempty"`
+	CoverLetterDocumentId string                 `protobuf:"bytes,8,opt,name=cover_letter_document_id,json=coverLetterDocumentId,proto3" json:"cover_letter_document_id,omitempty"`
+	CvQuery               string                 `protobuf:"bytes,9,opt,name=cv_query,json=cvQuery,proto3" json:"cv_query,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *JobApplicationFilter) Reset() {
+	*x = JobApplicationFilter{}
+	mi := &file_api_v1_api_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicationFilter) ProtoMessage() {}
+
+func (x *JobApplicationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicationFilter.ProtoReflect.Descriptor instead.
+func (*JobApplicationFilter) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JobApplicationFilter) GetStatuses() []JobApplicationStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *JobApplicationFilter) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *JobApplicationFilter) GetAppliedOnFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOnFrom
+	}
+	return nil
+}
+
+func (x *JobApplicationFilter) GetAppliedOnTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOnTo
+	}
+	return nil
+}
+
+func (x *JobApplicationFilter) GetHasCv() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.HasCv
+	}
+	return nil
+}
+
+func (x *JobApplicationFilter) GetHasCoverLetter() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.HasCoverLetter
+	}
+	return nil
+}
+
+func (x *JobApplicationFilter) GetCvDocumentId() string {
+	if x != nil {
+		return x.CvDocumentId
+	}
+	return ""
+}
+
+func (x *JobApplicationFilter) GetCoverLetterDocumentId() string {
+	if x != nil {
+		return x.CoverLetterDocumentId
+	}
+	return ""
+}
+
+func (x *JobApplicationFilter) GetCvQuery() string {
+	if x != nil {
+		return x.CvQuery
+	}
+	return ""
+}
+
+type UpdateJobApplicationRequest struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Id                    string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Company               string                  `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	Title                 string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description           *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Notes                 *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	Cv                    *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=cv,proto3" json:"cv,omitempty"`
+	CoverLetter           *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	Status                JobApplicationStatus    `protobuf:"varint,8,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	AppliedOn             *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`
+	Position              string                  `protobuf:"bytes,10,opt,name=position,proto3" json:"position,omitempty"`
+	Version               int64                   `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	UpdateMask            *fieldmaskpb.FieldMask  `protobuf:"bytes,12,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	CvDocumentId          *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=cv_document_id,json=cvDocumentId,proto3" json:"cv_document_id,omitempty"`
+	CoverLetterDocumentId *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=cover_letter_document_id,json=coverLetterDocumentId,proto3" json:"cover_letter_document_id,omitempty"`
+	FollowUpOn            *timestamppb.Timestamp  `protobuf:"bytes,15,opt,name=follow_up_on,json=followUpOn,proto3" json:"follow_up_on,omitempty"`
+	CompanyId             *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateJobApplicationRequest) Reset() {
+	*x = UpdateJobApplicationRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobApplicationRequest) ProtoMessage() {}
+
+func (x *UpdateJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateJobApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationRequest) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetCv() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cv
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetCoverLetter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverLetter
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateJobApplicationRequest) GetAppliedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOn
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateJobApplicationRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetCvDocumentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CvDocumentId
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetCoverLetterDocumentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverLetterDocumentId
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetFollowUpOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowUpOn
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationRequest) GetCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CompanyId
+	}
+	return nil
+}
+
+type UpdateJobApplicationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateJobApplicationResponse) Reset() {
+	*x = UpdateJobApplicationResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobApplicationResponse) ProtoMessage() {}
+
+func (x *UpdateJobApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobApplicationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateJobApplicationResponse) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+type DeleteJobApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobApplicationRequest) Reset() {
+	*x = DeleteJobApplicationRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobApplicationRequest) ProtoMessage() {}
+
+func (x *DeleteJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeleteJobApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteJobApplicationRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type DeleteJobApplicationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteJobApplicationResponse) Reset() {
+	*x = DeleteJobApplicationResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteJobApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJobApplicationResponse) ProtoMessage() {}
+
+func (x *DeleteJobApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJobApplicationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJobApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{8}
+}
+
+type JobApplication struct {
+	state                 protoimpl.MessageState  `protogen:"open.v1"`
+	Id                    string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Company               string                  `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	Title                 string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Status                JobApplicationStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	Description           *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Notes                 *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Cv                    *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=cv,proto3" json:"cv,omitempty"`
+	CoverLetter           *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
+	AppliedOn             *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`
+	CreatedAt             *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt             *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Position              string                  `protobuf:"bytes,12,opt,name=position,proto3" json:"position,omitempty"`
+	Version               int64                   `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	DeletedAt             *timestamppb.Timestamp  `protobuf:"bytes,14,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	CvDocumentId          *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=cv_document_id,json=cvDocumentId,proto3" json:"cv_document_id,omitempty"`
+	CoverLetterDocumentId *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=cover_letter_document_id,json=coverLetterDocumentId,proto3" json:"cover_letter_document_id,omitempty"`
+	MatchScore            *wrapperspb.Int32Value  `protobuf:"bytes,17,opt,name=match_score,json=matchScore,proto3" json:"match_score,omitempty"`
+	ExternalId            *wrapperspb.StringValue `protobuf:"bytes,18,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	StatusChangedAt       *timestamppb.Timestamp  `protobuf:"bytes,19,opt,name=status_changed_at,json=statusChangedAt,proto3" json:"status_changed_at,omitempty"`
+	FollowUpOn            *timestamppb.Timestamp  `protobuf:"bytes,20,opt,name=follow_up_on,json=followUpOn,proto3" json:"follow_up_on,omitempty"`
+	CompanyId             *wrapperspb.StringValue `protobuf:"bytes,21,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *JobApplication) Reset() {
+	*x = JobApplication{}
+	mi := &file_api_v1_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplication) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplication) ProtoMessage() {}
+
+func (x *JobApplication) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplication.ProtoReflect.Descriptor instead.
+func (*JobApplication) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JobApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobApplication) GetCompany() string {
+	if x != nil {
+		return x.Company
+	}
+	return ""
+}
+
+func (x *JobApplication) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *JobApplication) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *JobApplication) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *JobApplication) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *JobApplication) GetCv() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Cv
+	}
+	return nil
+}
+
+func (x *JobApplication) GetCoverLetter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverLetter
+	}
+	return nil
+}
+
+func (x *JobApplication) GetAppliedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOn
+	}
+	return nil
+}
+
+func (x *JobApplication) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *JobApplication) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *JobApplication) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *JobApplication) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *JobApplication) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *JobApplication) GetCvDocumentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CvDocumentId
+	}
+	return nil
+}
+
+func (x *JobApplication) GetCoverLetterDocumentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CoverLetterDocumentId
+	}
+	return nil
+}
+
+func (x *JobApplication) GetMatchScore() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MatchScore
+	}
+	return nil
+}
+
+func (x *JobApplication) GetExternalId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ExternalId
+	}
+	return nil
+}
+
+func (x *JobApplication) GetStatusChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusChangedAt
+	}
+	return nil
+}
+
+func (x *JobApplication) GetFollowUpOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FollowUpOn
+	}
+	return nil
+}
+
+func (x *JobApplication) GetCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CompanyId
+	}
+	return nil
+}
+
+type UpdateJobApplicationStatusRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        JobApplicationStatus    `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	Position      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	AfterId       string                  `protobuf:"bytes,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	BeforeId      string                  `protobuf:"bytes,5,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	Version       int64                   `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateJobApplicationStatusRequest) Reset() {
+	*x = UpdateJobApplicationStatusRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobApplicationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobApplicationStatusRequest) ProtoMessage() {}
+
+func (x *UpdateJobApplicationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobApplicationStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateJobApplicationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateJobApplicationStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationStatusRequest) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateJobApplicationStatusRequest) GetPosition() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *UpdateJobApplicationStatusRequest) GetAfterId() string {
+	if x != nil {
+		return x.AfterId
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationStatusRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+func (x *UpdateJobApplicationStatusRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type UpdateJobApplicationStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateJobApplicationStatusResponse) Reset() {
+	*x = UpdateJobApplicationStatusResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateJobApplicationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateJobApplicationStatusResponse) ProtoMessage() {}
+
+func (x *UpdateJobApplicationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateJobApplicationStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateJobApplicationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateJobApplicationStatusResponse) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+type GetJobApplicationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobApplicationHistoryRequest) Reset() {
+	*x = GetJobApplicationHistoryRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobApplicationHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobApplicationHistoryRequest) ProtoMessage() {}
+
+func (x *GetJobApplicationHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobApplicationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetJobApplicationHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetJobApplicationHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetJobApplicationHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*JobApplicationEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobApplicationHistoryResponse) Reset() {
+	*x = GetJobApplicationHistoryResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobApplicationHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobApplicationHistoryResponse) ProtoMessage() {}
+
+func (x *GetJobApplicationHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobApplicationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetJobApplicationHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetJobApplicationHistoryResponse) GetEvents() []*JobApplicationEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type JobApplicationFieldChange struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Field         string                  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobApplicationFieldChange) Reset() {
+	*x = JobApplicationFieldChange{}
+	mi := &file_api_v1_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicationFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicationFieldChange) ProtoMessage() {}
+
+func (x *JobApplicationFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicationFieldChange.ProtoReflect.Descriptor instead.
+func (*JobApplicationFieldChange) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *JobApplicationFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *JobApplicationFieldChange) GetOldValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OldValue
+	}
+	return nil
+}
+
+func (x *JobApplicationFieldChange) GetNewValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.NewValue
+	}
+	return nil
+}
+
+type JobApplicationEvent struct {
+	state            protoimpl.MessageState       `protogen:"open.v1"`
+	Id               string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobApplicationId string                       `protobuf:"bytes,2,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	UserId           string                       `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type             JobApplicationEventType      `protobuf:"varint,4,opt,name=type,proto3,enum=api.v1.JobApplicationEventType" json:"type,omitempty"`
+	Changes          []*JobApplicationFieldChange `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	CreatedAt        *timestamppb.Timestamp       `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *JobApplicationEvent) Reset() {
+	*x = JobApplicationEvent{}
+	mi := &file_api_v1_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicationEvent) ProtoMessage() {}
+
+func (x *JobApplicationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicationEvent.ProtoReflect.Descriptor instead.
+func (*JobApplicationEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *JobApplicationEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JobApplicationEvent) GetJobApplicationId() string {
+	if x != nil {
+		return x.JobApplicationId
+	}
+	return ""
+}
+
+func (x *JobApplicationEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *JobApplicationEvent) GetType() JobApplicationEventType {
+	if x != nil {
+		return x.Type
+	}
+	return JobApplicationEventType_JOB_APPLICATION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *JobApplicationEvent) GetChanges() []*JobApplicationFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *JobApplicationEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SearchJobApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJobApplicationsRequest) Reset() {
+	*x = SearchJobApplicationsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJobApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJobApplicationsRequest) ProtoMessage() {}
+
+func (x *SearchJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*SearchJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SearchJobApplicationsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchJobApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchJobApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchJobApplicationsResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Results       []*JobApplicationSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchJobApplicationsResponse) Reset() {
+	*x = SearchJobApplicationsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchJobApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchJobApplicationsResponse) ProtoMessage() {}
+
+func (x *SearchJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*SearchJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SearchJobApplicationsResponse) GetResults() []*JobApplicationSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchJobApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type JobApplicationSearchResult struct {
+	state          protoimpl.MessageState         `protogen:"open.v1"`
+	JobApplication *JobApplication                `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	Rank           float64                        `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippets       []*JobApplicationSearchSnippet `protobuf:"bytes,3,rep,name=snippets,proto3" json:"snippets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *JobApplicationSearchResult) Reset() {
+	*x = JobApplicationSearchResult{}
+	mi := &file_api_v1_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicationSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicationSearchResult) ProtoMessage() {}
+
+func (x *JobApplicationSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicationSearchResult.ProtoReflect.Descriptor instead.
+func (*JobApplicationSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *JobApplicationSearchResult) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+func (x *JobApplicationSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *JobApplicationSearchResult) GetSnippets() []*JobApplicationSearchSnippet {
+	if x != nil {
+		return x.Snippets
+	}
+	return nil
+}
+
+type JobApplicationSearchSnippet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobApplicationSearchSnippet) Reset() {
+	*x = JobApplicationSearchSnippet{}
+	mi := &file_api_v1_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobApplicationSearchSnippet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobApplicationSearchSnippet) ProtoMessage() {}
+
+func (x *JobApplicationSearchSnippet) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobApplicationSearchSnippet.ProtoReflect.Descriptor instead.
+func (*JobApplicationSearchSnippet) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *JobApplicationSearchSnippet) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *JobApplicationSearchSnippet) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UserSettings struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	StrictStatusTransitions bool                   `protobuf:"varint,1,opt,name=strict_status_transitions,json=strictStatusTransitions,proto3" json:"strict_status_transitions,omitempty"`
+	GhostingRules           []*GhostingRule        `protobuf:"bytes,2,rep,name=ghosting_rules,json=ghostingRules,proto3" json:"ghosting_rules,omitempty"`
+	AutoRejectAfterDays     int32                  `protobuf:"varint,3,opt,name=auto_reject_after_days,json=autoRejectAfterDays,proto3" json:"auto_reject_after_days,omitempty"`
+	EmailDigest             bool                   `protobuf:"varint,4,opt,name=email_digest,json=emailDigest,proto3" json:"email_digest,omitempty"`
+	EmailReminders          bool                   `protobuf:"varint,5,opt,name=email_reminders,json=emailReminders,proto3" json:"email_reminders,omitempty"`
+	Email                   string                 `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UserSettings) Reset() {
+	*x = UserSettings{}
+	mi := &file_api_v1_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSettings) ProtoMessage() {}
+
+func (x *UserSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSettings.ProtoReflect.Descriptor instead.
+func (*UserSettings) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UserSettings) GetStrictStatusTransitions() bool {
+	if x != nil {
+		return x.StrictStatusTransitions
+	}
+	return false
+}
+
+func (x *UserSettings) GetGhostingRules() []*GhostingRule {
+	if x != nil {
+		return x.GhostingRules
+	}
+	return nil
+}
+
+func (x *UserSettings) GetAutoRejectAfterDays() int32 {
+	if x != nil {
+		return x.AutoRejectAfterDays
+	}
+	return 0
+}
+
+func (x *UserSettings) GetEmailDigest() bool {
+	if x != nil {
+		return x.EmailDigest
+	}
+	return false
+}
+
+func (x *UserSettings) GetEmailReminders() bool {
+	if x != nil {
+		return x.EmailReminders
+	}
+	return false
+}
+
+func (x *UserSettings) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GhostingRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        JobApplicationStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	AfterDays     int32                  `protobuf:"varint,2,opt,name=after_days,json=afterDays,proto3" json:"after_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GhostingRule) Reset() {
+	*x = GhostingRule{}
+	mi := &file_api_v1_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GhostingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GhostingRule) ProtoMessage() {}
+
+func (x *GhostingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GhostingRule.ProtoReflect.Descriptor instead.
+func (*GhostingRule) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GhostingRule) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *GhostingRule) GetAfterDays() int32 {
+	if x != nil {
+		return x.AfterDays
+	}
+	return 0
+}
+
+type GetUserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsRequest) Reset() {
+	*x = GetUserSettingsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsRequest) ProtoMessage() {}
+
+func (x *GetUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{22}
+}
+
+type GetUserSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserSettingsResponse) Reset() {
+	*x = GetUserSettingsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserSettingsResponse) ProtoMessage() {}
+
+func (x *GetUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateUserSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingsRequest) Reset() {
+	*x = UpdateUserSettingsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateUserSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateUserSettingsRequest) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *UpdateUserSettingsRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+type UpdateUserSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *UserSettings          `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserSettingsResponse) Reset() {
+	*x = UpdateUserSettingsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateUserSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UpdateUserSettingsResponse) GetSettings() *UserSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ListDeletedJobApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedJobApplicationsRequest) Reset() {
+	*x = ListDeletedJobApplicationsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedJobApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedJobApplicationsRequest) ProtoMessage() {}
+
+func (x *ListDeletedJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListDeletedJobApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeletedJobApplicationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDeletedJobApplicationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	JobApplications []*JobApplication      `protobuf:"bytes,1,rep,name=job_applications,json=jobApplications,proto3" json:"job_applications,omitempty"`
+	NextPageToken   string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListDeletedJobApplicationsResponse) Reset() {
+	*x = ListDeletedJobApplicationsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedJobApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedJobApplicationsResponse) ProtoMessage() {}
+
+func (x *ListDeletedJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeletedJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListDeletedJobApplicationsResponse) GetJobApplications() []*JobApplication {
+	if x != nil {
+		return x.JobApplications
+	}
+	return nil
+}
+
+func (x *ListDeletedJobApplicationsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RestoreJobApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreJobApplicationRequest) Reset() {
+	*x = RestoreJobApplicationRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreJobApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJobApplicationRequest) ProtoMessage() {}
+
+func (x *RestoreJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*RestoreJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreJobApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RestoreJobApplicationRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RestoreJobApplicationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RestoreJobApplicationResponse) Reset() {
+	*x = RestoreJobApplicationResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreJobApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreJobApplicationResponse) ProtoMessage() {}
+
+func (x *RestoreJobApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreJobApplicationResponse.ProtoReflect.Descriptor instead.
+func (*RestoreJobApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestoreJobApplicationResponse) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+type Interview struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JobApplicationId string                 `protobuf:"bytes,2,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	Round            string                 `protobuf:"bytes,3,opt,name=round,proto3" json:"round,omitempty"`
+	ScheduledAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	TimeZone         string                 `protobuf:"bytes,5,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Duration         *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Interviewers     []string               `protobuf:"bytes,7,rep,name=interviewers,proto3" json:"interviewers,omitempty"`
+	Format           InterviewFormat        `protobuf:"varint,8,opt,name=format,proto3,enum=api.v1.InterviewFormat" json:"format,omitempty"`
+	Location         string                 `protobuf:"bytes,9,opt,name=location,proto3" json:"location,omitempty"`
+	Outcome          InterviewOutcome       `protobuf:"varint,10,opt,name=outcome,proto3,enum=api.v1.InterviewOutcome" json:"outcome,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Interview) Reset() {
+	*x = Interview{}
+	mi := &file_api_v1_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interview) ProtoMessage() {}
+
+func (x *Interview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interview.ProtoReflect.Descriptor instead.
+func (*Interview) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Interview) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Interview) GetJobApplicationId() string {
+	if x != nil {
+		return x.JobApplicationId
+	}
+	return ""
+}
+
+func (x *Interview) GetRound() string {
+	if x != nil {
+		return x.Round
+	}
+	return ""
+}
+
+func (x *Interview) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *Interview) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *Interview) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Interview) GetInterviewers() []string {
+	if x != nil {
+		return x.Interviewers
+	}
+	return nil
+}
+
+func (x *Interview) GetFormat() InterviewFormat {
+	if x != nil {
+		return x.Format
+	}
+	return InterviewFormat_INTERVIEW_FORMAT_UNSPECIFIED
+}
+
+func (x *Interview) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *Interview) GetOutcome() InterviewOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return InterviewOutcome_INTERVIEW_OUTCOME_UNSPECIFIED
+}
+
+func (x *Interview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Interview) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateInterviewRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	JobApplicationId string                 `protobuf:"bytes,1,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	Round            string                 `protobuf:"bytes,2,opt,name=round,proto3" json:"round,omitempty"`
+	ScheduledAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	TimeZone         string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Duration         *durationpb.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Interviewers     []string               `protobuf:"bytes,6,rep,name=interviewers,proto3" json:"interviewers,omitempty"`
+	Format           InterviewFormat        `protobuf:"varint,7,opt,name=format,proto3,enum=api.v1.InterviewFormat" json:"format,omitempty"`
+	Location         string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateInterviewRequest) Reset() {
+	*x = CreateInterviewRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInterviewRequest) ProtoMessage() {}
+
+func (x *CreateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateInterviewRequest) GetJobApplicationId() string {
+	if x != nil {
+		return x.JobApplicationId
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetRound() string {
+	if x != nil {
+		return x.Round
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *CreateInterviewRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *CreateInterviewRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *CreateInterviewRequest) GetInterviewers() []string {
+	if x != nil {
+		return x.Interviewers
+	}
+	return nil
+}
+
+func (x *CreateInterviewRequest) GetFormat() InterviewFormat {
+	if x != nil {
+		return x.Format
+	}
+	return InterviewFormat_INTERVIEW_FORMAT_UNSPECIFIED
+}
+
+func (x *CreateInterviewRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type CreateInterviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interview     *Interview             `protobuf:"bytes,1,opt,name=interview,proto3" json:"interview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInterviewResponse) Reset() {
+	*x = CreateInterviewResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInterviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInterviewResponse) ProtoMessage() {}
+
+func (x *CreateInterviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInterviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateInterviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateInterviewResponse) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+type ListInterviewsRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	JobApplicationId string                 `protobuf:"bytes,1,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListInterviewsRequest) Reset() {
+	*x = ListInterviewsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterviewsRequest) ProtoMessage() {}
+
+func (x *ListInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListInterviewsRequest) GetJobApplicationId() string {
+	if x != nil {
+		return x.JobApplicationId
+	}
+	return ""
+}
+
+type ListInterviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interviews    []*Interview           `protobuf:"bytes,1,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInterviewsResponse) Reset() {
+	*x = ListInterviewsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInterviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInterviewsResponse) ProtoMessage() {}
+
+func (x *ListInterviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInterviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListInterviewsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListInterviewsResponse) GetInterviews() []*Interview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+type UpdateInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Round         string                 `protobuf:"bytes,2,opt,name=round,proto3" json:"round,omitempty"`
+	ScheduledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	TimeZone      string                 `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	Duration      *durationpb.Duration   `protobuf:"bytes,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Interviewers  []string               `protobuf:"bytes,6,rep,name=interviewers,proto3" json:"interviewers,omitempty"`
+	Format        InterviewFormat        `protobuf:"varint,7,opt,name=format,proto3,enum=api.v1.InterviewFormat" json:"format,omitempty"`
+	Location      string                 `protobuf:"bytes,8,opt,name=location,proto3" json:"location,omitempty"`
+	Outcome       InterviewOutcome       `protobuf:"varint,9,opt,name=outcome,proto3,enum=api.v1.InterviewOutcome" json:"outcome,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInterviewRequest) Reset() {
+	*x = UpdateInterviewRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterviewRequest) ProtoMessage() {}
+
+func (x *UpdateInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UpdateInterviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetRound() string {
+	if x != nil {
+		return x.Round
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetScheduledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return nil
+}
+
+func (x *UpdateInterviewRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *UpdateInterviewRequest) GetInterviewers() []string {
+	if x != nil {
+		return x.Interviewers
+	}
+	return nil
+}
+
+func (x *UpdateInterviewRequest) GetFormat() InterviewFormat {
+	if x != nil {
+		return x.Format
+	}
+	return InterviewFormat_INTERVIEW_FORMAT_UNSPECIFIED
+}
+
+func (x *UpdateInterviewRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateInterviewRequest) GetOutcome() InterviewOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return InterviewOutcome_INTERVIEW_OUTCOME_UNSPECIFIED
+}
+
+type UpdateInterviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interview     *Interview             `protobuf:"bytes,1,opt,name=interview,proto3" json:"interview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInterviewResponse) Reset() {
+	*x = UpdateInterviewResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInterviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInterviewResponse) ProtoMessage() {}
+
+func (x *UpdateInterviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInterviewResponse.ProtoReflect.Descriptor instead.
+func (*UpdateInterviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateInterviewResponse) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+type DeleteInterviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInterviewRequest) Reset() {
+	*x = DeleteInterviewRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInterviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInterviewRequest) ProtoMessage() {}
+
+func (x *DeleteInterviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInterviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInterviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteInterviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteInterviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInterviewResponse) Reset() {
+	*x = DeleteInterviewResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInterviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInterviewResponse) ProtoMessage() {}
+
+func (x *DeleteInterviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInterviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInterviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{38}
+}
+
+type ListUpcomingInterviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingInterviewsRequest) Reset() {
+	*x = ListUpcomingInterviewsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingInterviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingInterviewsRequest) ProtoMessage() {}
+
+func (x *ListUpcomingInterviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingInterviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListUpcomingInterviewsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListUpcomingInterviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUpcomingInterviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUpcomingInterviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interviews    []*UpcomingInterview   `protobuf:"bytes,1,rep,name=interviews,proto3" json:"interviews,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUpcomingInterviewsResponse) Reset() {
+	*x = ListUpcomingInterviewsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUpcomingInterviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUpcomingInterviewsResponse) ProtoMessage() {}
+
+func (x *ListUpcomingInterviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUpcomingInterviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListUpcomingInterviewsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListUpcomingInterviewsResponse) GetInterviews() []*UpcomingInterview {
+	if x != nil {
+		return x.Interviews
+	}
+	return nil
+}
+
+func (x *ListUpcomingInterviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpcomingInterview struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Interview      *Interview             `protobuf:"bytes,1,opt,name=interview,proto3" json:"interview,omitempty"`
+	JobApplication *JobApplication        `protobuf:"bytes,2,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpcomingInterview) Reset() {
+	*x = UpcomingInterview{}
+	mi := &file_api_v1_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpcomingInterview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpcomingInterview) ProtoMessage() {}
+
+func (x *UpcomingInterview) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpcomingInterview.ProtoReflect.Descriptor instead.
+func (*UpcomingInterview) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpcomingInterview) GetInterview() *Interview {
+	if x != nil {
+		return x.Interview
+	}
+	return nil
+}
+
+func (x *UpcomingInterview) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+type WatchJobApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchJobApplicationsRequest) Reset() {
+	*x = WatchJobApplicationsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobApplicationsRequest) ProtoMessage() {}
+
+func (x *WatchJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*WatchJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WatchJobApplicationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type WatchJobApplicationsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Event          *JobApplicationEvent   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	JobApplication *JobApplication        `protobuf:"bytes,2,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	Cursor         string                 `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Heartbeat      bool                   `protobuf:"varint,4,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchJobApplicationsResponse) Reset() {
+	*x = WatchJobApplicationsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchJobApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchJobApplicationsResponse) ProtoMessage() {}
+
+func (x *WatchJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*WatchJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WatchJobApplicationsResponse) GetEvent() *JobApplicationEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *WatchJobApplicationsResponse) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+func (x *WatchJobApplicationsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *WatchJobApplicationsResponse) GetHeartbeat() bool {
+	if x != nil {
+		return x.Heartbeat
+	}
+	return false
+}
+
+type Document struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          DocumentKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=api.v1.DocumentKind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Size          int64                  `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Label         string                 `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	TextPreview   string                 `protobuf:"bytes,8,opt,name=text_preview,json=textPreview,proto3" json:"text_preview,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Document) Reset() {
+	*x = Document{}
+	mi := &file_api_v1_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Document) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Document) ProtoMessage() {}
+
+func (x *Document) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Document.ProtoReflect.Descriptor instead.
+func (*Document) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Document) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Document) GetKind() DocumentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DocumentKind_DOCUMENT_KIND_UNSPECIFIED
+}
+
+func (x *Document) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Document) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Document) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Document) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Document) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Document) GetTextPreview() string {
+	if x != nil {
+		return x.TextPreview
+	}
+	return ""
+}
+
+type DocumentSummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Document         *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	ApplicationCount int32                  `protobuf:"varint,2,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DocumentSummary) Reset() {
+	*x = DocumentSummary{}
+	mi := &file_api_v1_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentSummary) ProtoMessage() {}
+
+func (x *DocumentSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentSummary.ProtoReflect.Descriptor instead.
+func (*DocumentSummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DocumentSummary) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DocumentSummary) GetApplicationCount() int32 {
+	if x != nil {
+		return x.ApplicationCount
+	}
+	return 0
+}
+
+type UploadDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          DocumentKind           `protobuf:"varint,1,opt,name=kind,proto3,enum=api.v1.DocumentKind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Content       []byte                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Label         string                 `protobuf:"bytes,5,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentRequest) Reset() {
+	*x = UploadDocumentRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentRequest) ProtoMessage() {}
+
+func (x *UploadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UploadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UploadDocumentRequest) GetKind() DocumentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DocumentKind_DOCUMENT_KIND_UNSPECIFIED
+}
+
+func (x *UploadDocumentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *UploadDocumentRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *UploadDocumentRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type UploadDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDocumentResponse) Reset() {
+	*x = UploadDocumentResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDocumentResponse) ProtoMessage() {}
+
+func (x *UploadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UploadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UploadDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type GetDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentRequest) Reset() {
+	*x = GetDocumentRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentRequest) ProtoMessage() {}
+
+func (x *GetDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDocumentResponse) Reset() {
+	*x = GetDocumentResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDocumentResponse) ProtoMessage() {}
+
+func (x *GetDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type DownloadDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDocumentRequest) Reset() {
+	*x = DownloadDocumentRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDocumentRequest) ProtoMessage() {}
+
+func (x *DownloadDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DownloadDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DownloadDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDocumentResponse) Reset() {
+	*x = DownloadDocumentResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDocumentResponse) ProtoMessage() {}
+
+func (x *DownloadDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DownloadDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DownloadDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type DeleteDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentRequest) Reset() {
+	*x = DeleteDocumentRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentRequest) ProtoMessage() {}
+
+func (x *DeleteDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDocumentResponse) Reset() {
+	*x = DeleteDocumentResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDocumentResponse) ProtoMessage() {}
+
+func (x *DeleteDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDocumentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{53}
+}
+
+type UpdateDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          DocumentKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=api.v1.DocumentKind" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Label         string                 `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDocumentRequest) Reset() {
+	*x = UpdateDocumentRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentRequest) ProtoMessage() {}
+
+func (x *UpdateDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocumentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateDocumentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetKind() DocumentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DocumentKind_DOCUMENT_KIND_UNSPECIFIED
+}
+
+func (x *UpdateDocumentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDocumentRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type UpdateDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDocumentResponse) Reset() {
+	*x = UpdateDocumentResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDocumentResponse) ProtoMessage() {}
+
+func (x *UpdateDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDocumentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UpdateDocumentResponse) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ListDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Kind          DocumentKind           `protobuf:"varint,3,opt,name=kind,proto3,enum=api.v1.DocumentKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsRequest) Reset() {
+	*x = ListDocumentsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsRequest) ProtoMessage() {}
+
+func (x *ListDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListDocumentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDocumentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListDocumentsRequest) GetKind() DocumentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DocumentKind_DOCUMENT_KIND_UNSPECIFIED
+}
+
+type ListDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*DocumentSummary     `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDocumentsResponse) Reset() {
+	*x = ListDocumentsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDocumentsResponse) ProtoMessage() {}
+
+func (x *ListDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListDocumentsResponse) GetDocuments() []*DocumentSummary {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *ListDocumentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchDocumentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Kind          DocumentKind           `protobuf:"varint,2,opt,name=kind,proto3,enum=api.v1.DocumentKind" json:"kind,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchDocumentsRequest) Reset() {
+	*x = SearchDocumentsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDocumentsRequest) ProtoMessage() {}
+
+func (x *SearchDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*SearchDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SearchDocumentsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchDocumentsRequest) GetKind() DocumentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DocumentKind_DOCUMENT_KIND_UNSPECIFIED
+}
+
+func (x *SearchDocumentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchDocumentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DocumentSearchResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Document         *Document              `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	ApplicationCount int32                  `protobuf:"varint,2,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
+	Rank             float64                `protobuf:"fixed64,3,opt,name=rank,proto3" json:"rank,omitempty"`
+	Snippet          string                 `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DocumentSearchResult) Reset() {
+	*x = DocumentSearchResult{}
+	mi := &file_api_v1_api_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DocumentSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DocumentSearchResult) ProtoMessage() {}
+
+func (x *DocumentSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DocumentSearchResult.ProtoReflect.Descriptor instead.
+func (*DocumentSearchResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DocumentSearchResult) GetDocument() *Document {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *DocumentSearchResult) GetApplicationCount() int32 {
+	if x != nil {
+		return x.ApplicationCount
+	}
+	return 0
+}
+
+func (x *DocumentSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *DocumentSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type SearchDocumentsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*DocumentSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchDocumentsResponse) Reset() {
+	*x = SearchDocumentsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDocumentsResponse) ProtoMessage() {}
+
+func (x *SearchDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*SearchDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SearchDocumentsResponse) GetResults() []*DocumentSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchDocumentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ScoreJobApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreJobApplicationRequest) Reset() {
+	*x = ScoreJobApplicationRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreJobApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreJobApplicationRequest) ProtoMessage() {}
+
+func (x *ScoreJobApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreJobApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ScoreJobApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ScoreJobApplicationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ScoreJobApplicationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	Score          int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	MatchedSkills  []string               `protobuf:"bytes,3,rep,name=matched_skills,json=matchedSkills,proto3" json:"matched_skills,omitempty"`
+	MissingSkills  []string               `protobuf:"bytes,4,rep,name=missing_skills,json=missingSkills,proto3" json:"missing_skills,omitempty"`
+	KeywordOverlap float64                `protobuf:"fixed64,5,opt,name=keyword_overlap,json=keywordOverlap,proto3" json:"keyword_overlap,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScoreJobApplicationResponse) Reset() {
+	*x = ScoreJobApplicationResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreJobApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreJobApplicationResponse) ProtoMessage() {}
+
+func (x *ScoreJobApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreJobApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ScoreJobApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ScoreJobApplicationResponse) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+func (x *ScoreJobApplicationResponse) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ScoreJobApplicationResponse) GetMatchedSkills() []string {
+	if x != nil {
+		return x.MatchedSkills
+	}
+	return nil
+}
+
+func (x *ScoreJobApplicationResponse) GetMissingSkills() []string {
+	if x != nil {
+		return x.MissingSkills
+	}
+	return nil
+}
+
+func (x *ScoreJobApplicationResponse) GetKeywordOverlap() float64 {
+	if x != nil {
+		return x.KeywordOverlap
+	}
+	return 0
+}
+
+type ImportColumnMapping struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Column        string                 `protobuf:"bytes,2,opt,name=column,proto3" json:"column,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportColumnMapping) Reset() {
+	*x = ImportColumnMapping{}
+	mi := &file_api_v1_api_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportColumnMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportColumnMapping) ProtoMessage() {}
+
+func (x *ImportColumnMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportColumnMapping.ProtoReflect.Descriptor instead.
+func (*ImportColumnMapping) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ImportColumnMapping) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportColumnMapping) GetColumn() string {
+	if x != nil {
+		return x.Column
+	}
+	return ""
+}
+
+type ImportJobApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ImportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=api.v1.ImportFormat" json:"format,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Mapping       []*ImportColumnMapping `protobuf:"bytes,3,rep,name=mapping,proto3" json:"mapping,omitempty"`
+	DayFirst      bool                   `protobuf:"varint,4,opt,name=day_first,json=dayFirst,proto3" json:"day_first,omitempty"`
+	DryRun        bool                   `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobApplicationsRequest) Reset() {
+	*x = ImportJobApplicationsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobApplicationsRequest) ProtoMessage() {}
+
+func (x *ImportJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ImportJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ImportJobApplicationsRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportJobApplicationsRequest) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *ImportJobApplicationsRequest) GetMapping() []*ImportColumnMapping {
+	if x != nil {
+		return x.Mapping
+	}
+	return nil
+}
+
+func (x *ImportJobApplicationsRequest) GetDayFirst() bool {
+	if x != nil {
+		return x.DayFirst
+	}
+	return false
+}
+
+func (x *ImportJobApplicationsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRowResult struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Row              int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	ExternalId       string                 `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	Outcome          ImportRowOutcome       `protobuf:"varint,3,opt,name=outcome,proto3,enum=api.v1.ImportRowOutcome" json:"outcome,omitempty"`
+	Field            string                 `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	Error            string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	JobApplicationId string                 `protobuf:"bytes,6,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_api_v1_api_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetExternalId() string {
+	if x != nil {
+		return x.ExternalId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetOutcome() ImportRowOutcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return ImportRowOutcome_IMPORT_ROW_OUTCOME_UNSPECIFIED
+}
+
+func (x *ImportRowResult) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetJobApplicationId() string {
+	if x != nil {
+		return x.JobApplicationId
+	}
+	return ""
+}
+
+type ImportJobApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ImportRowResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	CreatedCount  int32                  `protobuf:"varint,2,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	SkippedCount  int32                  `protobuf:"varint,3,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	InvalidCount  int32                  `protobuf:"varint,4,opt,name=invalid_count,json=invalidCount,proto3" json:"invalid_count,omitempty"`
+	Committed     bool                   `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJobApplicationsResponse) Reset() {
+	*x = ImportJobApplicationsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJobApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJobApplicationsResponse) ProtoMessage() {}
+
+func (x *ImportJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ImportJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ImportJobApplicationsResponse) GetResults() []*ImportRowResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportJobApplicationsResponse) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *ImportJobApplicationsResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *ImportJobApplicationsResponse) GetInvalidCount() int32 {
+	if x != nil {
+		return x.InvalidCount
+	}
+	return 0
+}
+
+func (x *ImportJobApplicationsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+type ExportJobApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        ExportFormat           `protobuf:"varint,1,opt,name=format,proto3,enum=api.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJobApplicationsRequest) Reset() {
+	*x = ExportJobApplicationsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJobApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJobApplicationsRequest) ProtoMessage() {}
+
+func (x *ExportJobApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJobApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportJobApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportJobApplicationsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportJobApplicationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJobApplicationsResponse) Reset() {
+	*x = ExportJobApplicationsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJobApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJobApplicationsResponse) ProtoMessage() {}
+
+func (x *ExportJobApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJobApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ExportJobApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ExportJobApplicationsResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ExportJobApplicationsResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportJobApplicationsResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GetPipelineStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AppliedOnFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=applied_on_from,json=appliedOnFrom,proto3" json:"applied_on_from,omitempty"`
+	AppliedOnTo   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=applied_on_to,json=appliedOnTo,proto3" json:"applied_on_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPipelineStatsRequest) Reset() {
+	*x = GetPipelineStatsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineStatsRequest) ProtoMessage() {}
+
+func (x *GetPipelineStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPipelineStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetPipelineStatsRequest) GetAppliedOnFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOnFrom
+	}
+	return nil
+}
+
+func (x *GetPipelineStatsRequest) GetAppliedOnTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedOnTo
+	}
+	return nil
+}
+
+type StatusCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        JobApplicationStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusCount) Reset() {
+	*x = StatusCount{}
+	mi := &file_api_v1_api_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusCount) ProtoMessage() {}
+
+func (x *StatusCount) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusCount.ProtoReflect.Descriptor instead.
+func (*StatusCount) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *StatusCount) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *StatusCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type StageConversion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromStatus    JobApplicationStatus   `protobuf:"varint,1,opt,name=from_status,json=fromStatus,proto3,enum=api.v1.JobApplicationStatus" json:"from_status,omitempty"`
+	ToStatus      JobApplicationStatus   `protobuf:"varint,2,opt,name=to_status,json=toStatus,proto3,enum=api.v1.JobApplicationStatus" json:"to_status,omitempty"`
+	FromCount     int32                  `protobuf:"varint,3,opt,name=from_count,json=fromCount,proto3" json:"from_count,omitempty"`
+	ToCount       int32                  `protobuf:"varint,4,opt,name=to_count,json=toCount,proto3" json:"to_count,omitempty"`
+	Rate          float64                `protobuf:"fixed64,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageConversion) Reset() {
+	*x = StageConversion{}
+	mi := &file_api_v1_api_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageConversion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageConversion) ProtoMessage() {}
+
+func (x *StageConversion) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageConversion.ProtoReflect.Descriptor instead.
+func (*StageConversion) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *StageConversion) GetFromStatus() JobApplicationStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *StageConversion) GetToStatus() JobApplicationStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *StageConversion) GetFromCount() int32 {
+	if x != nil {
+		return x.FromCount
+	}
+	return 0
+}
+
+func (x *StageConversion) GetToCount() int32 {
+	if x != nil {
+		return x.ToCount
+	}
+	return 0
+}
+
+func (x *StageConversion) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type StageDuration struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        JobApplicationStatus   `protobuf:"varint,1,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
+	MedianDays    float64                `protobuf:"fixed64,2,opt,name=median_days,json=medianDays,proto3" json:"median_days,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StageDuration) Reset() {
+	*x = StageDuration{}
+	mi := &file_api_v1_api_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StageDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StageDuration) ProtoMessage() {}
+
+func (x *StageDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StageDuration.ProtoReflect.Descriptor instead.
+func (*StageDuration) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *StageDuration) GetStatus() JobApplicationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+}
+
+func (x *StageDuration) GetMedianDays() float64 {
+	if x != nil {
+		return x.MedianDays
+	}
+	return 0
+}
+
+func (x *StageDuration) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type WeeklyResponseRate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WeekStart      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=week_start,json=weekStart,proto3" json:"week_start,omitempty"`
+	AppliedCount   int32                  `protobuf:"varint,2,opt,name=applied_count,json=appliedCount,proto3" json:"applied_count,omitempty"`
+	RespondedCount int32                  `protobuf:"varint,3,opt,name=responded_count,json=respondedCount,proto3" json:"responded_count,omitempty"`
+	Rate           float64                `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WeeklyResponseRate) Reset() {
+	*x = WeeklyResponseRate{}
+	mi := &file_api_v1_api_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeeklyResponseRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeeklyResponseRate) ProtoMessage() {}
+
+func (x *WeeklyResponseRate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeeklyResponseRate.ProtoReflect.Descriptor instead.
+func (*WeeklyResponseRate) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WeeklyResponseRate) GetWeekStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WeekStart
+	}
+	return nil
+}
+
+func (x *WeeklyResponseRate) GetAppliedCount() int32 {
+	if x != nil {
+		return x.AppliedCount
+	}
+	return 0
+}
+
+func (x *WeeklyResponseRate) GetRespondedCount() int32 {
+	if x != nil {
+		return x.RespondedCount
+	}
+	return 0
+}
+
+func (x *WeeklyResponseRate) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type GetPipelineStatsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	StatusCounts        []*StatusCount         `protobuf:"bytes,1,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	Conversions         []*StageConversion     `protobuf:"bytes,2,rep,name=conversions,proto3" json:"conversions,omitempty"`
+	TimeInStage         []*StageDuration       `protobuf:"bytes,3,rep,name=time_in_stage,json=timeInStage,proto3" json:"time_in_stage,omitempty"`
+	WeeklyResponseRates []*WeeklyResponseRate  `protobuf:"bytes,4,rep,name=weekly_response_rates,json=weeklyResponseRates,proto3" json:"weekly_response_rates,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetPipelineStatsResponse) Reset() {
+	*x = GetPipelineStatsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPipelineStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPipelineStatsResponse) ProtoMessage() {}
+
+func (x *GetPipelineStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPipelineStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPipelineStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetPipelineStatsResponse) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *GetPipelineStatsResponse) GetConversions() []*StageConversion {
+	if x != nil {
+		return x.Conversions
+	}
+	return nil
+}
+
+func (x *GetPipelineStatsResponse) GetTimeInStage() []*StageDuration {
+	if x != nil {
+		return x.TimeInStage
+	}
+	return nil
+}
+
+func (x *GetPipelineStatsResponse) GetWeeklyResponseRates() []*WeeklyResponseRate {
+	if x != nil {
+		return x.WeeklyResponseRates
+	}
+	return nil
+}
+
+type ListDueFollowUpsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueFollowUpsRequest) Reset() {
+	*x = ListDueFollowUpsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueFollowUpsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueFollowUpsRequest) ProtoMessage() {}
+
+func (x *ListDueFollowUpsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueFollowUpsRequest.ProtoReflect.Descriptor instead.
+func (*ListDueFollowUpsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{75}
+}
+
+type DueFollowUp struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	Reason         FollowUpReason         `protobuf:"varint,2,opt,name=reason,proto3,enum=api.v1.FollowUpReason" json:"reason,omitempty"`
+	DueAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DueFollowUp) Reset() {
+	*x = DueFollowUp{}
+	mi := &file_api_v1_api_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DueFollowUp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DueFollowUp) ProtoMessage() {}
+
+func (x *DueFollowUp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DueFollowUp.ProtoReflect.Descriptor instead.
+func (*DueFollowUp) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DueFollowUp) GetJobApplication() *JobApplication {
+	if x != nil {
+		return x.JobApplication
+	}
+	return nil
+}
+
+func (x *DueFollowUp) GetReason() FollowUpReason {
+	if x != nil {
+		return x.Reason
+	}
+	return FollowUpReason_FOLLOW_UP_REASON_UNSPECIFIED
+}
+
+func (x *DueFollowUp) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+type ListDueFollowUpsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowUps     []*DueFollowUp         `protobuf:"bytes,1,rep,name=follow_ups,json=followUps,proto3" json:"follow_ups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDueFollowUpsResponse) Reset() {
+	*x = ListDueFollowUpsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDueFollowUpsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDueFollowUpsResponse) ProtoMessage() {}
+
+func (x *ListDueFollowUpsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDueFollowUpsResponse.ProtoReflect.Descriptor instead.
+func (*ListDueFollowUpsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListDueFollowUpsResponse) GetFollowUps() []*DueFollowUp {
+	if x != nil {
+		return x.FollowUps
+	}
+	return nil
+}
+
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UnsubscribeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnsubscribeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeResponse) Reset() {
+	*x = UnsubscribeResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeResponse) ProtoMessage() {}
+
+func (x *UnsubscribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{79}
+}
+
+type Company struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Website       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	Location      *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Size          CompanySize             `protobuf:"varint,5,opt,name=size,proto3,enum=api.v1.CompanySize" json:"size,omitempty"`
+	Industry      *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=industry,proto3" json:"industry,omitempty"`
+	Notes         *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_api_v1_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *Company) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+func (x *Company) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Company) GetSize() CompanySize {
+	if x != nil {
+		return x.Size
+	}
+	return CompanySize_COMPANY_SIZE_UNSPECIFIED
+}
+
+func (x *Company) GetIndustry() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Industry
+	}
+	return nil
+}
+
+func (x *Company) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Company) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Company) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCompanyRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Website       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	Location      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Size          CompanySize             `protobuf:"varint,4,opt,name=size,proto3,enum=api.v1.CompanySize" json:"size,omitempty"`
+	Industry      *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=industry,proto3" json:"industry,omitempty"`
+	Notes         *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetSize() CompanySize {
+	if x != nil {
+		return x.Size
+	}
+	return CompanySize_COMPANY_SIZE_UNSPECIFIED
+}
+
+func (x *CreateCompanyRequest) GetIndustry() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Industry
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type CreateCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyResponse) Reset() {
+	*x = CreateCompanyResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyResponse) ProtoMessage() {}
+
+func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+type UpdateCompanyRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Website       *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=website,proto3" json:"website,omitempty"`
+	Location      *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Size          CompanySize             `protobuf:"varint,5,opt,name=size,proto3,enum=api.v1.CompanySize" json:"size,omitempty"`
+	Industry      *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=industry,proto3" json:"industry,omitempty"`
+	Notes         *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetLocation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetSize() CompanySize {
+	if x != nil {
+		return x.Size
+	}
+	return CompanySize_COMPANY_SIZE_UNSPECIFIED
+}
+
+func (x *UpdateCompanyRequest) GetIndustry() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Industry
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetNotes() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type UpdateCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyResponse) Reset() {
+	*x = UpdateCompanyResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyResponse) ProtoMessage() {}
+
+func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UpdateCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+type ListCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{85}
+}
+
+type CompanySummary struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Company          *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	ApplicationCount int32                  `protobuf:"varint,2,opt,name=application_count,json=applicationCount,proto3" json:"application_count,omitempty"`
+	StatusCounts     []*StatusCount         `protobuf:"bytes,3,rep,name=status_counts,json=statusCounts,proto3" json:"status_counts,omitempty"`
+	LastAppliedOn    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_applied_on,json=lastAppliedOn,proto3" json:"last_applied_on,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CompanySummary) Reset() {
+	*x = CompanySummary{}
+	mi := &file_api_v1_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySummary) ProtoMessage() {}
+
+func (x *CompanySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanySummary.ProtoReflect.Descriptor instead.
+func (*CompanySummary) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CompanySummary) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *CompanySummary) GetApplicationCount() int32 {
+	if x != nil {
+		return x.ApplicationCount
+	}
+	return 0
+}
+
+func (x *CompanySummary) GetStatusCounts() []*StatusCount {
+	if x != nil {
+		return x.StatusCounts
+	}
+	return nil
+}
+
+func (x *CompanySummary) GetLastAppliedOn() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAppliedOn
+	}
+	return nil
+}
+
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*CompanySummary      `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*CompanySummary {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+type Contact struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Email         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	LinkedinUrl   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	CompanyId     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Contact) Reset() {
+	*x = Contact{}
+	mi := &file_api_v1_api_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Contact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Contact) ProtoMessage() {}
+
+func (x *Contact) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Contact.ProtoReflect.Descriptor instead.
+func (*Contact) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *Contact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Contact) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contact) GetRole() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+func (x *Contact) GetEmail() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *Contact) GetPhone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
 }
 
-func (JobApplicationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_v1_api_proto_enumTypes[0].Descriptor()
+func (x *Contact) GetLinkedinUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.LinkedinUrl
+	}
+	return nil
 }
 
-func (JobApplicationStatus) Type() protoreflect.EnumType {
-	return &file_api_v1_api_proto_enumTypes[0]
+func (x *Contact) GetCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CompanyId
+	}
+	return nil
 }
 
-func (x JobApplicationStatus) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Contact) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-// Deprecated: Use JobApplicationStatus.Descriptor instead.
-func (JobApplicationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{0}
+func (x *Contact) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
 }
 
-type CreateJobApplicationRequest struct {
+type CreateContactRequest struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Company       string                  `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
-	Title         string                  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Notes         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
-	Cv            *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=cv,proto3" json:"cv,omitempty"`
-	CoverLetter   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	AppliedOn     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`
-	Status        JobApplicationStatus    `protobuf:"varint,8,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
-	Position      string                  `protobuf:"bytes,9,opt,name=position,proto3" json:"position,omitempty"`
+	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Role          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Email         *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	LinkedinUrl   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	CompanyId     *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateJobApplicationRequest) Reset() {
-	*x = CreateJobApplicationRequest{}
-	mi := &file_api_v1_api_proto_msgTypes[0]
+func (x *CreateContactRequest) Reset() {
+	*x = CreateContactRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateJobApplicationRequest) String() string {
+func (x *CreateContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJobApplicationRequest) ProtoMessage() {}
+func (*CreateContactRequest) ProtoMessage() {}
 
-func (x *CreateJobApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[0]
+func (x *CreateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -127,96 +6225,75 @@ func (x *CreateJobApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJobApplicationRequest.ProtoReflect.Descriptor instead.
-func (*CreateJobApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *CreateJobApplicationRequest) GetCompany() string {
-	if x != nil {
-		return x.Company
-	}
-	return ""
+// Deprecated: Use CreateContactRequest.ProtoReflect.Descriptor instead.
+func (*CreateContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *CreateJobApplicationRequest) GetTitle() string {
+func (x *CreateContactRequest) GetName() string {
 	if x != nil {
-		return x.Title
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateJobApplicationRequest) GetDescription() *wrapperspb.StringValue {
+func (x *CreateContactRequest) GetRole() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Description
+		return x.Role
 	}
 	return nil
 }
 
-func (x *CreateJobApplicationRequest) GetNotes() *wrapperspb.StringValue {
+func (x *CreateContactRequest) GetEmail() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Notes
+		return x.Email
 	}
 	return nil
 }
 
-func (x *CreateJobApplicationRequest) GetCv() *wrapperspb.StringValue {
+func (x *CreateContactRequest) GetPhone() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Cv
+		return x.Phone
 	}
 	return nil
 }
 
-func (x *CreateJobApplicationRequest) GetCoverLetter() *wrapperspb.StringValue {
+func (x *CreateContactRequest) GetLinkedinUrl() *wrapperspb.StringValue {
 	if x != nil {
-		return x.CoverLetter
+		return x.LinkedinUrl
 	}
 	return nil
 }
 
-func (x *CreateJobApplicationRequest) GetAppliedOn() *timestamppb.Timestamp {
+func (x *CreateContactRequest) GetCompanyId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.AppliedOn
+		return x.CompanyId
 	}
 	return nil
 }
 
-func (x *CreateJobApplicationRequest) GetStatus() JobApplicationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
-}
-
-func (x *CreateJobApplicationRequest) GetPosition() string {
-	if x != nil {
-		return x.Position
-	}
-	return ""
-}
-
-type CreateJobApplicationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CreateContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateJobApplicationResponse) Reset() {
-	*x = CreateJobApplicationResponse{}
-	mi := &file_api_v1_api_proto_msgTypes[1]
+func (x *CreateContactResponse) Reset() {
+	*x = CreateContactResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateJobApplicationResponse) String() string {
+func (x *CreateContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJobApplicationResponse) ProtoMessage() {}
+func (*CreateContactResponse) ProtoMessage() {}
 
-func (x *CreateJobApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[1]
+func (x *CreateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -227,39 +6304,40 @@ func (x *CreateJobApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJobApplicationResponse.ProtoReflect.Descriptor instead.
-func (*CreateJobApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use CreateContactResponse.ProtoReflect.Descriptor instead.
+func (*CreateContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *CreateJobApplicationResponse) GetJobApplication() *JobApplication {
+func (x *CreateContactResponse) GetContact() *Contact {
 	if x != nil {
-		return x.JobApplication
+		return x.Contact
 	}
 	return nil
 }
 
-type ListJobApplicationsRequest struct {
+type GetContactRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListJobApplicationsRequest) Reset() {
-	*x = ListJobApplicationsRequest{}
-	mi := &file_api_v1_api_proto_msgTypes[2]
+func (x *GetContactRequest) Reset() {
+	*x = GetContactRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobApplicationsRequest) String() string {
+func (x *GetContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobApplicationsRequest) ProtoMessage() {}
+func (*GetContactRequest) ProtoMessage() {}
 
-func (x *ListJobApplicationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[2]
+func (x *GetContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,33 +6348,40 @@ func (x *ListJobApplicationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobApplicationsRequest.ProtoReflect.Descriptor instead.
-func (*ListJobApplicationsRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetContactRequest.ProtoReflect.Descriptor instead.
+func (*GetContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{91}
 }
 
-type ListJobApplicationsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	JobApplications []*JobApplication      `protobuf:"bytes,1,rep,name=job_applications,json=jobApplications,proto3" json:"job_applications,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *GetContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *ListJobApplicationsResponse) Reset() {
-	*x = ListJobApplicationsResponse{}
-	mi := &file_api_v1_api_proto_msgTypes[3]
+type GetContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContactResponse) Reset() {
+	*x = GetContactResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListJobApplicationsResponse) String() string {
+func (x *GetContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListJobApplicationsResponse) ProtoMessage() {}
+func (*GetContactResponse) ProtoMessage() {}
 
-func (x *ListJobApplicationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[3]
+func (x *GetContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -307,49 +6392,46 @@ func (x *ListJobApplicationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListJobApplicationsResponse.ProtoReflect.Descriptor instead.
-func (*ListJobApplicationsResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetContactResponse.ProtoReflect.Descriptor instead.
+func (*GetContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListJobApplicationsResponse) GetJobApplications() []*JobApplication {
+func (x *GetContactResponse) GetContact() *Contact {
 	if x != nil {
-		return x.JobApplications
+		return x.Contact
 	}
 	return nil
 }
 
-type UpdateJobApplicationRequest struct {
+type UpdateContactRequest struct {
 	state         protoimpl.MessageState  `protogen:"open.v1"`
 	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Company       string                  `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
-	Title         string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Notes         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	Cv            *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=cv,proto3" json:"cv,omitempty"`
-	CoverLetter   *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	Status        JobApplicationStatus    `protobuf:"varint,8,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
-	AppliedOn     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`
-	Position      string                  `protobuf:"bytes,10,opt,name=position,proto3" json:"position,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Role          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Email         *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	LinkedinUrl   *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=linkedin_url,json=linkedinUrl,proto3" json:"linkedin_url,omitempty"`
+	CompanyId     *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJobApplicationRequest) Reset() {
-	*x = UpdateJobApplicationRequest{}
-	mi := &file_api_v1_api_proto_msgTypes[4]
+func (x *UpdateContactRequest) Reset() {
+	*x = UpdateContactRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJobApplicationRequest) String() string {
+func (x *UpdateContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobApplicationRequest) ProtoMessage() {}
+func (*UpdateContactRequest) ProtoMessage() {}
 
-func (x *UpdateJobApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[4]
+func (x *UpdateContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,103 +6442,258 @@ func (x *UpdateJobApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobApplicationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use UpdateContactRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *UpdateJobApplicationRequest) GetId() string {
+func (x *UpdateContactRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateJobApplicationRequest) GetCompany() string {
+func (x *UpdateContactRequest) GetName() string {
 	if x != nil {
-		return x.Company
+		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateJobApplicationRequest) GetTitle() string {
+func (x *UpdateContactRequest) GetRole() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Title
+		return x.Role
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateJobApplicationRequest) GetDescription() *wrapperspb.StringValue {
+func (x *UpdateContactRequest) GetEmail() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Description
+		return x.Email
 	}
 	return nil
 }
 
-func (x *UpdateJobApplicationRequest) GetNotes() *wrapperspb.StringValue {
+func (x *UpdateContactRequest) GetPhone() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Notes
+		return x.Phone
 	}
 	return nil
 }
 
-func (x *UpdateJobApplicationRequest) GetCv() *wrapperspb.StringValue {
+func (x *UpdateContactRequest) GetLinkedinUrl() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Cv
+		return x.LinkedinUrl
+	}
+	return nil
+}
+
+func (x *UpdateContactRequest) GetCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.CompanyId
+	}
+	return nil
+}
+
+type UpdateContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contact       *Contact               `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContactResponse) Reset() {
+	*x = UpdateContactResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContactResponse) ProtoMessage() {}
+
+func (x *UpdateContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContactResponse.ProtoReflect.Descriptor instead.
+func (*UpdateContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *UpdateContactResponse) GetContact() *Contact {
+	if x != nil {
+		return x.Contact
 	}
 	return nil
 }
 
-func (x *UpdateJobApplicationRequest) GetCoverLetter() *wrapperspb.StringValue {
-	if x != nil {
-		return x.CoverLetter
-	}
-	return nil
+type DeleteContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactRequest) Reset() {
+	*x = DeleteContactRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactRequest) ProtoMessage() {}
+
+func (x *DeleteContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContactResponse) Reset() {
+	*x = DeleteContactResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContactResponse) ProtoMessage() {}
+
+func (x *DeleteContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContactResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{96}
+}
+
+type ListContactsRequest struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	CompanyId        *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	JobApplicationId *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListContactsRequest) Reset() {
+	*x = ListContactsRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateJobApplicationRequest) GetStatus() JobApplicationStatus {
+func (*ListContactsRequest) ProtoMessage() {}
+
+func (x *ListContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[97]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateJobApplicationRequest) GetAppliedOn() *timestamppb.Timestamp {
+// Deprecated: Use ListContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListContactsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListContactsRequest) GetCompanyId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.AppliedOn
+		return x.CompanyId
 	}
 	return nil
 }
 
-func (x *UpdateJobApplicationRequest) GetPosition() string {
+func (x *ListContactsRequest) GetJobApplicationId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Position
+		return x.JobApplicationId
 	}
-	return ""
+	return nil
 }
 
-type UpdateJobApplicationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ListContactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Contacts      []*Contact             `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJobApplicationResponse) Reset() {
-	*x = UpdateJobApplicationResponse{}
-	mi := &file_api_v1_api_proto_msgTypes[5]
+func (x *ListContactsResponse) Reset() {
+	*x = ListContactsResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJobApplicationResponse) String() string {
+func (x *ListContactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobApplicationResponse) ProtoMessage() {}
+func (*ListContactsResponse) ProtoMessage() {}
 
-func (x *UpdateJobApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[5]
+func (x *ListContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,40 +6704,41 @@ func (x *UpdateJobApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobApplicationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJobApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ListContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListContactsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *UpdateJobApplicationResponse) GetJobApplication() *JobApplication {
+func (x *ListContactsResponse) GetContacts() []*Contact {
 	if x != nil {
-		return x.JobApplication
+		return x.Contacts
 	}
 	return nil
 }
 
-type DeleteJobApplicationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type LinkContactRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ContactId        string                 `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	JobApplicationId string                 `protobuf:"bytes,2,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *DeleteJobApplicationRequest) Reset() {
-	*x = DeleteJobApplicationRequest{}
-	mi := &file_api_v1_api_proto_msgTypes[6]
+func (x *LinkContactRequest) Reset() {
+	*x = LinkContactRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobApplicationRequest) String() string {
+func (x *LinkContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobApplicationRequest) ProtoMessage() {}
+func (*LinkContactRequest) ProtoMessage() {}
 
-func (x *DeleteJobApplicationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[6]
+func (x *LinkContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -511,39 +6749,46 @@ func (x *DeleteJobApplicationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobApplicationRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJobApplicationRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use LinkContactRequest.ProtoReflect.Descriptor instead.
+func (*LinkContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DeleteJobApplicationRequest) GetId() string {
+func (x *LinkContactRequest) GetContactId() string {
 	if x != nil {
-		return x.Id
+		return x.ContactId
 	}
 	return ""
 }
 
-type DeleteJobApplicationResponse struct {
+func (x *LinkContactRequest) GetJobApplicationId() string {
+	if x != nil {
+		return x.JobApplicationId
+	}
+	return ""
+}
+
+type LinkContactResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteJobApplicationResponse) Reset() {
-	*x = DeleteJobApplicationResponse{}
-	mi := &file_api_v1_api_proto_msgTypes[7]
+func (x *LinkContactResponse) Reset() {
+	*x = LinkContactResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteJobApplicationResponse) String() string {
+func (x *LinkContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJobApplicationResponse) ProtoMessage() {}
+func (*LinkContactResponse) ProtoMessage() {}
 
-func (x *DeleteJobApplicationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[7]
+func (x *LinkContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -554,44 +6799,34 @@ func (x *DeleteJobApplicationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJobApplicationResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJobApplicationResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use LinkContactResponse.ProtoReflect.Descriptor instead.
+func (*LinkContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{100}
 }
 
-type JobApplication struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Company       string                  `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
-	Title         string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Status        JobApplicationStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Notes         *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	Cv            *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=cv,proto3" json:"cv,omitempty"`
-	CoverLetter   *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=cover_letter,json=coverLetter,proto3" json:"cover_letter,omitempty"`
-	AppliedOn     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=applied_on,json=appliedOn,proto3" json:"applied_on,omitempty"`
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Position      string                  `protobuf:"bytes,12,opt,name=position,proto3" json:"position,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UnlinkContactRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ContactId        string                 `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	JobApplicationId string                 `protobuf:"bytes,2,opt,name=job_application_id,json=jobApplicationId,proto3" json:"job_application_id,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *JobApplication) Reset() {
-	*x = JobApplication{}
-	mi := &file_api_v1_api_proto_msgTypes[8]
+func (x *UnlinkContactRequest) Reset() {
+	*x = UnlinkContactRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobApplication) String() string {
+func (x *UnlinkContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobApplication) ProtoMessage() {}
+func (*UnlinkContactRequest) ProtoMessage() {}
 
-func (x *JobApplication) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[8]
+func (x *UnlinkContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,119 +6837,129 @@ func (x *JobApplication) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobApplication.ProtoReflect.Descriptor instead.
-func (*JobApplication) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UnlinkContactRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkContactRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *JobApplication) GetId() string {
+func (x *UnlinkContactRequest) GetContactId() string {
 	if x != nil {
-		return x.Id
+		return x.ContactId
 	}
 	return ""
 }
 
-func (x *JobApplication) GetCompany() string {
+func (x *UnlinkContactRequest) GetJobApplicationId() string {
 	if x != nil {
-		return x.Company
+		return x.JobApplicationId
 	}
 	return ""
 }
 
-func (x *JobApplication) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type UnlinkContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JobApplication) GetStatus() JobApplicationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+func (x *UnlinkContactResponse) Reset() {
+	*x = UnlinkContactResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobApplication) GetDescription() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Description
-	}
-	return nil
+func (x *UnlinkContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JobApplication) GetNotes() *wrapperspb.StringValue {
+func (*UnlinkContactResponse) ProtoMessage() {}
+
+func (x *UnlinkContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[102]
 	if x != nil {
-		return x.Notes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *JobApplication) GetCv() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Cv
-	}
-	return nil
+// Deprecated: Use UnlinkContactResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkContactResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *JobApplication) GetCoverLetter() *wrapperspb.StringValue {
-	if x != nil {
-		return x.CoverLetter
-	}
-	return nil
+type GetContactTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JobApplication) GetAppliedOn() *timestamppb.Timestamp {
-	if x != nil {
-		return x.AppliedOn
-	}
-	return nil
+func (x *GetContactTimelineRequest) Reset() {
+	*x = GetContactTimelineRequest{}
+	mi := &file_api_v1_api_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobApplication) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *GetContactTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *JobApplication) GetUpdatedAt() *timestamppb.Timestamp {
+func (*GetContactTimelineRequest) ProtoMessage() {}
+
+func (x *GetContactTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[103]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *JobApplication) GetPosition() string {
+// Deprecated: Use GetContactTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetContactTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetContactTimelineRequest) GetId() string {
 	if x != nil {
-		return x.Position
+		return x.Id
 	}
 	return ""
 }
 
-type UpdateJobApplicationStatusRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        JobApplicationStatus    `protobuf:"varint,2,opt,name=status,proto3,enum=api.v1.JobApplicationStatus" json:"status,omitempty"`
-	Position      *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ContactTimelineEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
+	LinkedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	Events         []*JobApplicationEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *UpdateJobApplicationStatusRequest) Reset() {
-	*x = UpdateJobApplicationStatusRequest{}
-	mi := &file_api_v1_api_proto_msgTypes[9]
+func (x *ContactTimelineEntry) Reset() {
+	*x = ContactTimelineEntry{}
+	mi := &file_api_v1_api_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJobApplicationStatusRequest) String() string {
+func (x *ContactTimelineEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobApplicationStatusRequest) ProtoMessage() {}
+func (*ContactTimelineEntry) ProtoMessage() {}
 
-func (x *UpdateJobApplicationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[9]
+func (x *ContactTimelineEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -725,54 +6970,54 @@ func (x *UpdateJobApplicationStatusRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobApplicationStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateJobApplicationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ContactTimelineEntry.ProtoReflect.Descriptor instead.
+func (*ContactTimelineEntry) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UpdateJobApplicationStatusRequest) GetId() string {
+func (x *ContactTimelineEntry) GetJobApplication() *JobApplication {
 	if x != nil {
-		return x.Id
+		return x.JobApplication
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateJobApplicationStatusRequest) GetStatus() JobApplicationStatus {
+func (x *ContactTimelineEntry) GetLinkedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.LinkedAt
 	}
-	return JobApplicationStatus_JOB_APPLICATION_STATUS_UNSPECIFIED
+	return nil
 }
 
-func (x *UpdateJobApplicationStatusRequest) GetPosition() *wrapperspb.StringValue {
+func (x *ContactTimelineEntry) GetEvents() []*JobApplicationEvent {
 	if x != nil {
-		return x.Position
+		return x.Events
 	}
 	return nil
 }
 
-type UpdateJobApplicationStatusResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	JobApplication *JobApplication        `protobuf:"bytes,1,opt,name=job_application,json=jobApplication,proto3" json:"job_application,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetContactTimelineResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*ContactTimelineEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateJobApplicationStatusResponse) Reset() {
-	*x = UpdateJobApplicationStatusResponse{}
-	mi := &file_api_v1_api_proto_msgTypes[10]
+func (x *GetContactTimelineResponse) Reset() {
+	*x = GetContactTimelineResponse{}
+	mi := &file_api_v1_api_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateJobApplicationStatusResponse) String() string {
+func (x *GetContactTimelineResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateJobApplicationStatusResponse) ProtoMessage() {}
+func (*GetContactTimelineResponse) ProtoMessage() {}
 
-func (x *UpdateJobApplicationStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_v1_api_proto_msgTypes[10]
+func (x *GetContactTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_api_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,14 +7028,14 @@ func (x *UpdateJobApplicationStatusResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateJobApplicationStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateJobApplicationStatusResponse) Descriptor() ([]byte, []int) {
-	return file_api_v1_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetContactTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetContactTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_api_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *UpdateJobApplicationStatusResponse) GetJobApplication() *JobApplication {
+func (x *GetContactTimelineResponse) GetEntries() []*ContactTimelineEntry {
 	if x != nil {
-		return x.JobApplication
+		return x.Entries
 	}
 	return nil
 }
@@ -799,7 +7044,7 @@ var File_api_v1_api_proto protoreflect.FileDescriptor
 
 const file_api_v1_api_proto_rawDesc = "" +
 	"\n" +
-	"\x10api/v1/api.proto\x12\x06api.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xbd\x03\n" +
+	"\x10api/v1/api.proto\x12\x06api.v1\x1a\x1egoogle/protobuf/duration.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xd3\x05\n" +
 	"\x1bCreateJobApplicationRequest\x12\x18\n" +
 	"\acompany\x18\x01 \x01(\tR\acompany\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12>\n" +
@@ -810,12 +7055,38 @@ const file_api_v1_api_proto_rawDesc = "" +
 	"\n" +
 	"applied_on\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tappliedOn\x124\n" +
 	"\x06status\x18\b \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\x06status\x12\x1a\n" +
-	"\bposition\x18\t \x01(\tR\bposition\"_\n" +
+	"\bposition\x18\t \x01(\tR\bposition\x12B\n" +
+	"\x0ecv_document_id\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fcvDocumentId\x12U\n" +
+	"\x18cover_letter_document_id\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x15coverLetterDocumentId\x12<\n" +
+	"\ffollow_up_on\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"followUpOn\x12;\n" +
+	"\n" +
+	"company_id\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\"_\n" +
 	"\x1cCreateJobApplicationResponse\x12?\n" +
-	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"\x1c\n" +
-	"\x1aListJobApplicationsRequest\"`\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"\xe8\x01\n" +
+	"\x1aListJobApplicationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x124\n" +
+	"\x06filter\x18\x03 \x01(\v2\x1c.api.v1.JobApplicationFilterR\x06filter\x128\n" +
+	"\bsort_key\x18\x04 \x01(\x0e2\x1d.api.v1.JobApplicationSortKeyR\asortKey\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\"\x88\x01\n" +
 	"\x1bListJobApplicationsResponse\x12A\n" +
-	"\x10job_applications\x18\x01 \x03(\v2\x16.api.v1.JobApplicationR\x0fjobApplications\"\xcd\x03\n" +
+	"\x10job_applications\x18\x01 \x03(\v2\x16.api.v1.JobApplicationR\x0fjobApplications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xe1\x03\n" +
+	"\x14JobApplicationFilter\x128\n" +
+	"\bstatuses\x18\x01 \x03(\x0e2\x1c.api.v1.JobApplicationStatusR\bstatuses\x12\x18\n" +
+	"\acompany\x18\x02 \x01(\tR\acompany\x12B\n" +
+	"\x0fapplied_on_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rappliedOnFrom\x12>\n" +
+	"\rapplied_on_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vappliedOnTo\x121\n" +
+	"\x06has_cv\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x05hasCv\x12D\n" +
+	"\x10has_cover_letter\x18\x06 \x01(\v2\x1a.google.protobuf.BoolValueR\x0ehasCoverLetter\x12$\n" +
+	"\x0ecv_document_id\x18\a \x01(\tR\fcvDocumentId\x127\n" +
+	"\x18cover_letter_document_id\x18\b \x01(\tR\x15coverLetterDocumentId\x12\x19\n" +
+	"\bcv_query\x18\t \x01(\tR\acvQuery\"\xba\x06\n" +
 	"\x1bUpdateJobApplicationRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acompany\x18\x02 \x01(\tR\acompany\x12\x14\n" +
@@ -828,12 +7099,22 @@ const file_api_v1_api_proto_rawDesc = "" +
 	"\n" +
 	"applied_on\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tappliedOn\x12\x1a\n" +
 	"\bposition\x18\n" +
-	" \x01(\tR\bposition\"_\n" +
+	" \x01(\tR\bposition\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x12;\n" +
+	"\vupdate_mask\x18\f \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12B\n" +
+	"\x0ecv_document_id\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\fcvDocumentId\x12U\n" +
+	"\x18cover_letter_document_id\x18\x0e \x01(\v2\x1c.google.protobuf.StringValueR\x15coverLetterDocumentId\x12<\n" +
+	"\ffollow_up_on\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"followUpOn\x12;\n" +
+	"\n" +
+	"company_id\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\"_\n" +
 	"\x1cUpdateJobApplicationResponse\x12?\n" +
-	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"-\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"G\n" +
 	"\x1bDeleteJobApplicationRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
-	"\x1cDeleteJobApplicationResponse\"\xb6\x04\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"\x1e\n" +
+	"\x1cDeleteJobApplicationResponse\"\xe6\b\n" +
 	"\x0eJobApplication\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
 	"\acompany\x18\x02 \x01(\tR\acompany\x12\x14\n" +
@@ -850,13 +7131,411 @@ const file_api_v1_api_proto_rawDesc = "" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
-	"\bposition\x18\f \x01(\tR\bposition\"\xa3\x01\n" +
+	"\bposition\x18\f \x01(\tR\bposition\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\x129\n" +
+	"\n" +
+	"deleted_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12B\n" +
+	"\x0ecv_document_id\x18\x0f \x01(\v2\x1c.google.protobuf.StringValueR\fcvDocumentId\x12U\n" +
+	"\x18cover_letter_document_id\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\x15coverLetterDocumentId\x12<\n" +
+	"\vmatch_score\x18\x11 \x01(\v2\x1b.google.protobuf.Int32ValueR\n" +
+	"matchScore\x12=\n" +
+	"\vexternal_id\x18\x12 \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"externalId\x12F\n" +
+	"\x11status_changed_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusChangedAt\x12<\n" +
+	"\ffollow_up_on\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"followUpOn\x12;\n" +
+	"\n" +
+	"company_id\x18\x15 \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\"\xf5\x01\n" +
 	"!UpdateJobApplicationStatusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\x06status\x128\n" +
-	"\bposition\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bposition\"e\n" +
+	"\bposition\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bposition\x12\x19\n" +
+	"\bafter_id\x18\x04 \x01(\tR\aafterId\x12\x1b\n" +
+	"\tbefore_id\x18\x05 \x01(\tR\bbeforeId\x12\x18\n" +
+	"\aversion\x18\x06 \x01(\x03R\aversion\"e\n" +
 	"\"UpdateJobApplicationStatusResponse\x12?\n" +
-	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication*\xc0\x02\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"1\n" +
+	"\x1fGetJobApplicationHistoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"W\n" +
+	" GetJobApplicationHistoryResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.api.v1.JobApplicationEventR\x06events\"\xa7\x01\n" +
+	"\x19JobApplicationFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x129\n" +
+	"\told_value\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\boldValue\x129\n" +
+	"\tnew_value\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\bnewValue\"\x99\x02\n" +
+	"\x13JobApplicationEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12job_application_id\x18\x02 \x01(\tR\x10jobApplicationId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x123\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1f.api.v1.JobApplicationEventTypeR\x04type\x12;\n" +
+	"\achanges\x18\x05 \x03(\v2!.api.v1.JobApplicationFieldChangeR\achanges\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"p\n" +
+	"\x1cSearchJobApplicationsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x85\x01\n" +
+	"\x1dSearchJobApplicationsResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".api.v1.JobApplicationSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xb2\x01\n" +
+	"\x1aJobApplicationSearchResult\x12?\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12?\n" +
+	"\bsnippets\x18\x03 \x03(\v2#.api.v1.JobApplicationSearchSnippetR\bsnippets\"G\n" +
+	"\x1bJobApplicationSearchSnippet\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"\x9e\x02\n" +
+	"\fUserSettings\x12:\n" +
+	"\x19strict_status_transitions\x18\x01 \x01(\bR\x17strictStatusTransitions\x12;\n" +
+	"\x0eghosting_rules\x18\x02 \x03(\v2\x14.api.v1.GhostingRuleR\rghostingRules\x123\n" +
+	"\x16auto_reject_after_days\x18\x03 \x01(\x05R\x13autoRejectAfterDays\x12!\n" +
+	"\femail_digest\x18\x04 \x01(\bR\vemailDigest\x12'\n" +
+	"\x0femail_reminders\x18\x05 \x01(\bR\x0eemailReminders\x12\x14\n" +
+	"\x05email\x18\x06 \x01(\tR\x05email\"c\n" +
+	"\fGhostingRule\x124\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"after_days\x18\x02 \x01(\x05R\tafterDays\"\x18\n" +
+	"\x16GetUserSettingsRequest\"K\n" +
+	"\x17GetUserSettingsResponse\x120\n" +
+	"\bsettings\x18\x01 \x01(\v2\x14.api.v1.UserSettingsR\bsettings\"\x8a\x01\n" +
+	"\x19UpdateUserSettingsRequest\x120\n" +
+	"\bsettings\x18\x01 \x01(\v2\x14.api.v1.UserSettingsR\bsettings\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"N\n" +
+	"\x1aUpdateUserSettingsResponse\x120\n" +
+	"\bsettings\x18\x01 \x01(\v2\x14.api.v1.UserSettingsR\bsettings\"_\n" +
+	"!ListDeletedJobApplicationsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x8f\x01\n" +
+	"\"ListDeletedJobApplicationsResponse\x12A\n" +
+	"\x10job_applications\x18\x01 \x03(\v2\x16.api.v1.JobApplicationR\x0fjobApplications\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x1cRestoreJobApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"`\n" +
+	"\x1dRestoreJobApplicationResponse\x12?\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"\x8d\x04\n" +
+	"\tInterview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x12job_application_id\x18\x02 \x01(\tR\x10jobApplicationId\x12\x14\n" +
+	"\x05round\x18\x03 \x01(\tR\x05round\x12=\n" +
+	"\fscheduled_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x1b\n" +
+	"\ttime_zone\x18\x05 \x01(\tR\btimeZone\x125\n" +
+	"\bduration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\"\n" +
+	"\finterviewers\x18\a \x03(\tR\finterviewers\x12/\n" +
+	"\x06format\x18\b \x01(\x0e2\x17.api.v1.InterviewFormatR\x06format\x12\x1a\n" +
+	"\blocation\x18\t \x01(\tR\blocation\x122\n" +
+	"\aoutcome\x18\n" +
+	" \x01(\x0e2\x18.api.v1.InterviewOutcomeR\aoutcome\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe0\x02\n" +
+	"\x16CreateInterviewRequest\x12,\n" +
+	"\x12job_application_id\x18\x01 \x01(\tR\x10jobApplicationId\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\tR\x05round\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\"\n" +
+	"\finterviewers\x18\x06 \x03(\tR\finterviewers\x12/\n" +
+	"\x06format\x18\a \x01(\x0e2\x17.api.v1.InterviewFormatR\x06format\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\"J\n" +
+	"\x17CreateInterviewResponse\x12/\n" +
+	"\tinterview\x18\x01 \x01(\v2\x11.api.v1.InterviewR\tinterview\"E\n" +
+	"\x15ListInterviewsRequest\x12,\n" +
+	"\x12job_application_id\x18\x01 \x01(\tR\x10jobApplicationId\"K\n" +
+	"\x16ListInterviewsResponse\x121\n" +
+	"\n" +
+	"interviews\x18\x01 \x03(\v2\x11.api.v1.InterviewR\n" +
+	"interviews\"\xf6\x02\n" +
+	"\x16UpdateInterviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05round\x18\x02 \x01(\tR\x05round\x12=\n" +
+	"\fscheduled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vscheduledAt\x12\x1b\n" +
+	"\ttime_zone\x18\x04 \x01(\tR\btimeZone\x125\n" +
+	"\bduration\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\"\n" +
+	"\finterviewers\x18\x06 \x03(\tR\finterviewers\x12/\n" +
+	"\x06format\x18\a \x01(\x0e2\x17.api.v1.InterviewFormatR\x06format\x12\x1a\n" +
+	"\blocation\x18\b \x01(\tR\blocation\x122\n" +
+	"\aoutcome\x18\t \x01(\x0e2\x18.api.v1.InterviewOutcomeR\aoutcome\"J\n" +
+	"\x17UpdateInterviewResponse\x12/\n" +
+	"\tinterview\x18\x01 \x01(\v2\x11.api.v1.InterviewR\tinterview\"(\n" +
+	"\x16DeleteInterviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x19\n" +
+	"\x17DeleteInterviewResponse\"[\n" +
+	"\x1dListUpcomingInterviewsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\"\x83\x01\n" +
+	"\x1eListUpcomingInterviewsResponse\x129\n" +
+	"\n" +
+	"interviews\x18\x01 \x03(\v2\x19.api.v1.UpcomingInterviewR\n" +
+	"interviews\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x85\x01\n" +
+	"\x11UpcomingInterview\x12/\n" +
+	"\tinterview\x18\x01 \x01(\v2\x11.api.v1.InterviewR\tinterview\x12?\n" +
+	"\x0fjob_application\x18\x02 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\"5\n" +
+	"\x1bWatchJobApplicationsRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\"\xc8\x01\n" +
+	"\x1cWatchJobApplicationsResponse\x121\n" +
+	"\x05event\x18\x01 \x01(\v2\x1b.api.v1.JobApplicationEventR\x05event\x12?\n" +
+	"\x0fjob_application\x18\x02 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\x12\x1c\n" +
+	"\theartbeat\x18\x04 \x01(\bR\theartbeat\"\x83\x02\n" +
+	"\bDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x14.api.v1.DocumentKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x03R\x04size\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05label\x18\a \x01(\tR\x05label\x12!\n" +
+	"\ftext_preview\x18\b \x01(\tR\vtextPreview\"l\n" +
+	"\x0fDocumentSummary\x12,\n" +
+	"\bdocument\x18\x01 \x01(\v2\x10.api.v1.DocumentR\bdocument\x12+\n" +
+	"\x11application_count\x18\x02 \x01(\x05R\x10applicationCount\"\xa8\x01\n" +
+	"\x15UploadDocumentRequest\x12(\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x14.api.v1.DocumentKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\x12\x14\n" +
+	"\x05label\x18\x05 \x01(\tR\x05label\"F\n" +
+	"\x16UploadDocumentResponse\x12,\n" +
+	"\bdocument\x18\x01 \x01(\v2\x10.api.v1.DocumentR\bdocument\"$\n" +
+	"\x12GetDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x13GetDocumentResponse\x12,\n" +
+	"\bdocument\x18\x01 \x01(\v2\x10.api.v1.DocumentR\bdocument\")\n" +
+	"\x17DownloadDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"b\n" +
+	"\x18DownloadDocumentResponse\x12,\n" +
+	"\bdocument\x18\x01 \x01(\v2\x10.api.v1.DocumentR\bdocument\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"'\n" +
+	"\x15DeleteDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
+	"\x16DeleteDocumentResponse\"{\n" +
+	"\x15UpdateDocumentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12(\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x14.api.v1.DocumentKindR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05label\x18\x04 \x01(\tR\x05label\"F\n" +
+	"\x16UpdateDocumentResponse\x12,\n" +
+	"\bdocument\x18\x01 \x01(\v2\x10.api.v1.DocumentR\bdocument\"|\n" +
+	"\x14ListDocumentsRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x12(\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x14.api.v1.DocumentKindR\x04kind\"v\n" +
+	"\x15ListDocumentsResponse\x125\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x17.api.v1.DocumentSummaryR\tdocuments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x94\x01\n" +
+	"\x16SearchDocumentsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12(\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x14.api.v1.DocumentKindR\x04kind\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\x9f\x01\n" +
+	"\x14DocumentSearchResult\x12,\n" +
+	"\bdocument\x18\x01 \x01(\v2\x10.api.v1.DocumentR\bdocument\x12+\n" +
+	"\x11application_count\x18\x02 \x01(\x05R\x10applicationCount\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\x01R\x04rank\x12\x18\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\"y\n" +
+	"\x17SearchDocumentsResponse\x126\n" +
+	"\aresults\x18\x01 \x03(\v2\x1c.api.v1.DocumentSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\",\n" +
+	"\x1aScoreJobApplicationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xeb\x01\n" +
+	"\x1bScoreJobApplicationResponse\x12?\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\x12%\n" +
+	"\x0ematched_skills\x18\x03 \x03(\tR\rmatchedSkills\x12%\n" +
+	"\x0emissing_skills\x18\x04 \x03(\tR\rmissingSkills\x12'\n" +
+	"\x0fkeyword_overlap\x18\x05 \x01(\x01R\x0ekeywordOverlap\"C\n" +
+	"\x13ImportColumnMapping\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06column\x18\x02 \x01(\tR\x06column\"\xd3\x01\n" +
+	"\x1cImportJobApplicationsRequest\x12,\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x14.api.v1.ImportFormatR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\x125\n" +
+	"\amapping\x18\x03 \x03(\v2\x1b.api.v1.ImportColumnMappingR\amapping\x12\x1b\n" +
+	"\tday_first\x18\x04 \x01(\bR\bdayFirst\x12\x17\n" +
+	"\adry_run\x18\x05 \x01(\bR\x06dryRun\"\xd2\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1f\n" +
+	"\vexternal_id\x18\x02 \x01(\tR\n" +
+	"externalId\x122\n" +
+	"\aoutcome\x18\x03 \x01(\x0e2\x18.api.v1.ImportRowOutcomeR\aoutcome\x12\x14\n" +
+	"\x05field\x18\x04 \x01(\tR\x05field\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12,\n" +
+	"\x12job_application_id\x18\x06 \x01(\tR\x10jobApplicationId\"\xdf\x01\n" +
+	"\x1dImportJobApplicationsResponse\x121\n" +
+	"\aresults\x18\x01 \x03(\v2\x17.api.v1.ImportRowResultR\aresults\x12#\n" +
+	"\rcreated_count\x18\x02 \x01(\x05R\fcreatedCount\x12#\n" +
+	"\rskipped_count\x18\x03 \x01(\x05R\fskippedCount\x12#\n" +
+	"\rinvalid_count\x18\x04 \x01(\x05R\finvalidCount\x12\x1c\n" +
+	"\tcommitted\x18\x05 \x01(\bR\tcommitted\"L\n" +
+	"\x1cExportJobApplicationsRequest\x12,\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x14.api.v1.ExportFormatR\x06format\"s\n" +
+	"\x1dExportJobApplicationsResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\"\x9d\x01\n" +
+	"\x17GetPipelineStatsRequest\x12B\n" +
+	"\x0fapplied_on_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rappliedOnFrom\x12>\n" +
+	"\rapplied_on_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vappliedOnTo\"Y\n" +
+	"\vStatusCount\x124\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\x06status\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xd9\x01\n" +
+	"\x0fStageConversion\x12=\n" +
+	"\vfrom_status\x18\x01 \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\n" +
+	"fromStatus\x129\n" +
+	"\tto_status\x18\x02 \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\btoStatus\x12\x1d\n" +
+	"\n" +
+	"from_count\x18\x03 \x01(\x05R\tfromCount\x12\x19\n" +
+	"\bto_count\x18\x04 \x01(\x05R\atoCount\x12\x12\n" +
+	"\x04rate\x18\x05 \x01(\x01R\x04rate\"|\n" +
+	"\rStageDuration\x124\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1c.api.v1.JobApplicationStatusR\x06status\x12\x1f\n" +
+	"\vmedian_days\x18\x02 \x01(\x01R\n" +
+	"medianDays\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xb1\x01\n" +
+	"\x12WeeklyResponseRate\x129\n" +
+	"\n" +
+	"week_start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tweekStart\x12#\n" +
+	"\rapplied_count\x18\x02 \x01(\x05R\fappliedCount\x12'\n" +
+	"\x0fresponded_count\x18\x03 \x01(\x05R\x0erespondedCount\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x01R\x04rate\"\x9a\x02\n" +
+	"\x18GetPipelineStatsResponse\x128\n" +
+	"\rstatus_counts\x18\x01 \x03(\v2\x13.api.v1.StatusCountR\fstatusCounts\x129\n" +
+	"\vconversions\x18\x02 \x03(\v2\x17.api.v1.StageConversionR\vconversions\x129\n" +
+	"\rtime_in_stage\x18\x03 \x03(\v2\x15.api.v1.StageDurationR\vtimeInStage\x12N\n" +
+	"\x15weekly_response_rates\x18\x04 \x03(\v2\x1a.api.v1.WeeklyResponseRateR\x13weeklyResponseRates\"\x19\n" +
+	"\x17ListDueFollowUpsRequest\"\xb1\x01\n" +
+	"\vDueFollowUp\x12?\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\x12.\n" +
+	"\x06reason\x18\x02 \x01(\x0e2\x16.api.v1.FollowUpReasonR\x06reason\x121\n" +
+	"\x06due_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\"N\n" +
+	"\x18ListDueFollowUpsResponse\x122\n" +
+	"\n" +
+	"follow_ups\x18\x01 \x03(\v2\x13.api.v1.DueFollowUpR\tfollowUps\"*\n" +
+	"\x12UnsubscribeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x15\n" +
+	"\x13UnsubscribeResponse\"\xac\x03\n" +
+	"\aCompany\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\awebsite\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\awebsite\x128\n" +
+	"\blocation\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12'\n" +
+	"\x04size\x18\x05 \x01(\x0e2\x13.api.v1.CompanySizeR\x04size\x128\n" +
+	"\bindustry\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\bindustry\x122\n" +
+	"\x05notes\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb3\x02\n" +
+	"\x14CreateCompanyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x126\n" +
+	"\awebsite\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\awebsite\x128\n" +
+	"\blocation\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12'\n" +
+	"\x04size\x18\x04 \x01(\x0e2\x13.api.v1.CompanySizeR\x04size\x128\n" +
+	"\bindustry\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\bindustry\x122\n" +
+	"\x05notes\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\"B\n" +
+	"\x15CreateCompanyResponse\x12)\n" +
+	"\acompany\x18\x01 \x01(\v2\x0f.api.v1.CompanyR\acompany\"\xc3\x02\n" +
+	"\x14UpdateCompanyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\awebsite\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\awebsite\x128\n" +
+	"\blocation\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\blocation\x12'\n" +
+	"\x04size\x18\x05 \x01(\x0e2\x13.api.v1.CompanySizeR\x04size\x128\n" +
+	"\bindustry\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\bindustry\x122\n" +
+	"\x05notes\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x05notes\"B\n" +
+	"\x15UpdateCompanyResponse\x12)\n" +
+	"\acompany\x18\x01 \x01(\v2\x0f.api.v1.CompanyR\acompany\"\x16\n" +
+	"\x14ListCompaniesRequest\"\xe6\x01\n" +
+	"\x0eCompanySummary\x12)\n" +
+	"\acompany\x18\x01 \x01(\v2\x0f.api.v1.CompanyR\acompany\x12+\n" +
+	"\x11application_count\x18\x02 \x01(\x05R\x10applicationCount\x128\n" +
+	"\rstatus_counts\x18\x03 \x03(\v2\x13.api.v1.StatusCountR\fstatusCounts\x12B\n" +
+	"\x0flast_applied_on\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rlastAppliedOn\"M\n" +
+	"\x15ListCompaniesResponse\x124\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x16.api.v1.CompanySummaryR\tcompanies\"\xbb\x03\n" +
+	"\aContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\x04role\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04role\x122\n" +
+	"\x05email\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05email\x122\n" +
+	"\x05phone\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x12?\n" +
+	"\flinkedin_url\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\vlinkedinUrl\x12;\n" +
+	"\n" +
+	"company_id\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc2\x02\n" +
+	"\x14CreateContactRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x120\n" +
+	"\x04role\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04role\x122\n" +
+	"\x05email\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x05email\x122\n" +
+	"\x05phone\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x12?\n" +
+	"\flinkedin_url\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\vlinkedinUrl\x12;\n" +
+	"\n" +
+	"company_id\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\"B\n" +
+	"\x15CreateContactResponse\x12)\n" +
+	"\acontact\x18\x01 \x01(\v2\x0f.api.v1.ContactR\acontact\"#\n" +
+	"\x11GetContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x12GetContactResponse\x12)\n" +
+	"\acontact\x18\x01 \x01(\v2\x0f.api.v1.ContactR\acontact\"\xd2\x02\n" +
+	"\x14UpdateContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x120\n" +
+	"\x04role\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04role\x122\n" +
+	"\x05email\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x05email\x122\n" +
+	"\x05phone\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x12?\n" +
+	"\flinkedin_url\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\vlinkedinUrl\x12;\n" +
+	"\n" +
+	"company_id\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\"B\n" +
+	"\x15UpdateContactResponse\x12)\n" +
+	"\acontact\x18\x01 \x01(\v2\x0f.api.v1.ContactR\acontact\"&\n" +
+	"\x14DeleteContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15DeleteContactResponse\"\x9e\x01\n" +
+	"\x13ListContactsRequest\x12;\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\tcompanyId\x12J\n" +
+	"\x12job_application_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x10jobApplicationId\"C\n" +
+	"\x14ListContactsResponse\x12+\n" +
+	"\bcontacts\x18\x01 \x03(\v2\x0f.api.v1.ContactR\bcontacts\"a\n" +
+	"\x12LinkContactRequest\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\tR\tcontactId\x12,\n" +
+	"\x12job_application_id\x18\x02 \x01(\tR\x10jobApplicationId\"\x15\n" +
+	"\x13LinkContactResponse\"c\n" +
+	"\x14UnlinkContactRequest\x12\x1d\n" +
+	"\n" +
+	"contact_id\x18\x01 \x01(\tR\tcontactId\x12,\n" +
+	"\x12job_application_id\x18\x02 \x01(\tR\x10jobApplicationId\"\x17\n" +
+	"\x15UnlinkContactResponse\"+\n" +
+	"\x19GetContactTimelineRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x01\n" +
+	"\x14ContactTimelineEntry\x12?\n" +
+	"\x0fjob_application\x18\x01 \x01(\v2\x16.api.v1.JobApplicationR\x0ejobApplication\x127\n" +
+	"\tlinked_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\blinkedAt\x123\n" +
+	"\x06events\x18\x03 \x03(\v2\x1b.api.v1.JobApplicationEventR\x06events\"T\n" +
+	"\x1aGetContactTimelineResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.api.v1.ContactTimelineEntryR\aentries*\x8c\x02\n" +
+	"\x15JobApplicationSortKey\x12(\n" +
+	"$JOB_APPLICATION_SORT_KEY_UNSPECIFIED\x10\x00\x12'\n" +
+	"#JOB_APPLICATION_SORT_KEY_APPLIED_ON\x10\x01\x12'\n" +
+	"#JOB_APPLICATION_SORT_KEY_CREATED_AT\x10\x02\x12'\n" +
+	"#JOB_APPLICATION_SORT_KEY_UPDATED_AT\x10\x03\x12$\n" +
+	" JOB_APPLICATION_SORT_KEY_COMPANY\x10\x04\x12(\n" +
+	"$JOB_APPLICATION_SORT_KEY_MATCH_SCORE\x10\x05*\xc0\x02\n" +
 	"\x14JobApplicationStatus\x12&\n" +
 	"\"JOB_APPLICATION_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
 	"\x1eJOB_APPLICATION_STATUS_APPLIED\x10\x01\x12$\n" +
@@ -865,13 +7544,98 @@ const file_api_v1_api_proto_rawDesc = "" +
 	"\x1cJOB_APPLICATION_STATUS_OFFER\x10\x04\x12#\n" +
 	"\x1fJOB_APPLICATION_STATUS_REJECTED\x10\x05\x12$\n" +
 	" JOB_APPLICATION_STATUS_WITHDRAWN\x10\x06\x12#\n" +
-	"\x1fJOB_APPLICATION_STATUS_ACCEPTED\x10\a2\x87\x04\n" +
+	"\x1fJOB_APPLICATION_STATUS_ACCEPTED\x10\a*\x95\x02\n" +
+	"\x17JobApplicationEventType\x12*\n" +
+	"&JOB_APPLICATION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12&\n" +
+	"\"JOB_APPLICATION_EVENT_TYPE_CREATED\x10\x01\x12&\n" +
+	"\"JOB_APPLICATION_EVENT_TYPE_UPDATED\x10\x02\x12-\n" +
+	")JOB_APPLICATION_EVENT_TYPE_STATUS_CHANGED\x10\x03\x12&\n" +
+	"\"JOB_APPLICATION_EVENT_TYPE_DELETED\x10\x04\x12'\n" +
+	"#JOB_APPLICATION_EVENT_TYPE_RESTORED\x10\x05*\x88\x01\n" +
+	"\x0fInterviewFormat\x12 \n" +
+	"\x1cINTERVIEW_FORMAT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16INTERVIEW_FORMAT_PHONE\x10\x01\x12\x1a\n" +
+	"\x16INTERVIEW_FORMAT_VIDEO\x10\x02\x12\x1b\n" +
+	"\x17INTERVIEW_FORMAT_ONSITE\x10\x03*\xb1\x01\n" +
+	"\x10InterviewOutcome\x12!\n" +
+	"\x1dINTERVIEW_OUTCOME_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19INTERVIEW_OUTCOME_PENDING\x10\x01\x12\x1c\n" +
+	"\x18INTERVIEW_OUTCOME_PASSED\x10\x02\x12\x1c\n" +
+	"\x18INTERVIEW_OUTCOME_FAILED\x10\x03\x12\x1f\n" +
+	"\x1bINTERVIEW_OUTCOME_CANCELLED\x10\x04*c\n" +
+	"\fDocumentKind\x12\x1d\n" +
+	"\x19DOCUMENT_KIND_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10DOCUMENT_KIND_CV\x10\x01\x12\x1e\n" +
+	"\x1aDOCUMENT_KIND_COVER_LETTER\x10\x02*\\\n" +
+	"\fImportFormat\x12\x1d\n" +
+	"\x19IMPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11IMPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12IMPORT_FORMAT_JSON\x10\x02*\x96\x01\n" +
+	"\x10ImportRowOutcome\x12\"\n" +
+	"\x1eIMPORT_ROW_OUTCOME_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aIMPORT_ROW_OUTCOME_CREATED\x10\x01\x12\x1e\n" +
+	"\x1aIMPORT_ROW_OUTCOME_SKIPPED\x10\x02\x12\x1e\n" +
+	"\x1aIMPORT_ROW_OUTCOME_INVALID\x10\x03*u\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x18\n" +
+	"\x14EXPORT_FORMAT_NDJSON\x10\x02\x12\x15\n" +
+	"\x11EXPORT_FORMAT_ICS\x10\x03*p\n" +
+	"\x0eFollowUpReason\x12 \n" +
+	"\x1cFOLLOW_UP_REASON_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aFOLLOW_UP_REASON_SCHEDULED\x10\x01\x12\x1c\n" +
+	"\x18FOLLOW_UP_REASON_GHOSTED\x10\x02*\xc6\x01\n" +
+	"\vCompanySize\x12\x1c\n" +
+	"\x18COMPANY_SIZE_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11COMPANY_SIZE_1_10\x10\x01\x12\x16\n" +
+	"\x12COMPANY_SIZE_11_50\x10\x02\x12\x17\n" +
+	"\x13COMPANY_SIZE_51_200\x10\x03\x12\x19\n" +
+	"\x15COMPANY_SIZE_201_1000\x10\x04\x12\x1a\n" +
+	"\x16COMPANY_SIZE_1001_5000\x10\x05\x12\x1a\n" +
+	"\x16COMPANY_SIZE_5001_PLUS\x10\x062\xb2\x1c\n" +
 	"\aService\x12a\n" +
 	"\x14CreateJobApplication\x12#.api.v1.CreateJobApplicationRequest\x1a$.api.v1.CreateJobApplicationResponse\x12^\n" +
 	"\x13ListJobApplications\x12\".api.v1.ListJobApplicationsRequest\x1a#.api.v1.ListJobApplicationsResponse\x12a\n" +
 	"\x14UpdateJobApplication\x12#.api.v1.UpdateJobApplicationRequest\x1a$.api.v1.UpdateJobApplicationResponse\x12a\n" +
 	"\x14DeleteJobApplication\x12#.api.v1.DeleteJobApplicationRequest\x1a$.api.v1.DeleteJobApplicationResponse\x12s\n" +
-	"\x1aUpdateJobApplicationStatus\x12).api.v1.UpdateJobApplicationStatusRequest\x1a*.api.v1.UpdateJobApplicationStatusResponseB\x13Z\x11kiseki/api/v1;apib\x06proto3"
+	"\x1aUpdateJobApplicationStatus\x12).api.v1.UpdateJobApplicationStatusRequest\x1a*.api.v1.UpdateJobApplicationStatusResponse\x12m\n" +
+	"\x18GetJobApplicationHistory\x12'.api.v1.GetJobApplicationHistoryRequest\x1a(.api.v1.GetJobApplicationHistoryResponse\x12d\n" +
+	"\x15SearchJobApplications\x12$.api.v1.SearchJobApplicationsRequest\x1a%.api.v1.SearchJobApplicationsResponse\x12R\n" +
+	"\x0fGetUserSettings\x12\x1e.api.v1.GetUserSettingsRequest\x1a\x1f.api.v1.GetUserSettingsResponse\x12[\n" +
+	"\x12UpdateUserSettings\x12!.api.v1.UpdateUserSettingsRequest\x1a\".api.v1.UpdateUserSettingsResponse\x12s\n" +
+	"\x1aListDeletedJobApplications\x12).api.v1.ListDeletedJobApplicationsRequest\x1a*.api.v1.ListDeletedJobApplicationsResponse\x12d\n" +
+	"\x15RestoreJobApplication\x12$.api.v1.RestoreJobApplicationRequest\x1a%.api.v1.RestoreJobApplicationResponse\x12R\n" +
+	"\x0fCreateInterview\x12\x1e.api.v1.CreateInterviewRequest\x1a\x1f.api.v1.CreateInterviewResponse\x12O\n" +
+	"\x0eListInterviews\x12\x1d.api.v1.ListInterviewsRequest\x1a\x1e.api.v1.ListInterviewsResponse\x12R\n" +
+	"\x0fUpdateInterview\x12\x1e.api.v1.UpdateInterviewRequest\x1a\x1f.api.v1.UpdateInterviewResponse\x12R\n" +
+	"\x0fDeleteInterview\x12\x1e.api.v1.DeleteInterviewRequest\x1a\x1f.api.v1.DeleteInterviewResponse\x12g\n" +
+	"\x16ListUpcomingInterviews\x12%.api.v1.ListUpcomingInterviewsRequest\x1a&.api.v1.ListUpcomingInterviewsResponse\x12c\n" +
+	"\x14WatchJobApplications\x12#.api.v1.WatchJobApplicationsRequest\x1a$.api.v1.WatchJobApplicationsResponse0\x01\x12O\n" +
+	"\x0eUploadDocument\x12\x1d.api.v1.UploadDocumentRequest\x1a\x1e.api.v1.UploadDocumentResponse\x12F\n" +
+	"\vGetDocument\x12\x1a.api.v1.GetDocumentRequest\x1a\x1b.api.v1.GetDocumentResponse\x12U\n" +
+	"\x10DownloadDocument\x12\x1f.api.v1.DownloadDocumentRequest\x1a .api.v1.DownloadDocumentResponse\x12O\n" +
+	"\x0eDeleteDocument\x12\x1d.api.v1.DeleteDocumentRequest\x1a\x1e.api.v1.DeleteDocumentResponse\x12O\n" +
+	"\x0eUpdateDocument\x12\x1d.api.v1.UpdateDocumentRequest\x1a\x1e.api.v1.UpdateDocumentResponse\x12L\n" +
+	"\rListDocuments\x12\x1c.api.v1.ListDocumentsRequest\x1a\x1d.api.v1.ListDocumentsResponse\x12R\n" +
+	"\x0fSearchDocuments\x12\x1e.api.v1.SearchDocumentsRequest\x1a\x1f.api.v1.SearchDocumentsResponse\x12^\n" +
+	"\x13ScoreJobApplication\x12\".api.v1.ScoreJobApplicationRequest\x1a#.api.v1.ScoreJobApplicationResponse\x12d\n" +
+	"\x15ImportJobApplications\x12$.api.v1.ImportJobApplicationsRequest\x1a%.api.v1.ImportJobApplicationsResponse\x12f\n" +
+	"\x15ExportJobApplications\x12$.api.v1.ExportJobApplicationsRequest\x1a%.api.v1.ExportJobApplicationsResponse0\x01\x12U\n" +
+	"\x10GetPipelineStats\x12\x1f.api.v1.GetPipelineStatsRequest\x1a .api.v1.GetPipelineStatsResponse\x12U\n" +
+	"\x10ListDueFollowUps\x12\x1f.api.v1.ListDueFollowUpsRequest\x1a .api.v1.ListDueFollowUpsResponse\x12F\n" +
+	"\vUnsubscribe\x12\x1a.api.v1.UnsubscribeRequest\x1a\x1b.api.v1.UnsubscribeResponse\x12L\n" +
+	"\rCreateCompany\x12\x1c.api.v1.CreateCompanyRequest\x1a\x1d.api.v1.CreateCompanyResponse\x12L\n" +
+	"\rUpdateCompany\x12\x1c.api.v1.UpdateCompanyRequest\x1a\x1d.api.v1.UpdateCompanyResponse\x12L\n" +
+	"\rListCompanies\x12\x1c.api.v1.ListCompaniesRequest\x1a\x1d.api.v1.ListCompaniesResponse\x12L\n" +
+	"\rCreateContact\x12\x1c.api.v1.CreateContactRequest\x1a\x1d.api.v1.CreateContactResponse\x12C\n" +
+	"\n" +
+	"GetContact\x12\x19.api.v1.GetContactRequest\x1a\x1a.api.v1.GetContactResponse\x12L\n" +
+	"\rUpdateContact\x12\x1c.api.v1.UpdateContactRequest\x1a\x1d.api.v1.UpdateContactResponse\x12L\n" +
+	"\rDeleteContact\x12\x1c.api.v1.DeleteContactRequest\x1a\x1d.api.v1.DeleteContactResponse\x12I\n" +
+	"\fListContacts\x12\x1b.api.v1.ListContactsRequest\x1a\x1c.api.v1.ListContactsResponse\x12F\n" +
+	"\vLinkContact\x12\x1a.api.v1.LinkContactRequest\x1a\x1b.api.v1.LinkContactResponse\x12L\n" +
+	"\rUnlinkContact\x12\x1c.api.v1.UnlinkContactRequest\x1a\x1d.api.v1.UnlinkContactResponse\x12[\n" +
+	"\x12GetContactTimeline\x12!.api.v1.GetContactTimelineRequest\x1a\".api.v1.GetContactTimelineResponseB\x13Z\x11kiseki/api/v1;apib\x06proto3"
 
 var (
 	file_api_v1_api_proto_rawDescOnce sync.Once
@@ -885,66 +7649,394 @@ func file_api_v1_api_proto_rawDescGZIP() []byte {
 	return file_api_v1_api_proto_rawDescData
 }
 
-var file_api_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_v1_api_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_api_v1_api_proto_msgTypes = make([]protoimpl.MessageInfo, 106)
 var file_api_v1_api_proto_goTypes = []any{
-	(JobApplicationStatus)(0),                  // 0: api.v1.JobApplicationStatus
-	(*CreateJobApplicationRequest)(nil),        // 1: api.v1.CreateJobApplicationRequest
-	(*CreateJobApplicationResponse)(nil),       // 2: api.v1.CreateJobApplicationResponse
-	(*ListJobApplicationsRequest)(nil),         // 3: api.v1.ListJobApplicationsRequest
-	(*ListJobApplicationsResponse)(nil),        // 4: api.v1.ListJobApplicationsResponse
-	(*UpdateJobApplicationRequest)(nil),        // 5: api.v1.UpdateJobApplicationRequest
-	(*UpdateJobApplicationResponse)(nil),       // 6: api.v1.UpdateJobApplicationResponse
-	(*DeleteJobApplicationRequest)(nil),        // 7: api.v1.DeleteJobApplicationRequest
-	(*DeleteJobApplicationResponse)(nil),       // 8: api.v1.DeleteJobApplicationResponse
-	(*JobApplication)(nil),                     // 9: api.v1.JobApplication
-	(*UpdateJobApplicationStatusRequest)(nil),  // 10: api.v1.UpdateJobApplicationStatusRequest
-	(*UpdateJobApplicationStatusResponse)(nil), // 11: api.v1.UpdateJobApplicationStatusResponse
-	(*wrapperspb.StringValue)(nil),             // 12: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),              // 13: google.protobuf.Timestamp
+	(JobApplicationSortKey)(0),                 // 0: api.v1.JobApplicationSortKey
+	(JobApplicationStatus)(0),                  // 1: api.v1.JobApplicationStatus
+	(JobApplicationEventType)(0),               // 2: api.v1.JobApplicationEventType
+	(InterviewFormat)(0),                       // 3: api.v1.InterviewFormat
+	(InterviewOutcome)(0),                      // 4: api.v1.InterviewOutcome
+	(DocumentKind)(0),                          // 5: api.v1.DocumentKind
+	(ImportFormat)(0),                          // 6: api.v1.ImportFormat
+	(ImportRowOutcome)(0),                      // 7: api.v1.ImportRowOutcome
+	(ExportFormat)(0),                          // 8: api.v1.ExportFormat
+	(FollowUpReason)(0),                        // 9: api.v1.FollowUpReason
+	(CompanySize)(0),                           // 10: api.v1.CompanySize
+	(*CreateJobApplicationRequest)(nil),        // 11: api.v1.CreateJobApplicationRequest
+	(*CreateJobApplicationResponse)(nil),       // 12: api.v1.CreateJobApplicationResponse
+	(*ListJobApplicationsRequest)(nil),         // 13: api.v1.ListJobApplicationsRequest
+	(*ListJobApplicationsResponse)(nil),        // 14: api.v1.ListJobApplicationsResponse
+	(*JobApplicationFilter)(nil),               // 15: api.v1.JobApplicationFilter
+	(*UpdateJobApplicationRequest)(nil),        // 16: api.v1.UpdateJobApplicationRequest
+	(*UpdateJobApplicationResponse)(nil),       // 17: api.v1.UpdateJobApplicationResponse
+	(*DeleteJobApplicationRequest)(nil),        // 18: api.v1.DeleteJobApplicationRequest
+	(*DeleteJobApplicationResponse)(nil),       // 19: api.v1.DeleteJobApplicationResponse
+	(*JobApplication)(nil),                     // 20: api.v1.JobApplication
+	(*UpdateJobApplicationStatusRequest)(nil),  // 21: api.v1.UpdateJobApplicationStatusRequest
+	(*UpdateJobApplicationStatusResponse)(nil), // 22: api.v1.UpdateJobApplicationStatusResponse
+	(*GetJobApplicationHistoryRequest)(nil),    // 23: api.v1.GetJobApplicationHistoryRequest
+	(*GetJobApplicationHistoryResponse)(nil),   // 24: api.v1.GetJobApplicationHistoryResponse
+	(*JobApplicationFieldChange)(nil),          // 25: api.v1.JobApplicationFieldChange
+	(*JobApplicationEvent)(nil),                // 26: api.v1.JobApplicationEvent
+	(*SearchJobApplicationsRequest)(nil),       // 27: api.v1.SearchJobApplicationsRequest
+	(*SearchJobApplicationsResponse)(nil),      // 28: api.v1.SearchJobApplicationsResponse
+	(*JobApplicationSearchResult)(nil),         // 29: api.v1.JobApplicationSearchResult
+	(*JobApplicationSearchSnippet)(nil),        // 30: api.v1.JobApplicationSearchSnippet
+	(*UserSettings)(nil),                       // 31: api.v1.UserSettings
+	(*GhostingRule)(nil),                       // 32: api.v1.GhostingRule
+	(*GetUserSettingsRequest)(nil),             // 33: api.v1.GetUserSettingsRequest
+	(*GetUserSettingsResponse)(nil),            // 34: api.v1.GetUserSettingsResponse
+	(*UpdateUserSettingsRequest)(nil),          // 35: api.v1.UpdateUserSettingsRequest
+	(*UpdateUserSettingsResponse)(nil),         // 36: api.v1.UpdateUserSettingsResponse
+	(*ListDeletedJobApplicationsRequest)(nil),  // 37: api.v1.ListDeletedJobApplicationsRequest
+	(*ListDeletedJobApplicationsResponse)(nil), // 38: api.v1.ListDeletedJobApplicationsResponse
+	(*RestoreJobApplicationRequest)(nil),       // 39: api.v1.RestoreJobApplicationRequest
+	(*RestoreJobApplicationResponse)(nil),      // 40: api.v1.RestoreJobApplicationResponse
+	(*Interview)(nil),                          // 41: api.v1.Interview
+	(*CreateInterviewRequest)(nil),             // 42: api.v1.CreateInterviewRequest
+	(*CreateInterviewResponse)(nil),            // 43: api.v1.CreateInterviewResponse
+	(*ListInterviewsRequest)(nil),              // 44: api.v1.ListInterviewsRequest
+	(*ListInterviewsResponse)(nil),             // 45: api.v1.ListInterviewsResponse
+	(*UpdateInterviewRequest)(nil),             // 46: api.v1.UpdateInterviewRequest
+	(*UpdateInterviewResponse)(nil),            // 47: api.v1.UpdateInterviewResponse
+	(*DeleteInterviewRequest)(nil),             // 48: api.v1.DeleteInterviewRequest
+	(*DeleteInterviewResponse)(nil),            // 49: api.v1.DeleteInterviewResponse
+	(*ListUpcomingInterviewsRequest)(nil),      // 50: api.v1.ListUpcomingInterviewsRequest
+	(*ListUpcomingInterviewsResponse)(nil),     // 51: api.v1.ListUpcomingInterviewsResponse
+	(*UpcomingInterview)(nil),                  // 52: api.v1.UpcomingInterview
+	(*WatchJobApplicationsRequest)(nil),        // 53: api.v1.WatchJobApplicationsRequest
+	(*WatchJobApplicationsResponse)(nil),       // 54: api.v1.WatchJobApplicationsResponse
+	(*Document)(nil),                           // 55: api.v1.Document
+	(*DocumentSummary)(nil),                    // 56: api.v1.DocumentSummary
+	(*UploadDocumentRequest)(nil),              // 57: api.v1.UploadDocumentRequest
+	(*UploadDocumentResponse)(nil),             // 58: api.v1.UploadDocumentResponse
+	(*GetDocumentRequest)(nil),                 // 59: api.v1.GetDocumentRequest
+	(*GetDocumentResponse)(nil),                // 60: api.v1.GetDocumentResponse
+	(*DownloadDocumentRequest)(nil),            // 61: api.v1.DownloadDocumentRequest
+	(*DownloadDocumentResponse)(nil),           // 62: api.v1.DownloadDocumentResponse
+	(*DeleteDocumentRequest)(nil),              // 63: api.v1.DeleteDocumentRequest
+	(*DeleteDocumentResponse)(nil),             // 64: api.v1.DeleteDocumentResponse
+	(*UpdateDocumentRequest)(nil),              // 65: api.v1.UpdateDocumentRequest
+	(*UpdateDocumentResponse)(nil),             // 66: api.v1.UpdateDocumentResponse
+	(*ListDocumentsRequest)(nil),               // 67: api.v1.ListDocumentsRequest
+	(*ListDocumentsResponse)(nil),              // 68: api.v1.ListDocumentsResponse
+	(*SearchDocumentsRequest)(nil),             // 69: api.v1.SearchDocumentsRequest
+	(*DocumentSearchResult)(nil),               // 70: api.v1.DocumentSearchResult
+	(*SearchDocumentsResponse)(nil),            // 71: api.v1.SearchDocumentsResponse
+	(*ScoreJobApplicationRequest)(nil),         // 72: api.v1.ScoreJobApplicationRequest
+	(*ScoreJobApplicationResponse)(nil),        // 73: api.v1.ScoreJobApplicationResponse
+	(*ImportColumnMapping)(nil),                // 74: api.v1.ImportColumnMapping
+	(*ImportJobApplicationsRequest)(nil),       // 75: api.v1.ImportJobApplicationsRequest
+	(*ImportRowResult)(nil),                    // 76: api.v1.ImportRowResult
+	(*ImportJobApplicationsResponse)(nil),      // 77: api.v1.ImportJobApplicationsResponse
+	(*ExportJobApplicationsRequest)(nil),       // 78: api.v1.ExportJobApplicationsRequest
+	(*ExportJobApplicationsResponse)(nil),      // 79: api.v1.ExportJobApplicationsResponse
+	(*GetPipelineStatsRequest)(nil),            // 80: api.v1.GetPipelineStatsRequest
+	(*StatusCount)(nil),                        // 81: api.v1.StatusCount
+	(*StageConversion)(nil),                    // 82: api.v1.StageConversion
+	(*StageDuration)(nil),                      // 83: api.v1.StageDuration
+	(*WeeklyResponseRate)(nil),                 // 84: api.v1.WeeklyResponseRate
+	(*GetPipelineStatsResponse)(nil),           // 85: api.v1.GetPipelineStatsResponse
+	(*ListDueFollowUpsRequest)(nil),            // 86: api.v1.ListDueFollowUpsRequest
+	(*DueFollowUp)(nil),                        // 87: api.v1.DueFollowUp
+	(*ListDueFollowUpsResponse)(nil),           // 88: api.v1.ListDueFollowUpsResponse
+	(*UnsubscribeRequest)(nil),                 // 89: api.v1.UnsubscribeRequest
+	(*UnsubscribeResponse)(nil),                // 90: api.v1.UnsubscribeResponse
+	(*Company)(nil),                            // 91: api.v1.Company
+	(*CreateCompanyRequest)(nil),               // 92: api.v1.CreateCompanyRequest
+	(*CreateCompanyResponse)(nil),              // 93: api.v1.CreateCompanyResponse
+	(*UpdateCompanyRequest)(nil),               // 94: api.v1.UpdateCompanyRequest
+	(*UpdateCompanyResponse)(nil),              // 95: api.v1.UpdateCompanyResponse
+	(*ListCompaniesRequest)(nil),               // 96: api.v1.ListCompaniesRequest
+	(*CompanySummary)(nil),                     // 97: api.v1.CompanySummary
+	(*ListCompaniesResponse)(nil),              // 98: api.v1.ListCompaniesResponse
+	(*Contact)(nil),                            // 99: api.v1.Contact
+	(*CreateContactRequest)(nil),               // 100: api.v1.CreateContactRequest
+	(*CreateContactResponse)(nil),              // 101: api.v1.CreateContactResponse
+	(*GetContactRequest)(nil),                  // 102: api.v1.GetContactRequest
+	(*GetContactResponse)(nil),                 // 103: api.v1.GetContactResponse
+	(*UpdateContactRequest)(nil),               // 104: api.v1.UpdateContactRequest
+	(*UpdateContactResponse)(nil),              // 105: api.v1.UpdateContactResponse
+	(*DeleteContactRequest)(nil),               // 106: api.v1.DeleteContactRequest
+	(*DeleteContactResponse)(nil),              // 107: api.v1.DeleteContactResponse
+	(*ListContactsRequest)(nil),                // 108: api.v1.ListContactsRequest
+	(*ListContactsResponse)(nil),               // 109: api.v1.ListContactsResponse
+	(*LinkContactRequest)(nil),                 // 110: api.v1.LinkContactRequest
+	(*LinkContactResponse)(nil),                // 111: api.v1.LinkContactResponse
+	(*UnlinkContactRequest)(nil),               // 112: api.v1.UnlinkContactRequest
+	(*UnlinkContactResponse)(nil),              // 113: api.v1.UnlinkContactResponse
+	(*GetContactTimelineRequest)(nil),          // 114: api.v1.GetContactTimelineRequest
+	(*ContactTimelineEntry)(nil),               // 115: api.v1.ContactTimelineEntry
+	(*GetContactTimelineResponse)(nil),         // 116: api.v1.GetContactTimelineResponse
+	(*wrapperspb.StringValue)(nil),             // 117: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),              // 118: google.protobuf.Timestamp
+	(*wrapperspb.BoolValue)(nil),               // 119: google.protobuf.BoolValue
+	(*fieldmaskpb.FieldMask)(nil),              // 120: google.protobuf.FieldMask
+	(*wrapperspb.Int32Value)(nil),              // 121: google.protobuf.Int32Value
+	(*durationpb.Duration)(nil),                // 122: google.protobuf.Duration
 }
 var file_api_v1_api_proto_depIdxs = []int32{
-	12, // 0: api.v1.CreateJobApplicationRequest.description:type_name -> google.protobuf.StringValue
-	12, // 1: api.v1.CreateJobApplicationRequest.notes:type_name -> google.protobuf.StringValue
-	12, // 2: api.v1.CreateJobApplicationRequest.cv:type_name -> google.protobuf.StringValue
-	12, // 3: api.v1.CreateJobApplicationRequest.cover_letter:type_name -> google.protobuf.StringValue
-	13, // 4: api.v1.CreateJobApplicationRequest.applied_on:type_name -> google.protobuf.Timestamp
-	0,  // 5: api.v1.CreateJobApplicationRequest.status:type_name -> api.v1.JobApplicationStatus
-	9,  // 6: api.v1.CreateJobApplicationResponse.job_application:type_name -> api.v1.JobApplication
-	9,  // 7: api.v1.ListJobApplicationsResponse.job_applications:type_name -> api.v1.JobApplication
-	12, // 8: api.v1.UpdateJobApplicationRequest.description:type_name -> google.protobuf.StringValue
-	12, // 9: api.v1.UpdateJobApplicationRequest.notes:type_name -> google.protobuf.StringValue
-	12, // 10: api.v1.UpdateJobApplicationRequest.cv:type_name -> google.protobuf.StringValue
-	12, // 11: api.v1.UpdateJobApplicationRequest.cover_letter:type_name -> google.protobuf.StringValue
-	0,  // 12: api.v1.UpdateJobApplicationRequest.status:type_name -> api.v1.JobApplicationStatus
-	13, // 13: api.v1.UpdateJobApplicationRequest.applied_on:type_name -> google.protobuf.Timestamp
-	9,  // 14: api.v1.UpdateJobApplicationResponse.job_application:type_name -> api.v1.JobApplication
-	0,  // 15: api.v1.JobApplication.status:type_name -> api.v1.JobApplicationStatus
-	12, // 16: api.v1.JobApplication.description:type_name -> google.protobuf.StringValue
-	12, // 17: api.v1.JobApplication.notes:type_name -> google.protobuf.StringValue
-	12, // 18: api.v1.JobApplication.cv:type_name -> google.protobuf.StringValue
-	12, // 19: api.v1.JobApplication.cover_letter:type_name -> google.protobuf.StringValue
-	13, // 20: api.v1.JobApplication.applied_on:type_name -> google.protobuf.Timestamp
-	13, // 21: api.v1.JobApplication.created_at:type_name -> google.protobuf.Timestamp
-	13, // 22: api.v1.JobApplication.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 23: api.v1.UpdateJobApplicationStatusRequest.status:type_name -> api.v1.JobApplicationStatus
-	12, // 24: api.v1.UpdateJobApplicationStatusRequest.position:type_name -> google.protobuf.StringValue
-	9,  // 25: api.v1.UpdateJobApplicationStatusResponse.job_application:type_name -> api.v1.JobApplication
-	1,  // 26: api.v1.Service.CreateJobApplication:input_type -> api.v1.CreateJobApplicationRequest
-	3,  // 27: api.v1.Service.ListJobApplications:input_type -> api.v1.ListJobApplicationsRequest
-	5,  // 28: api.v1.Service.UpdateJobApplication:input_type -> api.v1.UpdateJobApplicationRequest
-	7,  // 29: api.v1.Service.DeleteJobApplication:input_type -> api.v1.DeleteJobApplicationRequest
-	10, // 30: api.v1.Service.UpdateJobApplicationStatus:input_type -> api.v1.UpdateJobApplicationStatusRequest
-	2,  // 31: api.v1.Service.CreateJobApplication:output_type -> api.v1.CreateJobApplicationResponse
-	4,  // 32: api.v1.Service.ListJobApplications:output_type -> api.v1.ListJobApplicationsResponse
-	6,  // 33: api.v1.Service.UpdateJobApplication:output_type -> api.v1.UpdateJobApplicationResponse
-	8,  // 34: api.v1.Service.DeleteJobApplication:output_type -> api.v1.DeleteJobApplicationResponse
-	11, // 35: api.v1.Service.UpdateJobApplicationStatus:output_type -> api.v1.UpdateJobApplicationStatusResponse
-	31, // [31:36] is the sub-list for method output_type
-	26, // [26:31] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	117, // 0: api.v1.CreateJobApplicationRequest.description:type_name -> google.protobuf.StringValue
+	117, // 1: api.v1.CreateJobApplicationRequest.notes:type_name -> google.protobuf.StringValue
+	117, // 2: api.v1.CreateJobApplicationRequest.cv:type_name -> google.protobuf.StringValue
+	117, // 3: api.v1.CreateJobApplicationRequest.cover_letter:type_name -> google.protobuf.StringValue
+	118, // 4: api.v1.CreateJobApplicationRequest.applied_on:type_name -> google.protobuf.Timestamp
+	1,   // 5: api.v1.CreateJobApplicationRequest.status:type_name -> api.v1.JobApplicationStatus
+	117, // 6: api.v1.CreateJobApplicationRequest.cv_document_id:type_name -> google.protobuf.StringValue
+	117, // 7: api.v1.CreateJobApplicationRequest.cover_letter_document_id:type_name -> google.protobuf.StringValue
+	118, // 8: api.v1.CreateJobApplicationRequest.follow_up_on:type_name -> google.protobuf.Timestamp
+	117, // 9: api.v1.CreateJobApplicationRequest.company_id:type_name -> google.protobuf.StringValue
+	20,  // 10: api.v1.CreateJobApplicationResponse.job_application:type_name -> api.v1.JobApplication
+	15,  // 11: api.v1.ListJobApplicationsRequest.filter:type_name -> api.v1.JobApplicationFilter
+	0,   // 12: api.v1.ListJobApplicationsRequest.sort_key:type_name -> api.v1.JobApplicationSortKey
+	20,  // 13: api.v1.ListJobApplicationsResponse.job_applications:type_name -> api.v1.JobApplication
+	1,   // 14: api.v1.JobApplicationFilter.statuses:type_name -> api.v1.JobApplicationStatus
+	118, // 15: api.v1.JobApplicationFilter.applied_on_from:type_name -> google.protobuf.Timestamp
+	118, // 16: api.v1.JobApplicationFilter.applied_on_to:type_name -> google.protobuf.Timestamp
+	119, // 17: api.v1.JobApplicationFilter.has_cv:type_name -> google.protobuf.BoolValue
+	119, // 18: api.v1.JobApplicationFilter.has_cover_letter:type_name -> google.protobuf.BoolValue
+	117, // 19: api.v1.UpdateJobApplicationRequest.description:type_name -> google.protobuf.StringValue
+	117, // 20: api.v1.UpdateJobApplicationRequest.notes:type_name -> google.protobuf.StringValue
+	117, // 21: api.v1.UpdateJobApplicationRequest.cv:type_name -> google.protobuf.StringValue
+	117, // 22: api.v1.UpdateJobApplicationRequest.cover_letter:type_name -> google.protobuf.StringValue
+	1,   // 23: api.v1.UpdateJobApplicationRequest.status:type_name -> api.v1.JobApplicationStatus
+	118, // 24: api.v1.UpdateJobApplicationRequest.applied_on:type_name -> google.protobuf.Timestamp
+	120, // 25: api.v1.UpdateJobApplicationRequest.update_mask:type_name -> google.protobuf.FieldMask
+	117, // 26: api.v1.UpdateJobApplicationRequest.cv_document_id:type_name -> google.protobuf.StringValue
+	117, // 27: api.v1.UpdateJobApplicationRequest.cover_letter_document_id:type_name -> google.protobuf.StringValue
+	118, // 28: api.v1.UpdateJobApplicationRequest.follow_up_on:type_name -> google.protobuf.Timestamp
+	117, // 29: api.v1.UpdateJobApplicationRequest.company_id:type_name -> google.protobuf.StringValue
+	20,  // 30: api.v1.UpdateJobApplicationResponse.job_application:type_name -> api.v1.JobApplication
+	1,   // 31: api.v1.JobApplication.status:type_name -> api.v1.JobApplicationStatus
+	117, // 32: api.v1.JobApplication.description:type_name -> google.protobuf.StringValue
+	117, // 33: api.v1.JobApplication.notes:type_name -> google.protobuf.StringValue
+	117, // 34: api.v1.JobApplication.cv:type_name -> google.protobuf.StringValue
+	117, // 35: api.v1.JobApplication.cover_letter:type_name -> google.protobuf.StringValue
+	118, // 36: api.v1.JobApplication.applied_on:type_name -> google.protobuf.Timestamp
+	118, // 37: api.v1.JobApplication.created_at:type_name -> google.protobuf.Timestamp
+	118, // 38: api.v1.JobApplication.updated_at:type_name -> google.protobuf.Timestamp
+	118, // 39: api.v1.JobApplication.deleted_at:type_name -> google.protobuf.Timestamp
+	117, // 40: api.v1.JobApplication.cv_document_id:type_name -> google.protobuf.StringValue
+	117, // 41: api.v1.JobApplication.cover_letter_document_id:type_name -> google.protobuf.StringValue
+	121, // 42: api.v1.JobApplication.match_score:type_name -> google.protobuf.Int32Value
+	117, // 43: api.v1.JobApplication.external_id:type_name -> google.protobuf.StringValue
+	118, // 44: api.v1.JobApplication.status_changed_at:type_name -> google.protobuf.Timestamp
+	118, // 45: api.v1.JobApplication.follow_up_on:type_name -> google.protobuf.Timestamp
+	117, // 46: api.v1.JobApplication.company_id:type_name -> google.protobuf.StringValue
+	1,   // 47: api.v1.UpdateJobApplicationStatusRequest.status:type_name -> api.v1.JobApplicationStatus
+	117, // 48: api.v1.UpdateJobApplicationStatusRequest.position:type_name -> google.protobuf.StringValue
+	20,  // 49: api.v1.UpdateJobApplicationStatusResponse.job_application:type_name -> api.v1.JobApplication
+	26,  // 50: api.v1.GetJobApplicationHistoryResponse.events:type_name -> api.v1.JobApplicationEvent
+	117, // 51: api.v1.JobApplicationFieldChange.old_value:type_name -> google.protobuf.StringValue
+	117, // 52: api.v1.JobApplicationFieldChange.new_value:type_name -> google.protobuf.StringValue
+	2,   // 53: api.v1.JobApplicationEvent.type:type_name -> api.v1.JobApplicationEventType
+	25,  // 54: api.v1.JobApplicationEvent.changes:type_name -> api.v1.JobApplicationFieldChange
+	118, // 55: api.v1.JobApplicationEvent.created_at:type_name -> google.protobuf.Timestamp
+	29,  // 56: api.v1.SearchJobApplicationsResponse.results:type_name -> api.v1.JobApplicationSearchResult
+	20,  // 57: api.v1.JobApplicationSearchResult.job_application:type_name -> api.v1.JobApplication
+	30,  // 58: api.v1.JobApplicationSearchResult.snippets:type_name -> api.v1.JobApplicationSearchSnippet
+	32,  // 59: api.v1.UserSettings.ghosting_rules:type_name -> api.v1.GhostingRule
+	1,   // 60: api.v1.GhostingRule.status:type_name -> api.v1.JobApplicationStatus
+	31,  // 61: api.v1.GetUserSettingsResponse.settings:type_name -> api.v1.UserSettings
+	31,  // 62: api.v1.UpdateUserSettingsRequest.settings:type_name -> api.v1.UserSettings
+	120, // 63: api.v1.UpdateUserSettingsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	31,  // 64: api.v1.UpdateUserSettingsResponse.settings:type_name -> api.v1.UserSettings
+	20,  // 65: api.v1.ListDeletedJobApplicationsResponse.job_applications:type_name -> api.v1.JobApplication
+	20,  // 66: api.v1.RestoreJobApplicationResponse.job_application:type_name -> api.v1.JobApplication
+	118, // 67: api.v1.Interview.scheduled_at:type_name -> google.protobuf.Timestamp
+	122, // 68: api.v1.Interview.duration:type_name -> google.protobuf.Duration
+	3,   // 69: api.v1.Interview.format:type_name -> api.v1.InterviewFormat
+	4,   // 70: api.v1.Interview.outcome:type_name -> api.v1.InterviewOutcome
+	118, // 71: api.v1.Interview.created_at:type_name -> google.protobuf.Timestamp
+	118, // 72: api.v1.Interview.updated_at:type_name -> google.protobuf.Timestamp
+	118, // 73: api.v1.CreateInterviewRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	122, // 74: api.v1.CreateInterviewRequest.duration:type_name -> google.protobuf.Duration
+	3,   // 75: api.v1.CreateInterviewRequest.format:type_name -> api.v1.InterviewFormat
+	41,  // 76: api.v1.CreateInterviewResponse.interview:type_name -> api.v1.Interview
+	41,  // 77: api.v1.ListInterviewsResponse.interviews:type_name -> api.v1.Interview
+	118, // 78: api.v1.UpdateInterviewRequest.scheduled_at:type_name -> google.protobuf.Timestamp
+	122, // 79: api.v1.UpdateInterviewRequest.duration:type_name -> google.protobuf.Duration
+	3,   // 80: api.v1.UpdateInterviewRequest.format:type_name -> api.v1.InterviewFormat
+	4,   // 81: api.v1.UpdateInterviewRequest.outcome:type_name -> api.v1.InterviewOutcome
+	41,  // 82: api.v1.UpdateInterviewResponse.interview:type_name -> api.v1.Interview
+	52,  // 83: api.v1.ListUpcomingInterviewsResponse.interviews:type_name -> api.v1.UpcomingInterview
+	41,  // 84: api.v1.UpcomingInterview.interview:type_name -> api.v1.Interview
+	20,  // 85: api.v1.UpcomingInterview.job_application:type_name -> api.v1.JobApplication
+	26,  // 86: api.v1.WatchJobApplicationsResponse.event:type_name -> api.v1.JobApplicationEvent
+	20,  // 87: api.v1.WatchJobApplicationsResponse.job_application:type_name -> api.v1.JobApplication
+	5,   // 88: api.v1.Document.kind:type_name -> api.v1.DocumentKind
+	118, // 89: api.v1.Document.created_at:type_name -> google.protobuf.Timestamp
+	55,  // 90: api.v1.DocumentSummary.document:type_name -> api.v1.Document
+	5,   // 91: api.v1.UploadDocumentRequest.kind:type_name -> api.v1.DocumentKind
+	55,  // 92: api.v1.UploadDocumentResponse.document:type_name -> api.v1.Document
+	55,  // 93: api.v1.GetDocumentResponse.document:type_name -> api.v1.Document
+	55,  // 94: api.v1.DownloadDocumentResponse.document:type_name -> api.v1.Document
+	5,   // 95: api.v1.UpdateDocumentRequest.kind:type_name -> api.v1.DocumentKind
+	55,  // 96: api.v1.UpdateDocumentResponse.document:type_name -> api.v1.Document
+	5,   // 97: api.v1.ListDocumentsRequest.kind:type_name -> api.v1.DocumentKind
+	56,  // 98: api.v1.ListDocumentsResponse.documents:type_name -> api.v1.DocumentSummary
+	5,   // 99: api.v1.SearchDocumentsRequest.kind:type_name -> api.v1.DocumentKind
+	55,  // 100: api.v1.DocumentSearchResult.document:type_name -> api.v1.Document
+	70,  // 101: api.v1.SearchDocumentsResponse.results:type_name -> api.v1.DocumentSearchResult
+	20,  // 102: api.v1.ScoreJobApplicationResponse.job_application:type_name -> api.v1.JobApplication
+	6,   // 103: api.v1.ImportJobApplicationsRequest.format:type_name -> api.v1.ImportFormat
+	74,  // 104: api.v1.ImportJobApplicationsRequest.mapping:type_name -> api.v1.ImportColumnMapping
+	7,   // 105: api.v1.ImportRowResult.outcome:type_name -> api.v1.ImportRowOutcome
+	76,  // 106: api.v1.ImportJobApplicationsResponse.results:type_name -> api.v1.ImportRowResult
+	8,   // 107: api.v1.ExportJobApplicationsRequest.format:type_name -> api.v1.ExportFormat
+	118, // 108: api.v1.GetPipelineStatsRequest.applied_on_from:type_name -> google.protobuf.Timestamp
+	118, // 109: api.v1.GetPipelineStatsRequest.applied_on_to:type_name -> google.protobuf.Timestamp
+	1,   // 110: api.v1.StatusCount.status:type_name -> api.v1.JobApplicationStatus
+	1,   // 111: api.v1.StageConversion.from_status:type_name -> api.v1.JobApplicationStatus
+	1,   // 112: api.v1.StageConversion.to_status:type_name -> api.v1.JobApplicationStatus
+	1,   // 113: api.v1.StageDuration.status:type_name -> api.v1.JobApplicationStatus
+	118, // 114: api.v1.WeeklyResponseRate.week_start:type_name -> google.protobuf.Timestamp
+	81,  // 115: api.v1.GetPipelineStatsResponse.status_counts:type_name -> api.v1.StatusCount
+	82,  // 116: api.v1.GetPipelineStatsResponse.conversions:type_name -> api.v1.StageConversion
+	83,  // 117: api.v1.GetPipelineStatsResponse.time_in_stage:type_name -> api.v1.StageDuration
+	84,  // 118: api.v1.GetPipelineStatsResponse.weekly_response_rates:type_name -> api.v1.WeeklyResponseRate
+	20,  // 119: api.v1.DueFollowUp.job_application:type_name -> api.v1.JobApplication
+	9,   // 120: api.v1.DueFollowUp.reason:type_name -> api.v1.FollowUpReason
+	118, // 121: api.v1.DueFollowUp.due_at:type_name -> google.protobuf.Timestamp
+	87,  // 122: api.v1.ListDueFollowUpsResponse.follow_ups:type_name -> api.v1.DueFollowUp
+	117, // 123: api.v1.Company.website:type_name -> google.protobuf.StringValue
+	117, // 124: api.v1.Company.location:type_name -> google.protobuf.StringValue
+	10,  // 125: api.v1.Company.size:type_name -> api.v1.CompanySize
+	117, // 126: api.v1.Company.industry:type_name -> google.protobuf.StringValue
+	117, // 127: api.v1.Company.notes:type_name -> google.protobuf.StringValue
+	118, // 128: api.v1.Company.created_at:type_name -> google.protobuf.Timestamp
+	118, // 129: api.v1.Company.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 130: api.v1.CreateCompanyRequest.website:type_name -> google.protobuf.StringValue
+	117, // 131: api.v1.CreateCompanyRequest.location:type_name -> google.protobuf.StringValue
+	10,  // 132: api.v1.CreateCompanyRequest.size:type_name -> api.v1.CompanySize
+	117, // 133: api.v1.CreateCompanyRequest.industry:type_name -> google.protobuf.StringValue
+	117, // 134: api.v1.CreateCompanyRequest.notes:type_name -> google.protobuf.StringValue
+	91,  // 135: api.v1.CreateCompanyResponse.company:type_name -> api.v1.Company
+	117, // 136: api.v1.UpdateCompanyRequest.website:type_name -> google.protobuf.StringValue
+	117, // 137: api.v1.UpdateCompanyRequest.location:type_name -> google.protobuf.StringValue
+	10,  // 138: api.v1.UpdateCompanyRequest.size:type_name -> api.v1.CompanySize
+	117, // 139: api.v1.UpdateCompanyRequest.industry:type_name -> google.protobuf.StringValue
+	117, // 140: api.v1.UpdateCompanyRequest.notes:type_name -> google.protobuf.StringValue
+	91,  // 141: api.v1.UpdateCompanyResponse.company:type_name -> api.v1.Company
+	91,  // 142: api.v1.CompanySummary.company:type_name -> api.v1.Company
+	81,  // 143: api.v1.CompanySummary.status_counts:type_name -> api.v1.StatusCount
+	118, // 144: api.v1.CompanySummary.last_applied_on:type_name -> google.protobuf.Timestamp
+	97,  // 145: api.v1.ListCompaniesResponse.companies:type_name -> api.v1.CompanySummary
+	117, // 146: api.v1.Contact.role:type_name -> google.protobuf.StringValue
+	117, // 147: api.v1.Contact.email:type_name -> google.protobuf.StringValue
+	117, // 148: api.v1.Contact.phone:type_name -> google.protobuf.StringValue
+	117, // 149: api.v1.Contact.linkedin_url:type_name -> google.protobuf.StringValue
+	117, // 150: api.v1.Contact.company_id:type_name -> google.protobuf.StringValue
+	118, // 151: api.v1.Contact.created_at:type_name -> google.protobuf.Timestamp
+	118, // 152: api.v1.Contact.updated_at:type_name -> google.protobuf.Timestamp
+	117, // 153: api.v1.CreateContactRequest.role:type_name -> google.protobuf.StringValue
+	117, // 154: api.v1.CreateContactRequest.email:type_name -> google.protobuf.StringValue
+	117, // 155: api.v1.CreateContactRequest.phone:type_name -> google.protobuf.StringValue
+	117, // 156: api.v1.CreateContactRequest.linkedin_url:type_name -> google.protobuf.StringValue
+	117, // 157: api.v1.CreateContactRequest.company_id:type_name -> google.protobuf.StringValue
+	99,  // 158: api.v1.CreateContactResponse.contact:type_name -> api.v1.Contact
+	99,  // 159: api.v1.GetContactResponse.contact:type_name -> api.v1.Contact
+	117, // 160: api.v1.UpdateContactRequest.role:type_name -> google.protobuf.StringValue
+	117, // 161: api.v1.UpdateContactRequest.email:type_name -> google.protobuf.StringValue
+	117, // 162: api.v1.UpdateContactRequest.phone:type_name -> google.protobuf.StringValue
+	117, // 163: api.v1.UpdateContactRequest.linkedin_url:type_name -> google.protobuf.StringValue
+	117, // 164: api.v1.UpdateContactRequest.company_id:type_name -> google.protobuf.StringValue
+	99,  // 165: api.v1.UpdateContactResponse.contact:type_name -> api.v1.Contact
+	117, // 166: api.v1.ListContactsRequest.company_id:type_name -> google.protobuf.StringValue
+	117, // 167: api.v1.ListContactsRequest.job_application_id:type_name -> google.protobuf.StringValue
+	99,  // 168: api.v1.ListContactsResponse.contacts:type_name -> api.v1.Contact
+	20,  // 169: api.v1.ContactTimelineEntry.job_application:type_name -> api.v1.JobApplication
+	118, // 170: api.v1.ContactTimelineEntry.linked_at:type_name -> google.protobuf.Timestamp
+	26,  // 171: api.v1.ContactTimelineEntry.events:type_name -> api.v1.JobApplicationEvent
+	115, // 172: api.v1.GetContactTimelineResponse.entries:type_name -> api.v1.ContactTimelineEntry
+	11,  // 173: api.v1.Service.CreateJobApplication:input_type -> api.v1.CreateJobApplicationRequest
+	13,  // 174: api.v1.Service.ListJobApplications:input_type -> api.v1.ListJobApplicationsRequest
+	16,  // 175: api.v1.Service.UpdateJobApplication:input_type -> api.v1.UpdateJobApplicationRequest
+	18,  // 176: api.v1.Service.DeleteJobApplication:input_type -> api.v1.DeleteJobApplicationRequest
+	21,  // 177: api.v1.Service.UpdateJobApplicationStatus:input_type -> api.v1.UpdateJobApplicationStatusRequest
+	23,  // 178: api.v1.Service.GetJobApplicationHistory:input_type -> api.v1.GetJobApplicationHistoryRequest
+	27,  // 179: api.v1.Service.SearchJobApplications:input_type -> api.v1.SearchJobApplicationsRequest
+	33,  // 180: api.v1.Service.GetUserSettings:input_type -> api.v1.GetUserSettingsRequest
+	35,  // 181: api.v1.Service.UpdateUserSettings:input_type -> api.v1.UpdateUserSettingsRequest
+	37,  // 182: api.v1.Service.ListDeletedJobApplications:input_type -> api.v1.ListDeletedJobApplicationsRequest
+	39,  // 183: api.v1.Service.RestoreJobApplication:input_type -> api.v1.RestoreJobApplicationRequest
+	42,  // 184: api.v1.Service.CreateInterview:input_type -> api.v1.CreateInterviewRequest
+	44,  // 185: api.v1.Service.ListInterviews:input_type -> api.v1.ListInterviewsRequest
+	46,  // 186: api.v1.Service.UpdateInterview:input_type -> api.v1.UpdateInterviewRequest
+	48,  // 187: api.v1.Service.DeleteInterview:input_type -> api.v1.DeleteInterviewRequest
+	50,  // 188: api.v1.Service.ListUpcomingInterviews:input_type -> api.v1.ListUpcomingInterviewsRequest
+	53,  // 189: api.v1.Service.WatchJobApplications:input_type -> api.v1.WatchJobApplicationsRequest
+	57,  // 190: api.v1.Service.UploadDocument:input_type -> api.v1.UploadDocumentRequest
+	59,  // 191: api.v1.Service.GetDocument:input_type -> api.v1.GetDocumentRequest
+	61,  // 192: api.v1.Service.DownloadDocument:input_type -> api.v1.DownloadDocumentRequest
+	63,  // 193: api.v1.Service.DeleteDocument:input_type -> api.v1.DeleteDocumentRequest
+	65,  // 194: api.v1.Service.UpdateDocument:input_type -> api.v1.UpdateDocumentRequest
+	67,  // 195: api.v1.Service.ListDocuments:input_type -> api.v1.ListDocumentsRequest
+	69,  // 196: api.v1.Service.SearchDocuments:input_type -> api.v1.SearchDocumentsRequest
+	72,  // 197: api.v1.Service.ScoreJobApplication:input_type -> api.v1.ScoreJobApplicationRequest
+	75,  // 198: api.v1.Service.ImportJobApplications:input_type -> api.v1.ImportJobApplicationsRequest
+	78,  // 199: api.v1.Service.ExportJobApplications:input_type -> api.v1.ExportJobApplicationsRequest
+	80,  // 200: api.v1.Service.GetPipelineStats:input_type -> api.v1.GetPipelineStatsRequest
+	86,  // 201: api.v1.Service.ListDueFollowUps:input_type -> api.v1.ListDueFollowUpsRequest
+	89,  // 202: api.v1.Service.Unsubscribe:input_type -> api.v1.UnsubscribeRequest
+	92,  // 203: api.v1.Service.CreateCompany:input_type -> api.v1.CreateCompanyRequest
+	94,  // 204: api.v1.Service.UpdateCompany:input_type -> api.v1.UpdateCompanyRequest
+	96,  // 205: api.v1.Service.ListCompanies:input_type -> api.v1.ListCompaniesRequest
+	100, // 206: api.v1.Service.CreateContact:input_type -> api.v1.CreateContactRequest
+	102, // 207: api.v1.Service.GetContact:input_type -> api.v1.GetContactRequest
+	104, // 208: api.v1.Service.UpdateContact:input_type -> api.v1.UpdateContactRequest
+	106, // 209: api.v1.Service.DeleteContact:input_type -> api.v1.DeleteContactRequest
+	108, // 210: api.v1.Service.ListContacts:input_type -> api.v1.ListContactsRequest
+	110, // 211: api.v1.Service.LinkContact:input_type -> api.v1.LinkContactRequest
+	112, // 212: api.v1.Service.UnlinkContact:input_type -> api.v1.UnlinkContactRequest
+	114, // 213: api.v1.Service.GetContactTimeline:input_type -> api.v1.GetContactTimelineRequest
+	12,  // 214: api.v1.Service.CreateJobApplication:output_type -> api.v1.CreateJobApplicationResponse
+	14,  // 215: api.v1.Service.ListJobApplications:output_type -> api.v1.ListJobApplicationsResponse
+	17,  // 216: api.v1.Service.UpdateJobApplication:output_type -> api.v1.UpdateJobApplicationResponse
+	19,  // 217: api.v1.Service.DeleteJobApplication:output_type -> api.v1.DeleteJobApplicationResponse
+	22,  // 218: api.v1.Service.UpdateJobApplicationStatus:output_type -> api.v1.UpdateJobApplicationStatusResponse
+	24,  // 219: api.v1.Service.GetJobApplicationHistory:output_type -> api.v1.GetJobApplicationHistoryResponse
+	28,  // 220: api.v1.Service.SearchJobApplications:output_type -> api.v1.SearchJobApplicationsResponse
+	34,  // 221: api.v1.Service.GetUserSettings:output_type -> api.v1.GetUserSettingsResponse
+	36,  // 222: api.v1.Service.UpdateUserSettings:output_type -> api.v1.UpdateUserSettingsResponse
+	38,  // 223: api.v1.Service.ListDeletedJobApplications:output_type -> api.v1.ListDeletedJobApplicationsResponse
+	40,  // 224: api.v1.Service.RestoreJobApplication:output_type -> api.v1.RestoreJobApplicationResponse
+	43,  // 225: api.v1.Service.CreateInterview:output_type -> api.v1.CreateInterviewResponse
+	45,  // 226: api.v1.Service.ListInterviews:output_type -> api.v1.ListInterviewsResponse
+	47,  // 227: api.v1.Service.UpdateInterview:output_type -> api.v1.UpdateInterviewResponse
+	49,  // 228: api.v1.Service.DeleteInterview:output_type -> api.v1.DeleteInterviewResponse
+	51,  // 229: api.v1.Service.ListUpcomingInterviews:output_type -> api.v1.ListUpcomingInterviewsResponse
+	54,  // 230: api.v1.Service.WatchJobApplications:output_type -> api.v1.WatchJobApplicationsResponse
+	58,  // 231: api.v1.Service.UploadDocument:output_type -> api.v1.UploadDocumentResponse
+	60,  // 232: api.v1.Service.GetDocument:output_type -> api.v1.GetDocumentResponse
+	62,  // 233: api.v1.Service.DownloadDocument:output_type -> api.v1.DownloadDocumentResponse
+	64,  // 234: api.v1.Service.DeleteDocument:output_type -> api.v1.DeleteDocumentResponse
+	66,  // 235: api.v1.Service.UpdateDocument:output_type -> api.v1.UpdateDocumentResponse
+	68,  // 236: api.v1.Service.ListDocuments:output_type -> api.v1.ListDocumentsResponse
+	71,  // 237: api.v1.Service.SearchDocuments:output_type -> api.v1.SearchDocumentsResponse
+	73,  // 238: api.v1.Service.ScoreJobApplication:output_type -> api.v1.ScoreJobApplicationResponse
+	77,  // 239: api.v1.Service.ImportJobApplications:output_type -> api.v1.ImportJobApplicationsResponse
+	79,  // 240: api.v1.Service.ExportJobApplications:output_type -> api.v1.ExportJobApplicationsResponse
+	85,  // 241: api.v1.Service.GetPipelineStats:output_type -> api.v1.GetPipelineStatsResponse
+	88,  // 242: api.v1.Service.ListDueFollowUps:output_type -> api.v1.ListDueFollowUpsResponse
+	90,  // 243: api.v1.Service.Unsubscribe:output_type -> api.v1.UnsubscribeResponse
+	93,  // 244: api.v1.Service.CreateCompany:output_type -> api.v1.CreateCompanyResponse
+	95,  // 245: api.v1.Service.UpdateCompany:output_type -> api.v1.UpdateCompanyResponse
+	98,  // 246: api.v1.Service.ListCompanies:output_type -> api.v1.ListCompaniesResponse
+	101, // 247: api.v1.Service.CreateContact:output_type -> api.v1.CreateContactResponse
+	103, // 248: api.v1.Service.GetContact:output_type -> api.v1.GetContactResponse
+	105, // 249: api.v1.Service.UpdateContact:output_type -> api.v1.UpdateContactResponse
+	107, // 250: api.v1.Service.DeleteContact:output_type -> api.v1.DeleteContactResponse
+	109, // 251: api.v1.Service.ListContacts:output_type -> api.v1.ListContactsResponse
+	111, // 252: api.v1.Service.LinkContact:output_type -> api.v1.LinkContactResponse
+	113, // 253: api.v1.Service.UnlinkContact:output_type -> api.v1.UnlinkContactResponse
+	116, // 254: api.v1.Service.GetContactTimeline:output_type -> api.v1.GetContactTimelineResponse
+	214, // [214:255] is the sub-list for method output_type
+	173, // [173:214] is the sub-list for method input_type
+	173, // [173:173] is the sub-list for extension type_name
+	173, // [173:173] is the sub-list for extension extendee
+	0,   // [0:173] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_api_proto_init() }
@@ -957,8 +8049,8 @@ func file_api_v1_api_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_api_proto_rawDesc), len(file_api_v1_api_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      11,
+			NumMessages:   106,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -48,6 +48,92 @@ const (
 	// ServiceUpdateJobApplicationStatusProcedure is the fully-qualified name of the Service's
 	// UpdateJobApplicationStatus RPC.
 	ServiceUpdateJobApplicationStatusProcedure = "/api.v1.Service/UpdateJobApplicationStatus"
+	// ServiceGetJobApplicationHistoryProcedure is the fully-qualified name of the Service's
+	// GetJobApplicationHistory RPC.
+	ServiceGetJobApplicationHistoryProcedure = "/api.v1.Service/GetJobApplicationHistory"
+	// ServiceSearchJobApplicationsProcedure is the fully-qualified name of the Service's
+	// SearchJobApplications RPC.
+	ServiceSearchJobApplicationsProcedure = "/api.v1.Service/SearchJobApplications"
+	// ServiceGetUserSettingsProcedure is the fully-qualified name of the Service's GetUserSettings RPC.
+	ServiceGetUserSettingsProcedure = "/api.v1.Service/GetUserSettings"
+	// ServiceUpdateUserSettingsProcedure is the fully-qualified name of the Service's
+	// UpdateUserSettings RPC.
+	ServiceUpdateUserSettingsProcedure = "/api.v1.Service/UpdateUserSettings"
+	// ServiceListDeletedJobApplicationsProcedure is the fully-qualified name of the Service's
+	// ListDeletedJobApplications RPC.
+	ServiceListDeletedJobApplicationsProcedure = "/api.v1.Service/ListDeletedJobApplications"
+	// ServiceRestoreJobApplicationProcedure is the fully-qualified name of the Service's
+	// RestoreJobApplication RPC.
+	ServiceRestoreJobApplicationProcedure = "/api.v1.Service/RestoreJobApplication"
+	// ServiceCreateInterviewProcedure is the fully-qualified name of the Service's CreateInterview RPC.
+	ServiceCreateInterviewProcedure = "/api.v1.Service/CreateInterview"
+	// ServiceListInterviewsProcedure is the fully-qualified name of the Service's ListInterviews RPC.
+	ServiceListInterviewsProcedure = "/api.v1.Service/ListInterviews"
+	// ServiceUpdateInterviewProcedure is the fully-qualified name of the Service's UpdateInterview RPC.
+	ServiceUpdateInterviewProcedure = "/api.v1.Service/UpdateInterview"
+	// ServiceDeleteInterviewProcedure is the fully-qualified name of the Service's DeleteInterview RPC.
+	ServiceDeleteInterviewProcedure = "/api.v1.Service/DeleteInterview"
+	// ServiceListUpcomingInterviewsProcedure is the fully-qualified name of the Service's
+	// ListUpcomingInterviews RPC.
+	ServiceListUpcomingInterviewsProcedure = "/api.v1.Service/ListUpcomingInterviews"
+	// ServiceWatchJobApplicationsProcedure is the fully-qualified name of the Service's
+	// WatchJobApplications RPC.
+	ServiceWatchJobApplicationsProcedure = "/api.v1.Service/WatchJobApplications"
+	// ServiceUploadDocumentProcedure is the fully-qualified name of the Service's UploadDocument RPC.
+	ServiceUploadDocumentProcedure = "/api.v1.Service/UploadDocument"
+	// ServiceGetDocumentProcedure is the fully-qualified name of the Service's GetDocument RPC.
+	ServiceGetDocumentProcedure = "/api.v1.Service/GetDocument"
+	// ServiceDownloadDocumentProcedure is the fully-qualified name of the Service's DownloadDocument
+	// RPC.
+	ServiceDownloadDocumentProcedure = "/api.v1.Service/DownloadDocument"
+	// ServiceDeleteDocumentProcedure is the fully-qualified name of the Service's DeleteDocument RPC.
+	ServiceDeleteDocumentProcedure = "/api.v1.Service/DeleteDocument"
+	// ServiceUpdateDocumentProcedure is the fully-qualified name of the Service's UpdateDocument RPC.
+	ServiceUpdateDocumentProcedure = "/api.v1.Service/UpdateDocument"
+	// ServiceListDocumentsProcedure is the fully-qualified name of the Service's ListDocuments RPC.
+	ServiceListDocumentsProcedure = "/api.v1.Service/ListDocuments"
+	// ServiceSearchDocumentsProcedure is the fully-qualified name of the Service's SearchDocuments RPC.
+	ServiceSearchDocumentsProcedure = "/api.v1.Service/SearchDocuments"
+	// ServiceScoreJobApplicationProcedure is the fully-qualified name of the Service's
+	// ScoreJobApplication RPC.
+	ServiceScoreJobApplicationProcedure = "/api.v1.Service/ScoreJobApplication"
+	// ServiceImportJobApplicationsProcedure is the fully-qualified name of the Service's
+	// ImportJobApplications RPC.
+	ServiceImportJobApplicationsProcedure = "/api.v1.Service/ImportJobApplications"
+	// ServiceExportJobApplicationsProcedure is the fully-qualified name of the Service's
+	// ExportJobApplications RPC.
+	ServiceExportJobApplicationsProcedure = "/api.v1.Service/ExportJobApplications"
+	// ServiceGetPipelineStatsProcedure is the fully-qualified name of the Service's GetPipelineStats
+	// RPC.
+	ServiceGetPipelineStatsProcedure = "/api.v1.Service/GetPipelineStats"
+	// ServiceListDueFollowUpsProcedure is the fully-qualified name of the Service's ListDueFollowUps
+	// RPC.
+	ServiceListDueFollowUpsProcedure = "/api.v1.Service/ListDueFollowUps"
+	// ServiceUnsubscribeProcedure is the fully-qualified name of the Service's Unsubscribe RPC.
+	ServiceUnsubscribeProcedure = "/api.v1.Service/Unsubscribe"
+	// ServiceCreateCompanyProcedure is the fully-qualified name of the Service's CreateCompany RPC.
+	ServiceCreateCompanyProcedure = "/api.v1.Service/CreateCompany"
+	// ServiceUpdateCompanyProcedure is the fully-qualified name of the Service's UpdateCompany RPC.
+	ServiceUpdateCompanyProcedure = "/api.v1.Service/UpdateCompany"
+	// ServiceListCompaniesProcedure is the fully-qualified name of the Service's ListCompanies RPC.
+	ServiceListCompaniesProcedure = "/api.v1.Service/ListCompanies"
+	// ServiceCreateContactProcedure is the fully-qualified name of the Service's CreateContact RPC.
+	ServiceCreateContactProcedure = "/api.v1.Service/CreateContact"
+	// ServiceGetContactProcedure is the fully-qualified name of the Service's GetContact RPC.
+	ServiceGetContactProcedure = "/api.v1.Service/GetContact"
+	// ServiceUpdateContactProcedure is the fully-qualified name of the Service's UpdateContact RPC.
+	ServiceUpdateContactProcedure = "/api.v1.Service/UpdateContact"
+	// ServiceDeleteContactProcedure is the fully-qualified name of the Service's DeleteContact RPC.
+	ServiceDeleteContactProcedure = "/api.v1.Service/DeleteContact"
+	// ServiceListContactsProcedure is the fully-qualified name of the Service's ListContacts RPC.
+	ServiceListContactsProcedure = "/api.v1.Service/ListContacts"
+	// ServiceLinkContactProcedure is the fully-qualified name of the Service's LinkContact RPC.
+	ServiceLinkContactProcedure = "/api.v1.Service/LinkContact"
+	// ServiceUnlinkContactProcedure is the fully-qualified name of the Service's UnlinkContact RPC.
+	ServiceUnlinkContactProcedure = "/api.v1.Service/UnlinkContact"
+	// ServiceGetContactTimelineProcedure is the fully-qualified name of the Service's
+	// GetContactTimeline RPC.
+	ServiceGetContactTimelineProcedure = "/api.v1.Service/GetContactTimeline"
 )
 
 // ServiceClient is a client for the api.v1.Service service.
@@ -57,6 +143,42 @@ type ServiceClient interface {
 	UpdateJobApplication(context.Context, *connect.Request[v1.UpdateJobApplicationRequest]) (*connect.Response[v1.UpdateJobApplicationResponse], error)
 	DeleteJobApplication(context.Context, *connect.Request[v1.DeleteJobApplicationRequest]) (*connect.Response[v1.DeleteJobApplicationResponse], error)
 	UpdateJobApplicationStatus(context.Context, *connect.Request[v1.UpdateJobApplicationStatusRequest]) (*connect.Response[v1.UpdateJobApplicationStatusResponse], error)
+	GetJobApplicationHistory(context.Context, *connect.Request[v1.GetJobApplicationHistoryRequest]) (*connect.Response[v1.GetJobApplicationHistoryResponse], error)
+	SearchJobApplications(context.Context, *connect.Request[v1.SearchJobApplicationsRequest]) (*connect.Response[v1.SearchJobApplicationsResponse], error)
+	GetUserSettings(context.Context, *connect.Request[v1.GetUserSettingsRequest]) (*connect.Response[v1.GetUserSettingsResponse], error)
+	UpdateUserSettings(context.Context, *connect.Request[v1.UpdateUserSettingsRequest]) (*connect.Response[v1.UpdateUserSettingsResponse], error)
+	ListDeletedJobApplications(context.Context, *connect.Request[v1.ListDeletedJobApplicationsRequest]) (*connect.Response[v1.ListDeletedJobApplicationsResponse], error)
+	RestoreJobApplication(context.Context, *connect.Request[v1.RestoreJobApplicationRequest]) (*connect.Response[v1.RestoreJobApplicationResponse], error)
+	CreateInterview(context.Context, *connect.Request[v1.CreateInterviewRequest]) (*connect.Response[v1.CreateInterviewResponse], error)
+	ListInterviews(context.Context, *connect.Request[v1.ListInterviewsRequest]) (*connect.Response[v1.ListInterviewsResponse], error)
+	UpdateInterview(context.Context, *connect.Request[v1.UpdateInterviewRequest]) (*connect.Response[v1.UpdateInterviewResponse], error)
+	DeleteInterview(context.Context, *connect.Request[v1.DeleteInterviewRequest]) (*connect.Response[v1.DeleteInterviewResponse], error)
+	ListUpcomingInterviews(context.Context, *connect.Request[v1.ListUpcomingInterviewsRequest]) (*connect.Response[v1.ListUpcomingInterviewsResponse], error)
+	WatchJobApplications(context.Context, *connect.Request[v1.WatchJobApplicationsRequest]) (*connect.ServerStreamForClient[v1.WatchJobApplicationsResponse], error)
+	UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error)
+	GetDocument(context.Context, *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error)
+	DownloadDocument(context.Context, *connect.Request[v1.DownloadDocumentRequest]) (*connect.Response[v1.DownloadDocumentResponse], error)
+	DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error)
+	UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error)
+	ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error)
+	SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error)
+	ScoreJobApplication(context.Context, *connect.Request[v1.ScoreJobApplicationRequest]) (*connect.Response[v1.ScoreJobApplicationResponse], error)
+	ImportJobApplications(context.Context, *connect.Request[v1.ImportJobApplicationsRequest]) (*connect.Response[v1.ImportJobApplicationsResponse], error)
+	ExportJobApplications(context.Context, *connect.Request[v1.ExportJobApplicationsRequest]) (*connect.ServerStreamForClient[v1.ExportJobApplicationsResponse], error)
+	GetPipelineStats(context.Context, *connect.Request[v1.GetPipelineStatsRequest]) (*connect.Response[v1.GetPipelineStatsResponse], error)
+	ListDueFollowUps(context.Context, *connect.Request[v1.ListDueFollowUpsRequest]) (*connect.Response[v1.ListDueFollowUpsResponse], error)
+	Unsubscribe(context.Context, *connect.Request[v1.UnsubscribeRequest]) (*connect.Response[v1.UnsubscribeResponse], error)
+	CreateCompany(context.Context, *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error)
+	UpdateCompany(context.Context, *connect.Request[v1.UpdateCompanyRequest]) (*connect.Response[v1.UpdateCompanyResponse], error)
+	ListCompanies(context.Context, *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error)
+	CreateContact(context.Context, *connect.Request[v1.CreateContactRequest]) (*connect.Response[v1.CreateContactResponse], error)
+	GetContact(context.Context, *connect.Request[v1.GetContactRequest]) (*connect.Response[v1.GetContactResponse], error)
+	UpdateContact(context.Context, *connect.Request[v1.UpdateContactRequest]) (*connect.Response[v1.UpdateContactResponse], error)
+	DeleteContact(context.Context, *connect.Request[v1.DeleteContactRequest]) (*connect.Response[v1.DeleteContactResponse], error)
+	ListContacts(context.Context, *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error)
+	LinkContact(context.Context, *connect.Request[v1.LinkContactRequest]) (*connect.Response[v1.LinkContactResponse], error)
+	UnlinkContact(context.Context, *connect.Request[v1.UnlinkContactRequest]) (*connect.Response[v1.UnlinkContactResponse], error)
+	GetContactTimeline(context.Context, *connect.Request[v1.GetContactTimelineRequest]) (*connect.Response[v1.GetContactTimelineResponse], error)
 }
 
 // NewServiceClient constructs a client for the api.v1.Service service. By default, it uses the
@@ -100,6 +222,222 @@ func NewServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...con
 			connect.WithSchema(serviceMethods.ByName("UpdateJobApplicationStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getJobApplicationHistory: connect.NewClient[v1.GetJobApplicationHistoryRequest, v1.GetJobApplicationHistoryResponse](
+			httpClient,
+			baseURL+ServiceGetJobApplicationHistoryProcedure,
+			connect.WithSchema(serviceMethods.ByName("GetJobApplicationHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		searchJobApplications: connect.NewClient[v1.SearchJobApplicationsRequest, v1.SearchJobApplicationsResponse](
+			httpClient,
+			baseURL+ServiceSearchJobApplicationsProcedure,
+			connect.WithSchema(serviceMethods.ByName("SearchJobApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		getUserSettings: connect.NewClient[v1.GetUserSettingsRequest, v1.GetUserSettingsResponse](
+			httpClient,
+			baseURL+ServiceGetUserSettingsProcedure,
+			connect.WithSchema(serviceMethods.ByName("GetUserSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUserSettings: connect.NewClient[v1.UpdateUserSettingsRequest, v1.UpdateUserSettingsResponse](
+			httpClient,
+			baseURL+ServiceUpdateUserSettingsProcedure,
+			connect.WithSchema(serviceMethods.ByName("UpdateUserSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeletedJobApplications: connect.NewClient[v1.ListDeletedJobApplicationsRequest, v1.ListDeletedJobApplicationsResponse](
+			httpClient,
+			baseURL+ServiceListDeletedJobApplicationsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListDeletedJobApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreJobApplication: connect.NewClient[v1.RestoreJobApplicationRequest, v1.RestoreJobApplicationResponse](
+			httpClient,
+			baseURL+ServiceRestoreJobApplicationProcedure,
+			connect.WithSchema(serviceMethods.ByName("RestoreJobApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		createInterview: connect.NewClient[v1.CreateInterviewRequest, v1.CreateInterviewResponse](
+			httpClient,
+			baseURL+ServiceCreateInterviewProcedure,
+			connect.WithSchema(serviceMethods.ByName("CreateInterview")),
+			connect.WithClientOptions(opts...),
+		),
+		listInterviews: connect.NewClient[v1.ListInterviewsRequest, v1.ListInterviewsResponse](
+			httpClient,
+			baseURL+ServiceListInterviewsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListInterviews")),
+			connect.WithClientOptions(opts...),
+		),
+		updateInterview: connect.NewClient[v1.UpdateInterviewRequest, v1.UpdateInterviewResponse](
+			httpClient,
+			baseURL+ServiceUpdateInterviewProcedure,
+			connect.WithSchema(serviceMethods.ByName("UpdateInterview")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteInterview: connect.NewClient[v1.DeleteInterviewRequest, v1.DeleteInterviewResponse](
+			httpClient,
+			baseURL+ServiceDeleteInterviewProcedure,
+			connect.WithSchema(serviceMethods.ByName("DeleteInterview")),
+			connect.WithClientOptions(opts...),
+		),
+		listUpcomingInterviews: connect.NewClient[v1.ListUpcomingInterviewsRequest, v1.ListUpcomingInterviewsResponse](
+			httpClient,
+			baseURL+ServiceListUpcomingInterviewsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListUpcomingInterviews")),
+			connect.WithClientOptions(opts...),
+		),
+		watchJobApplications: connect.NewClient[v1.WatchJobApplicationsRequest, v1.WatchJobApplicationsResponse](
+			httpClient,
+			baseURL+ServiceWatchJobApplicationsProcedure,
+			connect.WithSchema(serviceMethods.ByName("WatchJobApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		uploadDocument: connect.NewClient[v1.UploadDocumentRequest, v1.UploadDocumentResponse](
+			httpClient,
+			baseURL+ServiceUploadDocumentProcedure,
+			connect.WithSchema(serviceMethods.ByName("UploadDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		getDocument: connect.NewClient[v1.GetDocumentRequest, v1.GetDocumentResponse](
+			httpClient,
+			baseURL+ServiceGetDocumentProcedure,
+			connect.WithSchema(serviceMethods.ByName("GetDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		downloadDocument: connect.NewClient[v1.DownloadDocumentRequest, v1.DownloadDocumentResponse](
+			httpClient,
+			baseURL+ServiceDownloadDocumentProcedure,
+			connect.WithSchema(serviceMethods.ByName("DownloadDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDocument: connect.NewClient[v1.DeleteDocumentRequest, v1.DeleteDocumentResponse](
+			httpClient,
+			baseURL+ServiceDeleteDocumentProcedure,
+			connect.WithSchema(serviceMethods.ByName("DeleteDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		updateDocument: connect.NewClient[v1.UpdateDocumentRequest, v1.UpdateDocumentResponse](
+			httpClient,
+			baseURL+ServiceUpdateDocumentProcedure,
+			connect.WithSchema(serviceMethods.ByName("UpdateDocument")),
+			connect.WithClientOptions(opts...),
+		),
+		listDocuments: connect.NewClient[v1.ListDocumentsRequest, v1.ListDocumentsResponse](
+			httpClient,
+			baseURL+ServiceListDocumentsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListDocuments")),
+			connect.WithClientOptions(opts...),
+		),
+		searchDocuments: connect.NewClient[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse](
+			httpClient,
+			baseURL+ServiceSearchDocumentsProcedure,
+			connect.WithSchema(serviceMethods.ByName("SearchDocuments")),
+			connect.WithClientOptions(opts...),
+		),
+		scoreJobApplication: connect.NewClient[v1.ScoreJobApplicationRequest, v1.ScoreJobApplicationResponse](
+			httpClient,
+			baseURL+ServiceScoreJobApplicationProcedure,
+			connect.WithSchema(serviceMethods.ByName("ScoreJobApplication")),
+			connect.WithClientOptions(opts...),
+		),
+		importJobApplications: connect.NewClient[v1.ImportJobApplicationsRequest, v1.ImportJobApplicationsResponse](
+			httpClient,
+			baseURL+ServiceImportJobApplicationsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ImportJobApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		exportJobApplications: connect.NewClient[v1.ExportJobApplicationsRequest, v1.ExportJobApplicationsResponse](
+			httpClient,
+			baseURL+ServiceExportJobApplicationsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ExportJobApplications")),
+			connect.WithClientOptions(opts...),
+		),
+		getPipelineStats: connect.NewClient[v1.GetPipelineStatsRequest, v1.GetPipelineStatsResponse](
+			httpClient,
+			baseURL+ServiceGetPipelineStatsProcedure,
+			connect.WithSchema(serviceMethods.ByName("GetPipelineStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listDueFollowUps: connect.NewClient[v1.ListDueFollowUpsRequest, v1.ListDueFollowUpsResponse](
+			httpClient,
+			baseURL+ServiceListDueFollowUpsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListDueFollowUps")),
+			connect.WithClientOptions(opts...),
+		),
+		unsubscribe: connect.NewClient[v1.UnsubscribeRequest, v1.UnsubscribeResponse](
+			httpClient,
+			baseURL+ServiceUnsubscribeProcedure,
+			connect.WithSchema(serviceMethods.ByName("Unsubscribe")),
+			connect.WithClientOptions(opts...),
+		),
+		createCompany: connect.NewClient[v1.CreateCompanyRequest, v1.CreateCompanyResponse](
+			httpClient,
+			baseURL+ServiceCreateCompanyProcedure,
+			connect.WithSchema(serviceMethods.ByName("CreateCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		updateCompany: connect.NewClient[v1.UpdateCompanyRequest, v1.UpdateCompanyResponse](
+			httpClient,
+			baseURL+ServiceUpdateCompanyProcedure,
+			connect.WithSchema(serviceMethods.ByName("UpdateCompany")),
+			connect.WithClientOptions(opts...),
+		),
+		listCompanies: connect.NewClient[v1.ListCompaniesRequest, v1.ListCompaniesResponse](
+			httpClient,
+			baseURL+ServiceListCompaniesProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListCompanies")),
+			connect.WithClientOptions(opts...),
+		),
+		createContact: connect.NewClient[v1.CreateContactRequest, v1.CreateContactResponse](
+			httpClient,
+			baseURL+ServiceCreateContactProcedure,
+			connect.WithSchema(serviceMethods.ByName("CreateContact")),
+			connect.WithClientOptions(opts...),
+		),
+		getContact: connect.NewClient[v1.GetContactRequest, v1.GetContactResponse](
+			httpClient,
+			baseURL+ServiceGetContactProcedure,
+			connect.WithSchema(serviceMethods.ByName("GetContact")),
+			connect.WithClientOptions(opts...),
+		),
+		updateContact: connect.NewClient[v1.UpdateContactRequest, v1.UpdateContactResponse](
+			httpClient,
+			baseURL+ServiceUpdateContactProcedure,
+			connect.WithSchema(serviceMethods.ByName("UpdateContact")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteContact: connect.NewClient[v1.DeleteContactRequest, v1.DeleteContactResponse](
+			httpClient,
+			baseURL+ServiceDeleteContactProcedure,
+			connect.WithSchema(serviceMethods.ByName("DeleteContact")),
+			connect.WithClientOptions(opts...),
+		),
+		listContacts: connect.NewClient[v1.ListContactsRequest, v1.ListContactsResponse](
+			httpClient,
+			baseURL+ServiceListContactsProcedure,
+			connect.WithSchema(serviceMethods.ByName("ListContacts")),
+			connect.WithClientOptions(opts...),
+		),
+		linkContact: connect.NewClient[v1.LinkContactRequest, v1.LinkContactResponse](
+			httpClient,
+			baseURL+ServiceLinkContactProcedure,
+			connect.WithSchema(serviceMethods.ByName("LinkContact")),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkContact: connect.NewClient[v1.UnlinkContactRequest, v1.UnlinkContactResponse](
+			httpClient,
+			baseURL+ServiceUnlinkContactProcedure,
+			connect.WithSchema(serviceMethods.ByName("UnlinkContact")),
+			connect.WithClientOptions(opts...),
+		),
+		getContactTimeline: connect.NewClient[v1.GetContactTimelineRequest, v1.GetContactTimelineResponse](
+			httpClient,
+			baseURL+ServiceGetContactTimelineProcedure,
+			connect.WithSchema(serviceMethods.ByName("GetContactTimeline")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -110,6 +448,42 @@ type serviceClient struct {
 	updateJobApplication       *connect.Client[v1.UpdateJobApplicationRequest, v1.UpdateJobApplicationResponse]
 	deleteJobApplication       *connect.Client[v1.DeleteJobApplicationRequest, v1.DeleteJobApplicationResponse]
 	updateJobApplicationStatus *connect.Client[v1.UpdateJobApplicationStatusRequest, v1.UpdateJobApplicationStatusResponse]
+	getJobApplicationHistory   *connect.Client[v1.GetJobApplicationHistoryRequest, v1.GetJobApplicationHistoryResponse]
+	searchJobApplications      *connect.Client[v1.SearchJobApplicationsRequest, v1.SearchJobApplicationsResponse]
+	getUserSettings            *connect.Client[v1.GetUserSettingsRequest, v1.GetUserSettingsResponse]
+	updateUserSettings         *connect.Client[v1.UpdateUserSettingsRequest, v1.UpdateUserSettingsResponse]
+	listDeletedJobApplications *connect.Client[v1.ListDeletedJobApplicationsRequest, v1.ListDeletedJobApplicationsResponse]
+	restoreJobApplication      *connect.Client[v1.RestoreJobApplicationRequest, v1.RestoreJobApplicationResponse]
+	createInterview            *connect.Client[v1.CreateInterviewRequest, v1.CreateInterviewResponse]
+	listInterviews             *connect.Client[v1.ListInterviewsRequest, v1.ListInterviewsResponse]
+	updateInterview            *connect.Client[v1.UpdateInterviewRequest, v1.UpdateInterviewResponse]
+	deleteInterview            *connect.Client[v1.DeleteInterviewRequest, v1.DeleteInterviewResponse]
+	listUpcomingInterviews     *connect.Client[v1.ListUpcomingInterviewsRequest, v1.ListUpcomingInterviewsResponse]
+	watchJobApplications       *connect.Client[v1.WatchJobApplicationsRequest, v1.WatchJobApplicationsResponse]
+	uploadDocument             *connect.Client[v1.UploadDocumentRequest, v1.UploadDocumentResponse]
+	getDocument                *connect.Client[v1.GetDocumentRequest, v1.GetDocumentResponse]
+	downloadDocument           *connect.Client[v1.DownloadDocumentRequest, v1.DownloadDocumentResponse]
+	deleteDocument             *connect.Client[v1.DeleteDocumentRequest, v1.DeleteDocumentResponse]
+	updateDocument             *connect.Client[v1.UpdateDocumentRequest, v1.UpdateDocumentResponse]
+	listDocuments              *connect.Client[v1.ListDocumentsRequest, v1.ListDocumentsResponse]
+	searchDocuments            *connect.Client[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse]
+	scoreJobApplication        *connect.Client[v1.ScoreJobApplicationRequest, v1.ScoreJobApplicationResponse]
+	importJobApplications      *connect.Client[v1.ImportJobApplicationsRequest, v1.ImportJobApplicationsResponse]
+	exportJobApplications      *connect.Client[v1.ExportJobApplicationsRequest, v1.ExportJobApplicationsResponse]
+	getPipelineStats           *connect.Client[v1.GetPipelineStatsRequest, v1.GetPipelineStatsResponse]
+	listDueFollowUps           *connect.Client[v1.ListDueFollowUpsRequest, v1.ListDueFollowUpsResponse]
+	unsubscribe                *connect.Client[v1.UnsubscribeRequest, v1.UnsubscribeResponse]
+	createCompany              *connect.Client[v1.CreateCompanyRequest, v1.CreateCompanyResponse]
+	updateCompany              *connect.Client[v1.UpdateCompanyRequest, v1.UpdateCompanyResponse]
+	listCompanies              *connect.Client[v1.ListCompaniesRequest, v1.ListCompaniesResponse]
+	createContact              *connect.Client[v1.CreateContactRequest, v1.CreateContactResponse]
+	getContact                 *connect.Client[v1.GetContactRequest, v1.GetContactResponse]
+	updateContact              *connect.Client[v1.UpdateContactRequest, v1.UpdateContactResponse]
+	deleteContact              *connect.Client[v1.DeleteContactRequest, v1.DeleteContactResponse]
+	listContacts               *connect.Client[v1.ListContactsRequest, v1.ListContactsResponse]
+	linkContact                *connect.Client[v1.LinkContactRequest, v1.LinkContactResponse]
+	unlinkContact              *connect.Client[v1.UnlinkContactRequest, v1.UnlinkContactResponse]
+	getContactTimeline         *connect.Client[v1.GetContactTimelineRequest, v1.GetContactTimelineResponse]
 }
 
 // CreateJobApplication calls api.v1.Service.CreateJobApplication.
@@ -137,6 +511,186 @@ func (c *serviceClient) UpdateJobApplicationStatus(ctx context.Context, req *con
 	return c.updateJobApplicationStatus.CallUnary(ctx, req)
 }
 
+// GetJobApplicationHistory calls api.v1.Service.GetJobApplicationHistory.
+func (c *serviceClient) GetJobApplicationHistory(ctx context.Context, req *connect.Request[v1.GetJobApplicationHistoryRequest]) (*connect.Response[v1.GetJobApplicationHistoryResponse], error) {
+	return c.getJobApplicationHistory.CallUnary(ctx, req)
+}
+
+// SearchJobApplications calls api.v1.Service.SearchJobApplications.
+func (c *serviceClient) SearchJobApplications(ctx context.Context, req *connect.Request[v1.SearchJobApplicationsRequest]) (*connect.Response[v1.SearchJobApplicationsResponse], error) {
+	return c.searchJobApplications.CallUnary(ctx, req)
+}
+
+// GetUserSettings calls api.v1.Service.GetUserSettings.
+func (c *serviceClient) GetUserSettings(ctx context.Context, req *connect.Request[v1.GetUserSettingsRequest]) (*connect.Response[v1.GetUserSettingsResponse], error) {
+	return c.getUserSettings.CallUnary(ctx, req)
+}
+
+// UpdateUserSettings calls api.v1.Service.UpdateUserSettings.
+func (c *serviceClient) UpdateUserSettings(ctx context.Context, req *connect.Request[v1.UpdateUserSettingsRequest]) (*connect.Response[v1.UpdateUserSettingsResponse], error) {
+	return c.updateUserSettings.CallUnary(ctx, req)
+}
+
+// ListDeletedJobApplications calls api.v1.Service.ListDeletedJobApplications.
+func (c *serviceClient) ListDeletedJobApplications(ctx context.Context, req *connect.Request[v1.ListDeletedJobApplicationsRequest]) (*connect.Response[v1.ListDeletedJobApplicationsResponse], error) {
+	return c.listDeletedJobApplications.CallUnary(ctx, req)
+}
+
+// RestoreJobApplication calls api.v1.Service.RestoreJobApplication.
+func (c *serviceClient) RestoreJobApplication(ctx context.Context, req *connect.Request[v1.RestoreJobApplicationRequest]) (*connect.Response[v1.RestoreJobApplicationResponse], error) {
+	return c.restoreJobApplication.CallUnary(ctx, req)
+}
+
+// CreateInterview calls api.v1.Service.CreateInterview.
+func (c *serviceClient) CreateInterview(ctx context.Context, req *connect.Request[v1.CreateInterviewRequest]) (*connect.Response[v1.CreateInterviewResponse], error) {
+	return c.createInterview.CallUnary(ctx, req)
+}
+
+// ListInterviews calls api.v1.Service.ListInterviews.
+func (c *serviceClient) ListInterviews(ctx context.Context, req *connect.Request[v1.ListInterviewsRequest]) (*connect.Response[v1.ListInterviewsResponse], error) {
+	return c.listInterviews.CallUnary(ctx, req)
+}
+
+// UpdateInterview calls api.v1.Service.UpdateInterview.
+func (c *serviceClient) UpdateInterview(ctx context.Context, req *connect.Request[v1.UpdateInterviewRequest]) (*connect.Response[v1.UpdateInterviewResponse], error) {
+	return c.updateInterview.CallUnary(ctx, req)
+}
+
+// DeleteInterview calls api.v1.Service.DeleteInterview.
+func (c *serviceClient) DeleteInterview(ctx context.Context, req *connect.Request[v1.DeleteInterviewRequest]) (*connect.Response[v1.DeleteInterviewResponse], error) {
+	return c.deleteInterview.CallUnary(ctx, req)
+}
+
+// ListUpcomingInterviews calls api.v1.Service.ListUpcomingInterviews.
+func (c *serviceClient) ListUpcomingInterviews(ctx context.Context, req *connect.Request[v1.ListUpcomingInterviewsRequest]) (*connect.Response[v1.ListUpcomingInterviewsResponse], error) {
+	return c.listUpcomingInterviews.CallUnary(ctx, req)
+}
+
+// WatchJobApplications calls api.v1.Service.WatchJobApplications.
+func (c *serviceClient) WatchJobApplications(ctx context.Context, req *connect.Request[v1.WatchJobApplicationsRequest]) (*connect.ServerStreamForClient[v1.WatchJobApplicationsResponse], error) {
+	return c.watchJobApplications.CallServerStream(ctx, req)
+}
+
+// UploadDocument calls api.v1.Service.UploadDocument.
+func (c *serviceClient) UploadDocument(ctx context.Context, req *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error) {
+	return c.uploadDocument.CallUnary(ctx, req)
+}
+
+// GetDocument calls api.v1.Service.GetDocument.
+func (c *serviceClient) GetDocument(ctx context.Context, req *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error) {
+	return c.getDocument.CallUnary(ctx, req)
+}
+
+// DownloadDocument calls api.v1.Service.DownloadDocument.
+func (c *serviceClient) DownloadDocument(ctx context.Context, req *connect.Request[v1.DownloadDocumentRequest]) (*connect.Response[v1.DownloadDocumentResponse], error) {
+	return c.downloadDocument.CallUnary(ctx, req)
+}
+
+// DeleteDocument calls api.v1.Service.DeleteDocument.
+func (c *serviceClient) DeleteDocument(ctx context.Context, req *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error) {
+	return c.deleteDocument.CallUnary(ctx, req)
+}
+
+// UpdateDocument calls api.v1.Service.UpdateDocument.
+func (c *serviceClient) UpdateDocument(ctx context.Context, req *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error) {
+	return c.updateDocument.CallUnary(ctx, req)
+}
+
+// ListDocuments calls api.v1.Service.ListDocuments.
+func (c *serviceClient) ListDocuments(ctx context.Context, req *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error) {
+	return c.listDocuments.CallUnary(ctx, req)
+}
+
+// SearchDocuments calls api.v1.Service.SearchDocuments.
+func (c *serviceClient) SearchDocuments(ctx context.Context, req *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error) {
+	return c.searchDocuments.CallUnary(ctx, req)
+}
+
+// ScoreJobApplication calls api.v1.Service.ScoreJobApplication.
+func (c *serviceClient) ScoreJobApplication(ctx context.Context, req *connect.Request[v1.ScoreJobApplicationRequest]) (*connect.Response[v1.ScoreJobApplicationResponse], error) {
+	return c.scoreJobApplication.CallUnary(ctx, req)
+}
+
+// ImportJobApplications calls api.v1.Service.ImportJobApplications.
+func (c *serviceClient) ImportJobApplications(ctx context.Context, req *connect.Request[v1.ImportJobApplicationsRequest]) (*connect.Response[v1.ImportJobApplicationsResponse], error) {
+	return c.importJobApplications.CallUnary(ctx, req)
+}
+
+// ExportJobApplications calls api.v1.Service.ExportJobApplications.
+func (c *serviceClient) ExportJobApplications(ctx context.Context, req *connect.Request[v1.ExportJobApplicationsRequest]) (*connect.ServerStreamForClient[v1.ExportJobApplicationsResponse], error) {
+	return c.exportJobApplications.CallServerStream(ctx, req)
+}
+
+// GetPipelineStats calls api.v1.Service.GetPipelineStats.
+func (c *serviceClient) GetPipelineStats(ctx context.Context, req *connect.Request[v1.GetPipelineStatsRequest]) (*connect.Response[v1.GetPipelineStatsResponse], error) {
+	return c.getPipelineStats.CallUnary(ctx, req)
+}
+
+// ListDueFollowUps calls api.v1.Service.ListDueFollowUps.
+func (c *serviceClient) ListDueFollowUps(ctx context.Context, req *connect.Request[v1.ListDueFollowUpsRequest]) (*connect.Response[v1.ListDueFollowUpsResponse], error) {
+	return c.listDueFollowUps.CallUnary(ctx, req)
+}
+
+// Unsubscribe calls api.v1.Service.Unsubscribe.
+func (c *serviceClient) Unsubscribe(ctx context.Context, req *connect.Request[v1.UnsubscribeRequest]) (*connect.Response[v1.UnsubscribeResponse], error) {
+	return c.unsubscribe.CallUnary(ctx, req)
+}
+
+// CreateCompany calls api.v1.Service.CreateCompany.
+func (c *serviceClient) CreateCompany(ctx context.Context, req *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error) {
+	return c.createCompany.CallUnary(ctx, req)
+}
+
+// UpdateCompany calls api.v1.Service.UpdateCompany.
+func (c *serviceClient) UpdateCompany(ctx context.Context, req *connect.Request[v1.UpdateCompanyRequest]) (*connect.Response[v1.UpdateCompanyResponse], error) {
+	return c.updateCompany.CallUnary(ctx, req)
+}
+
+// ListCompanies calls api.v1.Service.ListCompanies.
+func (c *serviceClient) ListCompanies(ctx context.Context, req *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error) {
+	return c.listCompanies.CallUnary(ctx, req)
+}
+
+// CreateContact calls api.v1.Service.CreateContact.
+func (c *serviceClient) CreateContact(ctx context.Context, req *connect.Request[v1.CreateContactRequest]) (*connect.Response[v1.CreateContactResponse], error) {
+	return c.createContact.CallUnary(ctx, req)
+}
+
+// GetContact calls api.v1.Service.GetContact.
+func (c *serviceClient) GetContact(ctx context.Context, req *connect.Request[v1.GetContactRequest]) (*connect.Response[v1.GetContactResponse], error) {
+	return c.getContact.CallUnary(ctx, req)
+}
+
+// UpdateContact calls api.v1.Service.UpdateContact.
+func (c *serviceClient) UpdateContact(ctx context.Context, req *connect.Request[v1.UpdateContactRequest]) (*connect.Response[v1.UpdateContactResponse], error) {
+	return c.updateContact.CallUnary(ctx, req)
+}
+
+// DeleteContact calls api.v1.Service.DeleteContact.
+func (c *serviceClient) DeleteContact(ctx context.Context, req *connect.Request[v1.DeleteContactRequest]) (*connect.Response[v1.DeleteContactResponse], error) {
+	return c.deleteContact.CallUnary(ctx, req)
+}
+
+// ListContacts calls api.v1.Service.ListContacts.
+func (c *serviceClient) ListContacts(ctx context.Context, req *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error) {
+	return c.listContacts.CallUnary(ctx, req)
+}
+
+// LinkContact calls api.v1.Service.LinkContact.
+func (c *serviceClient) LinkContact(ctx context.Context, req *connect.Request[v1.LinkContactRequest]) (*connect.Response[v1.LinkContactResponse], error) {
+	return c.linkContact.CallUnary(ctx, req)
+}
+
+// UnlinkContact calls api.v1.Service.UnlinkContact.
+func (c *serviceClient) UnlinkContact(ctx context.Context, req *connect.Request[v1.UnlinkContactRequest]) (*connect.Response[v1.UnlinkContactResponse], error) {
+	return c.unlinkContact.CallUnary(ctx, req)
+}
+
+// GetContactTimeline calls api.v1.Service.GetContactTimeline.
+func (c *serviceClient) GetContactTimeline(ctx context.Context, req *connect.Request[v1.GetContactTimelineRequest]) (*connect.Response[v1.GetContactTimelineResponse], error) {
+	return c.getContactTimeline.CallUnary(ctx, req)
+}
+
 // ServiceHandler is an implementation of the api.v1.Service service.
 type ServiceHandler interface {
 	CreateJobApplication(context.Context, *connect.Request[v1.CreateJobApplicationRequest]) (*connect.Response[v1.CreateJobApplicationResponse], error)
@@ -144,6 +698,42 @@ type ServiceHandler interface {
 	UpdateJobApplication(context.Context, *connect.Request[v1.UpdateJobApplicationRequest]) (*connect.Response[v1.UpdateJobApplicationResponse], error)
 	DeleteJobApplication(context.Context, *connect.Request[v1.DeleteJobApplicationRequest]) (*connect.Response[v1.DeleteJobApplicationResponse], error)
 	UpdateJobApplicationStatus(context.Context, *connect.Request[v1.UpdateJobApplicationStatusRequest]) (*connect.Response[v1.UpdateJobApplicationStatusResponse], error)
+	GetJobApplicationHistory(context.Context, *connect.Request[v1.GetJobApplicationHistoryRequest]) (*connect.Response[v1.GetJobApplicationHistoryResponse], error)
+	SearchJobApplications(context.Context, *connect.Request[v1.SearchJobApplicationsRequest]) (*connect.Response[v1.SearchJobApplicationsResponse], error)
+	GetUserSettings(context.Context, *connect.Request[v1.GetUserSettingsRequest]) (*connect.Response[v1.GetUserSettingsResponse], error)
+	UpdateUserSettings(context.Context, *connect.Request[v1.UpdateUserSettingsRequest]) (*connect.Response[v1.UpdateUserSettingsResponse], error)
+	ListDeletedJobApplications(context.Context, *connect.Request[v1.ListDeletedJobApplicationsRequest]) (*connect.Response[v1.ListDeletedJobApplicationsResponse], error)
+	RestoreJobApplication(context.Context, *connect.Request[v1.RestoreJobApplicationRequest]) (*connect.Response[v1.RestoreJobApplicationResponse], error)
+	CreateInterview(context.Context, *connect.Request[v1.CreateInterviewRequest]) (*connect.Response[v1.CreateInterviewResponse], error)
+	ListInterviews(context.Context, *connect.Request[v1.ListInterviewsRequest]) (*connect.Response[v1.ListInterviewsResponse], error)
+	UpdateInterview(context.Context, *connect.Request[v1.UpdateInterviewRequest]) (*connect.Response[v1.UpdateInterviewResponse], error)
+	DeleteInterview(context.Context, *connect.Request[v1.DeleteInterviewRequest]) (*connect.Response[v1.DeleteInterviewResponse], error)
+	ListUpcomingInterviews(context.Context, *connect.Request[v1.ListUpcomingInterviewsRequest]) (*connect.Response[v1.ListUpcomingInterviewsResponse], error)
+	WatchJobApplications(context.Context, *connect.Request[v1.WatchJobApplicationsRequest], *connect.ServerStream[v1.WatchJobApplicationsResponse]) error
+	UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error)
+	GetDocument(context.Context, *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error)
+	DownloadDocument(context.Context, *connect.Request[v1.DownloadDocumentRequest]) (*connect.Response[v1.DownloadDocumentResponse], error)
+	DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error)
+	UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error)
+	ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error)
+	SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error)
+	ScoreJobApplication(context.Context, *connect.Request[v1.ScoreJobApplicationRequest]) (*connect.Response[v1.ScoreJobApplicationResponse], error)
+	ImportJobApplications(context.Context, *connect.Request[v1.ImportJobApplicationsRequest]) (*connect.Response[v1.ImportJobApplicationsResponse], error)
+	ExportJobApplications(context.Context, *connect.Request[v1.ExportJobApplicationsRequest], *connect.ServerStream[v1.ExportJobApplicationsResponse]) error
+	GetPipelineStats(context.Context, *connect.Request[v1.GetPipelineStatsRequest]) (*connect.Response[v1.GetPipelineStatsResponse], error)
+	ListDueFollowUps(context.Context, *connect.Request[v1.ListDueFollowUpsRequest]) (*connect.Response[v1.ListDueFollowUpsResponse], error)
+	Unsubscribe(context.Context, *connect.Request[v1.UnsubscribeRequest]) (*connect.Response[v1.UnsubscribeResponse], error)
+	CreateCompany(context.Context, *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error)
+	UpdateCompany(context.Context, *connect.Request[v1.UpdateCompanyRequest]) (*connect.Response[v1.UpdateCompanyResponse], error)
+	ListCompanies(context.Context, *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error)
+	CreateContact(context.Context, *connect.Request[v1.CreateContactRequest]) (*connect.Response[v1.CreateContactResponse], error)
+	GetContact(context.Context, *connect.Request[v1.GetContactRequest]) (*connect.Response[v1.GetContactResponse], error)
+	UpdateContact(context.Context, *connect.Request[v1.UpdateContactRequest]) (*connect.Response[v1.UpdateContactResponse], error)
+	DeleteContact(context.Context, *connect.Request[v1.DeleteContactRequest]) (*connect.Response[v1.DeleteContactResponse], error)
+	ListContacts(context.Context, *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error)
+	LinkContact(context.Context, *connect.Request[v1.LinkContactRequest]) (*connect.Response[v1.LinkContactResponse], error)
+	UnlinkContact(context.Context, *connect.Request[v1.UnlinkContactRequest]) (*connect.Response[v1.UnlinkContactResponse], error)
+	GetContactTimeline(context.Context, *connect.Request[v1.GetContactTimelineRequest]) (*connect.Response[v1.GetContactTimelineResponse], error)
 }
 
 // NewServiceHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -183,6 +773,222 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (strin
 		connect.WithSchema(serviceMethods.ByName("UpdateJobApplicationStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serviceGetJobApplicationHistoryHandler := connect.NewUnaryHandler(
+		ServiceGetJobApplicationHistoryProcedure,
+		svc.GetJobApplicationHistory,
+		connect.WithSchema(serviceMethods.ByName("GetJobApplicationHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceSearchJobApplicationsHandler := connect.NewUnaryHandler(
+		ServiceSearchJobApplicationsProcedure,
+		svc.SearchJobApplications,
+		connect.WithSchema(serviceMethods.ByName("SearchJobApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceGetUserSettingsHandler := connect.NewUnaryHandler(
+		ServiceGetUserSettingsProcedure,
+		svc.GetUserSettings,
+		connect.WithSchema(serviceMethods.ByName("GetUserSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUpdateUserSettingsHandler := connect.NewUnaryHandler(
+		ServiceUpdateUserSettingsProcedure,
+		svc.UpdateUserSettings,
+		connect.WithSchema(serviceMethods.ByName("UpdateUserSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListDeletedJobApplicationsHandler := connect.NewUnaryHandler(
+		ServiceListDeletedJobApplicationsProcedure,
+		svc.ListDeletedJobApplications,
+		connect.WithSchema(serviceMethods.ByName("ListDeletedJobApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceRestoreJobApplicationHandler := connect.NewUnaryHandler(
+		ServiceRestoreJobApplicationProcedure,
+		svc.RestoreJobApplication,
+		connect.WithSchema(serviceMethods.ByName("RestoreJobApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceCreateInterviewHandler := connect.NewUnaryHandler(
+		ServiceCreateInterviewProcedure,
+		svc.CreateInterview,
+		connect.WithSchema(serviceMethods.ByName("CreateInterview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListInterviewsHandler := connect.NewUnaryHandler(
+		ServiceListInterviewsProcedure,
+		svc.ListInterviews,
+		connect.WithSchema(serviceMethods.ByName("ListInterviews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUpdateInterviewHandler := connect.NewUnaryHandler(
+		ServiceUpdateInterviewProcedure,
+		svc.UpdateInterview,
+		connect.WithSchema(serviceMethods.ByName("UpdateInterview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceDeleteInterviewHandler := connect.NewUnaryHandler(
+		ServiceDeleteInterviewProcedure,
+		svc.DeleteInterview,
+		connect.WithSchema(serviceMethods.ByName("DeleteInterview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListUpcomingInterviewsHandler := connect.NewUnaryHandler(
+		ServiceListUpcomingInterviewsProcedure,
+		svc.ListUpcomingInterviews,
+		connect.WithSchema(serviceMethods.ByName("ListUpcomingInterviews")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceWatchJobApplicationsHandler := connect.NewServerStreamHandler(
+		ServiceWatchJobApplicationsProcedure,
+		svc.WatchJobApplications,
+		connect.WithSchema(serviceMethods.ByName("WatchJobApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUploadDocumentHandler := connect.NewUnaryHandler(
+		ServiceUploadDocumentProcedure,
+		svc.UploadDocument,
+		connect.WithSchema(serviceMethods.ByName("UploadDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceGetDocumentHandler := connect.NewUnaryHandler(
+		ServiceGetDocumentProcedure,
+		svc.GetDocument,
+		connect.WithSchema(serviceMethods.ByName("GetDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceDownloadDocumentHandler := connect.NewUnaryHandler(
+		ServiceDownloadDocumentProcedure,
+		svc.DownloadDocument,
+		connect.WithSchema(serviceMethods.ByName("DownloadDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceDeleteDocumentHandler := connect.NewUnaryHandler(
+		ServiceDeleteDocumentProcedure,
+		svc.DeleteDocument,
+		connect.WithSchema(serviceMethods.ByName("DeleteDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUpdateDocumentHandler := connect.NewUnaryHandler(
+		ServiceUpdateDocumentProcedure,
+		svc.UpdateDocument,
+		connect.WithSchema(serviceMethods.ByName("UpdateDocument")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListDocumentsHandler := connect.NewUnaryHandler(
+		ServiceListDocumentsProcedure,
+		svc.ListDocuments,
+		connect.WithSchema(serviceMethods.ByName("ListDocuments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceSearchDocumentsHandler := connect.NewUnaryHandler(
+		ServiceSearchDocumentsProcedure,
+		svc.SearchDocuments,
+		connect.WithSchema(serviceMethods.ByName("SearchDocuments")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceScoreJobApplicationHandler := connect.NewUnaryHandler(
+		ServiceScoreJobApplicationProcedure,
+		svc.ScoreJobApplication,
+		connect.WithSchema(serviceMethods.ByName("ScoreJobApplication")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceImportJobApplicationsHandler := connect.NewUnaryHandler(
+		ServiceImportJobApplicationsProcedure,
+		svc.ImportJobApplications,
+		connect.WithSchema(serviceMethods.ByName("ImportJobApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceExportJobApplicationsHandler := connect.NewServerStreamHandler(
+		ServiceExportJobApplicationsProcedure,
+		svc.ExportJobApplications,
+		connect.WithSchema(serviceMethods.ByName("ExportJobApplications")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceGetPipelineStatsHandler := connect.NewUnaryHandler(
+		ServiceGetPipelineStatsProcedure,
+		svc.GetPipelineStats,
+		connect.WithSchema(serviceMethods.ByName("GetPipelineStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListDueFollowUpsHandler := connect.NewUnaryHandler(
+		ServiceListDueFollowUpsProcedure,
+		svc.ListDueFollowUps,
+		connect.WithSchema(serviceMethods.ByName("ListDueFollowUps")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUnsubscribeHandler := connect.NewUnaryHandler(
+		ServiceUnsubscribeProcedure,
+		svc.Unsubscribe,
+		connect.WithSchema(serviceMethods.ByName("Unsubscribe")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceCreateCompanyHandler := connect.NewUnaryHandler(
+		ServiceCreateCompanyProcedure,
+		svc.CreateCompany,
+		connect.WithSchema(serviceMethods.ByName("CreateCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUpdateCompanyHandler := connect.NewUnaryHandler(
+		ServiceUpdateCompanyProcedure,
+		svc.UpdateCompany,
+		connect.WithSchema(serviceMethods.ByName("UpdateCompany")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListCompaniesHandler := connect.NewUnaryHandler(
+		ServiceListCompaniesProcedure,
+		svc.ListCompanies,
+		connect.WithSchema(serviceMethods.ByName("ListCompanies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceCreateContactHandler := connect.NewUnaryHandler(
+		ServiceCreateContactProcedure,
+		svc.CreateContact,
+		connect.WithSchema(serviceMethods.ByName("CreateContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceGetContactHandler := connect.NewUnaryHandler(
+		ServiceGetContactProcedure,
+		svc.GetContact,
+		connect.WithSchema(serviceMethods.ByName("GetContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUpdateContactHandler := connect.NewUnaryHandler(
+		ServiceUpdateContactProcedure,
+		svc.UpdateContact,
+		connect.WithSchema(serviceMethods.ByName("UpdateContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceDeleteContactHandler := connect.NewUnaryHandler(
+		ServiceDeleteContactProcedure,
+		svc.DeleteContact,
+		connect.WithSchema(serviceMethods.ByName("DeleteContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceListContactsHandler := connect.NewUnaryHandler(
+		ServiceListContactsProcedure,
+		svc.ListContacts,
+		connect.WithSchema(serviceMethods.ByName("ListContacts")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceLinkContactHandler := connect.NewUnaryHandler(
+		ServiceLinkContactProcedure,
+		svc.LinkContact,
+		connect.WithSchema(serviceMethods.ByName("LinkContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceUnlinkContactHandler := connect.NewUnaryHandler(
+		ServiceUnlinkContactProcedure,
+		svc.UnlinkContact,
+		connect.WithSchema(serviceMethods.ByName("UnlinkContact")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serviceGetContactTimelineHandler := connect.NewUnaryHandler(
+		ServiceGetContactTimelineProcedure,
+		svc.GetContactTimeline,
+		connect.WithSchema(serviceMethods.ByName("GetContactTimeline")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/api.v1.Service/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServiceCreateJobApplicationProcedure:
@@ -195,6 +1001,78 @@ func NewServiceHandler(svc ServiceHandler, opts ...connect.HandlerOption) (strin
 			serviceDeleteJobApplicationHandler.ServeHTTP(w, r)
 		case ServiceUpdateJobApplicationStatusProcedure:
 			serviceUpdateJobApplicationStatusHandler.ServeHTTP(w, r)
+		case ServiceGetJobApplicationHistoryProcedure:
+			serviceGetJobApplicationHistoryHandler.ServeHTTP(w, r)
+		case ServiceSearchJobApplicationsProcedure:
+			serviceSearchJobApplicationsHandler.ServeHTTP(w, r)
+		case ServiceGetUserSettingsProcedure:
+			serviceGetUserSettingsHandler.ServeHTTP(w, r)
+		case ServiceUpdateUserSettingsProcedure:
+			serviceUpdateUserSettingsHandler.ServeHTTP(w, r)
+		case ServiceListDeletedJobApplicationsProcedure:
+			serviceListDeletedJobApplicationsHandler.ServeHTTP(w, r)
+		case ServiceRestoreJobApplicationProcedure:
+			serviceRestoreJobApplicationHandler.ServeHTTP(w, r)
+		case ServiceCreateInterviewProcedure:
+			serviceCreateInterviewHandler.ServeHTTP(w, r)
+		case ServiceListInterviewsProcedure:
+			serviceListInterviewsHandler.ServeHTTP(w, r)
+		case ServiceUpdateInterviewProcedure:
+			serviceUpdateInterviewHandler.ServeHTTP(w, r)
+		case ServiceDeleteInterviewProcedure:
+			serviceDeleteInterviewHandler.ServeHTTP(w, r)
+		case ServiceListUpcomingInterviewsProcedure:
+			serviceListUpcomingInterviewsHandler.ServeHTTP(w, r)
+		case ServiceWatchJobApplicationsProcedure:
+			serviceWatchJobApplicationsHandler.ServeHTTP(w, r)
+		case ServiceUploadDocumentProcedure:
+			serviceUploadDocumentHandler.ServeHTTP(w, r)
+		case ServiceGetDocumentProcedure:
+			serviceGetDocumentHandler.ServeHTTP(w, r)
+		case ServiceDownloadDocumentProcedure:
+			serviceDownloadDocumentHandler.ServeHTTP(w, r)
+		case ServiceDeleteDocumentProcedure:
+			serviceDeleteDocumentHandler.ServeHTTP(w, r)
+		case ServiceUpdateDocumentProcedure:
+			serviceUpdateDocumentHandler.ServeHTTP(w, r)
+		case ServiceListDocumentsProcedure:
+			serviceListDocumentsHandler.ServeHTTP(w, r)
+		case ServiceSearchDocumentsProcedure:
+			serviceSearchDocumentsHandler.ServeHTTP(w, r)
+		case ServiceScoreJobApplicationProcedure:
+			serviceScoreJobApplicationHandler.ServeHTTP(w, r)
+		case ServiceImportJobApplicationsProcedure:
+			serviceImportJobApplicationsHandler.ServeHTTP(w, r)
+		case ServiceExportJobApplicationsProcedure:
+			serviceExportJobApplicationsHandler.ServeHTTP(w, r)
+		case ServiceGetPipelineStatsProcedure:
+			serviceGetPipelineStatsHandler.ServeHTTP(w, r)
+		case ServiceListDueFollowUpsProcedure:
+			serviceListDueFollowUpsHandler.ServeHTTP(w, r)
+		case ServiceUnsubscribeProcedure:
+			serviceUnsubscribeHandler.ServeHTTP(w, r)
+		case ServiceCreateCompanyProcedure:
+			serviceCreateCompanyHandler.ServeHTTP(w, r)
+		case ServiceUpdateCompanyProcedure:
+			serviceUpdateCompanyHandler.ServeHTTP(w, r)
+		case ServiceListCompaniesProcedure:
+			serviceListCompaniesHandler.ServeHTTP(w, r)
+		case ServiceCreateContactProcedure:
+			serviceCreateContactHandler.ServeHTTP(w, r)
+		case ServiceGetContactProcedure:
+			serviceGetContactHandler.ServeHTTP(w, r)
+		case ServiceUpdateContactProcedure:
+			serviceUpdateContactHandler.ServeHTTP(w, r)
+		case ServiceDeleteContactProcedure:
+			serviceDeleteContactHandler.ServeHTTP(w, r)
+		case ServiceListContactsProcedure:
+			serviceListContactsHandler.ServeHTTP(w, r)
+		case ServiceLinkContactProcedure:
+			serviceLinkContactHandler.ServeHTTP(w, r)
+		case ServiceUnlinkContactProcedure:
+			serviceUnlinkContactHandler.ServeHTTP(w, r)
+		case ServiceGetContactTimelineProcedure:
+			serviceGetContactTimelineHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,3 +1101,147 @@ func (UnimplementedServiceHandler) DeleteJobApplication(context.Context, *connec
 func (UnimplementedServiceHandler) UpdateJobApplicationStatus(context.Context, *connect.Request[v1.UpdateJobApplicationStatusRequest]) (*connect.Response[v1.UpdateJobApplicationStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UpdateJobApplicationStatus is not implemented"))
 }
+
+func (UnimplementedServiceHandler) GetJobApplicationHistory(context.Context, *connect.Request[v1.GetJobApplicationHistoryRequest]) (*connect.Response[v1.GetJobApplicationHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.GetJobApplicationHistory is not implemented"))
+}
+
+func (UnimplementedServiceHandler) SearchJobApplications(context.Context, *connect.Request[v1.SearchJobApplicationsRequest]) (*connect.Response[v1.SearchJobApplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.SearchJobApplications is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetUserSettings(context.Context, *connect.Request[v1.GetUserSettingsRequest]) (*connect.Response[v1.GetUserSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.GetUserSettings is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UpdateUserSettings(context.Context, *connect.Request[v1.UpdateUserSettingsRequest]) (*connect.Response[v1.UpdateUserSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UpdateUserSettings is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListDeletedJobApplications(context.Context, *connect.Request[v1.ListDeletedJobApplicationsRequest]) (*connect.Response[v1.ListDeletedJobApplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListDeletedJobApplications is not implemented"))
+}
+
+func (UnimplementedServiceHandler) RestoreJobApplication(context.Context, *connect.Request[v1.RestoreJobApplicationRequest]) (*connect.Response[v1.RestoreJobApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.RestoreJobApplication is not implemented"))
+}
+
+func (UnimplementedServiceHandler) CreateInterview(context.Context, *connect.Request[v1.CreateInterviewRequest]) (*connect.Response[v1.CreateInterviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.CreateInterview is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListInterviews(context.Context, *connect.Request[v1.ListInterviewsRequest]) (*connect.Response[v1.ListInterviewsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListInterviews is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UpdateInterview(context.Context, *connect.Request[v1.UpdateInterviewRequest]) (*connect.Response[v1.UpdateInterviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UpdateInterview is not implemented"))
+}
+
+func (UnimplementedServiceHandler) DeleteInterview(context.Context, *connect.Request[v1.DeleteInterviewRequest]) (*connect.Response[v1.DeleteInterviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.DeleteInterview is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListUpcomingInterviews(context.Context, *connect.Request[v1.ListUpcomingInterviewsRequest]) (*connect.Response[v1.ListUpcomingInterviewsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListUpcomingInterviews is not implemented"))
+}
+
+func (UnimplementedServiceHandler) WatchJobApplications(context.Context, *connect.Request[v1.WatchJobApplicationsRequest], *connect.ServerStream[v1.WatchJobApplicationsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.WatchJobApplications is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UploadDocument(context.Context, *connect.Request[v1.UploadDocumentRequest]) (*connect.Response[v1.UploadDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UploadDocument is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetDocument(context.Context, *connect.Request[v1.GetDocumentRequest]) (*connect.Response[v1.GetDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.GetDocument is not implemented"))
+}
+
+func (UnimplementedServiceHandler) DownloadDocument(context.Context, *connect.Request[v1.DownloadDocumentRequest]) (*connect.Response[v1.DownloadDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.DownloadDocument is not implemented"))
+}
+
+func (UnimplementedServiceHandler) DeleteDocument(context.Context, *connect.Request[v1.DeleteDocumentRequest]) (*connect.Response[v1.DeleteDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.DeleteDocument is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UpdateDocument(context.Context, *connect.Request[v1.UpdateDocumentRequest]) (*connect.Response[v1.UpdateDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UpdateDocument is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListDocuments(context.Context, *connect.Request[v1.ListDocumentsRequest]) (*connect.Response[v1.ListDocumentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListDocuments is not implemented"))
+}
+
+func (UnimplementedServiceHandler) SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.SearchDocuments is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ScoreJobApplication(context.Context, *connect.Request[v1.ScoreJobApplicationRequest]) (*connect.Response[v1.ScoreJobApplicationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ScoreJobApplication is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ImportJobApplications(context.Context, *connect.Request[v1.ImportJobApplicationsRequest]) (*connect.Response[v1.ImportJobApplicationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ImportJobApplications is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ExportJobApplications(context.Context, *connect.Request[v1.ExportJobApplicationsRequest], *connect.ServerStream[v1.ExportJobApplicationsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ExportJobApplications is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetPipelineStats(context.Context, *connect.Request[v1.GetPipelineStatsRequest]) (*connect.Response[v1.GetPipelineStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.GetPipelineStats is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListDueFollowUps(context.Context, *connect.Request[v1.ListDueFollowUpsRequest]) (*connect.Response[v1.ListDueFollowUpsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListDueFollowUps is not implemented"))
+}
+
+func (UnimplementedServiceHandler) Unsubscribe(context.Context, *connect.Request[v1.UnsubscribeRequest]) (*connect.Response[v1.UnsubscribeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.Unsubscribe is not implemented"))
+}
+
+func (UnimplementedServiceHandler) CreateCompany(context.Context, *connect.Request[v1.CreateCompanyRequest]) (*connect.Response[v1.CreateCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.CreateCompany is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UpdateCompany(context.Context, *connect.Request[v1.UpdateCompanyRequest]) (*connect.Response[v1.UpdateCompanyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UpdateCompany is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListCompanies(context.Context, *connect.Request[v1.ListCompaniesRequest]) (*connect.Response[v1.ListCompaniesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListCompanies is not implemented"))
+}
+
+func (UnimplementedServiceHandler) CreateContact(context.Context, *connect.Request[v1.CreateContactRequest]) (*connect.Response[v1.CreateContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.CreateContact is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetContact(context.Context, *connect.Request[v1.GetContactRequest]) (*connect.Response[v1.GetContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.GetContact is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UpdateContact(context.Context, *connect.Request[v1.UpdateContactRequest]) (*connect.Response[v1.UpdateContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UpdateContact is not implemented"))
+}
+
+func (UnimplementedServiceHandler) DeleteContact(context.Context, *connect.Request[v1.DeleteContactRequest]) (*connect.Response[v1.DeleteContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.DeleteContact is not implemented"))
+}
+
+func (UnimplementedServiceHandler) ListContacts(context.Context, *connect.Request[v1.ListContactsRequest]) (*connect.Response[v1.ListContactsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.ListContacts is not implemented"))
+}
+
+func (UnimplementedServiceHandler) LinkContact(context.Context, *connect.Request[v1.LinkContactRequest]) (*connect.Response[v1.LinkContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.LinkContact is not implemented"))
+}
+
+func (UnimplementedServiceHandler) UnlinkContact(context.Context, *connect.Request[v1.UnlinkContactRequest]) (*connect.Response[v1.UnlinkContactResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.UnlinkContact is not implemented"))
+}
+
+func (UnimplementedServiceHandler) GetContactTimeline(context.Context, *connect.Request[v1.GetContactTimelineRequest]) (*connect.Response[v1.GetContactTimelineResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api.v1.Service.GetContactTimeline is not implemented"))
+}
@@ -0,0 +1,77 @@
+package blob
+
+import (
+	"context"
+	"io"
+
+	"kiseki"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Config configures an S3-compatible bucket, such as AWS S3, Cloudflare R2
+// or Supabase Storage's S3 endpoint.
+type S3Config struct {
+	// Endpoint is the host, and optionally port, of the S3 API.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// Insecure talks to the endpoint over plain HTTP, for local emulators.
+	Insecure bool
+}
+
+// NewS3Store returns a kiseki.BlobStore that keeps content in an
+// S3-compatible bucket.
+func NewS3Store(config S3Config) (kiseki.BlobStore, error) {
+	client, err := minio.New(config.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKeyID, config.SecretAccessKey, ""),
+		Secure: !config.Insecure,
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s3Store{client: client, bucket: config.Bucket}, nil
+}
+
+type s3Store struct {
+	client *minio.Client
+	bucket string
+}
+
+func (s *s3Store) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, content, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return err
+}
+
+func (s *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	// GetObject only fails on the first read, so check the object exists
+	// first to report a missing one properly.
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, kiseki.ErrBlobNotFound
+		}
+		return nil, err
+	}
+	return s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+}
+
+func (s *s3Store) Delete(ctx context.Context, keys []string) error {
+	objects := make(chan minio.ObjectInfo, len(keys))
+	for _, key := range keys {
+		objects <- minio.ObjectInfo{Key: key}
+	}
+	close(objects)
+
+	for result := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if result.Err != nil {
+			return result.Err
+		}
+	}
+	return nil
+}
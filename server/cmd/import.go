@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"kiseki/importer"
+	"kiseki/postgres"
+	"kiseki/service"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// mappingFlag collects repeated -map field=column flags.
+type mappingFlag importer.Mapping
+
+func (m mappingFlag) String() string {
+	pairs := make([]string, 0, len(m))
+	for field, column := range m {
+		pairs = append(pairs, field+"="+column)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func (m mappingFlag) Set(v string) error {
+	field, column, ok := strings.Cut(v, "=")
+	if !ok || field == "" || column == "" {
+		return fmt.Errorf("expected field=column, got %q", v)
+	}
+	m[strings.TrimSpace(field)] = strings.TrimSpace(column)
+	return nil
+}
+
+// runImport implements the import subcommand, which imports job applications
+// from a CSV or JSON file straight into the database:
+//
+//	kiseki import -user <id> [-format csv|json] [-map field=column]... [-day-first] [-dry-run] <file>
+func runImport(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: kiseki import -user <id> [flags] <file>")
+		fmt.Fprintln(flags.Output(), "\nImports job applications from a CSV or JSON file. Fields:", strings.Join(importer.Fields, ", "))
+		fmt.Fprintln(flags.Output())
+		flags.PrintDefaults()
+	}
+	userID := flags.String("user", "", "ID of the user to import the job applications for")
+	formatName := flags.String("format", "", "format of the file, csv or json (default from the file extension)")
+	mapping := mappingFlag{}
+	flags.Var(mapping, "map", "read a field from a differently named column, as field=column (repeatable)")
+	dayFirst := flags.Bool("day-first", false, "read ambiguous dates such as 03/04/2024 as day/month")
+	dryRun := flags.Bool("dry-run", false, "report what would be imported without saving anything")
+	flags.Parse(args)
+
+	if *userID == "" || flags.NArg() != 1 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	path := flags.Arg(0)
+
+	if *formatName == "" {
+		*formatName = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	var format importer.Format
+	switch strings.ToLower(*formatName) {
+	case "csv":
+		format = importer.FormatCSV
+	case "json":
+		format = importer.FormatJSON
+	default:
+		log.Fatalf("Unknown format %q, use -format csv or -format json", *formatName)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", path, err)
+	}
+	defer f.Close()
+
+	rows, err := importer.Read(f, importer.Options{
+		Format:   format,
+		Mapping:  importer.Mapping(mapping),
+		DayFirst: *dayFirst,
+	})
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", path, err)
+	}
+
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, databaseURL())
+	if err != nil {
+		log.Fatalf("Failed to create connection pool: %v", err)
+	}
+	defer pool.Close()
+
+	imp := service.NewImporter(postgres.NewJobApplicationRepository(pool), postgres.NewPositionRepository(pool))
+	report, err := imp.Import(ctx, *userID, rows, *dryRun)
+	if err != nil {
+		log.Fatalf("Failed to import: %v", err)
+	}
+
+	for _, result := range report.Results {
+		label := fmt.Sprintf("row %d", result.Row)
+		if result.ExternalID != "" {
+			label += " (" + result.ExternalID + ")"
+		}
+		switch result.Outcome {
+		case importer.OutcomeCreated:
+			fmt.Printf("%s: created %s\n", label, result.JobApplicationID)
+		case importer.OutcomeSkipped:
+			fmt.Printf("%s: skipped, already imported\n", label)
+		case importer.OutcomeInvalid:
+			fmt.Printf("%s: invalid: %v\n", label, result.Err)
+		}
+	}
+
+	fmt.Printf("\n%d created, %d skipped, %d invalid\n", report.Created, report.Skipped, report.Invalid)
+	switch {
+	case report.Committed:
+		fmt.Println("Import saved.")
+	case report.Invalid > 0:
+		fmt.Println("Nothing was saved, fix the invalid rows and import again.")
+		os.Exit(1)
+	default:
+		fmt.Println("Dry run, nothing was saved.")
+	}
+}
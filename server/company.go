@@ -0,0 +1,156 @@
+package kiseki
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CompanySize is the domain enum for how many people a company employs. The
+// numeric values intentionally match the protobuf enum values.
+type CompanySize int32
+
+const (
+	CompanySizeUnspecified CompanySize = 0
+	CompanySize1To10       CompanySize = 1
+	CompanySize11To50      CompanySize = 2
+	CompanySize51To200     CompanySize = 3
+	CompanySize201To1000   CompanySize = 4
+	CompanySize1001To5000  CompanySize = 5
+	CompanySize5001Plus    CompanySize = 6
+)
+
+// CompanySizeToDB converts the domain enum to the DB enum label, e.g. 51_200.
+func CompanySizeToDB(s CompanySize) string {
+	switch s {
+	case CompanySize1To10:
+		return "1_10"
+	case CompanySize11To50:
+		return "11_50"
+	case CompanySize51To200:
+		return "51_200"
+	case CompanySize201To1000:
+		return "201_1000"
+	case CompanySize1001To5000:
+		return "1001_5000"
+	case CompanySize5001Plus:
+		return "5001_PLUS"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// CompanySizeFromDB converts a DB label to the domain enum. Case-insensitive.
+func CompanySizeFromDB(db string) CompanySize {
+	switch strings.ToUpper(strings.TrimSpace(db)) {
+	case "1_10":
+		return CompanySize1To10
+	case "11_50":
+		return CompanySize11To50
+	case "51_200":
+		return CompanySize51To200
+	case "201_1000":
+		return CompanySize201To1000
+	case "1001_5000":
+		return CompanySize1001To5000
+	case "5001_PLUS":
+		return CompanySize5001Plus
+	default:
+		return CompanySizeUnspecified
+	}
+}
+
+// ErrCompanyExists is returned when saving a company under a name the user
+// already has a company for. Names are compared ignoring case, punctuation
+// and legal suffixes such as "Inc" or "LLC".
+var ErrCompanyExists = errors.New("a company with this name already exists")
+
+// Company is a company a user applies to. Job applications link to it, so
+// the different ways its name was written count as one company.
+type Company struct {
+	ID     string
+	UserID string
+	// Name is the canonical name, which linked job applications take.
+	Name      string
+	Website   *string
+	Location  *string
+	Size      CompanySize
+	Industry  *string
+	Notes     *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NewCompanyParams struct {
+	UserID   string
+	Name     string
+	Website  *string
+	Location *string
+	Size     CompanySize
+	Industry *string
+	Notes    *string
+}
+
+func NewCompany(params NewCompanyParams) Company {
+	now := time.Now()
+	return Company{
+		ID:        uuid.New().String(),
+		UserID:    params.UserID,
+		Name:      strings.TrimSpace(params.Name),
+		Website:   params.Website,
+		Location:  params.Location,
+		Size:      params.Size,
+		Industry:  params.Industry,
+		Notes:     params.Notes,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+type UpdateCompanyParams struct {
+	Name     string
+	Website  *string
+	Location *string
+	Size     CompanySize
+	Industry *string
+	Notes    *string
+}
+
+// Update replaces the company's fields.
+func (c *Company) Update(params UpdateCompanyParams) {
+	c.Name = strings.TrimSpace(params.Name)
+	c.Website = params.Website
+	c.Location = params.Location
+	c.Size = params.Size
+	c.Industry = params.Industry
+	c.Notes = params.Notes
+	c.UpdatedAt = time.Now()
+}
+
+// CompanySummary is a company together with how the user's job applications
+// to it went.
+type CompanySummary struct {
+	Company *Company
+	// StatusCounts counts the job applications outside the trash linked to
+	// the company in each status.
+	StatusCounts map[JobApplicationStatus]int
+	// LastAppliedOn is the latest day one of them was applied on, if any.
+	LastAppliedOn *time.Time
+}
+
+// Applications returns the number of job applications linked to the
+// company.
+func (s CompanySummary) Applications() int {
+	n := 0
+	for _, count := range s.StatusCounts {
+		n += count
+	}
+	return n
+}
+
+// ListCompaniesParams lists a user's companies by name.
+type ListCompaniesParams struct {
+	UserID string
+}
@@ -2,66 +2,166 @@ package connect
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"slices"
 	"strings"
 
 	"kiseki/service"
 
 	"connectrpc.com/connect"
 	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
-// JWTMiddleware creates a Connect middleware that extracts the JWT token from the
-// Authorization header and adds it to the context.
-func JWTMiddleware(secret []byte, method jwt.SigningMethod, newClaims service.ClaimsFactory) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Get the Authorization header
-			auth := req.Header().Get("Authorization")
-			if auth == "" {
-				return next(ctx, req)
-			}
+// authErrorDomain is the domain reported in errdetails.ErrorInfo.
+const authErrorDomain = "kiseki"
 
-			// Check if it's a Bearer token
-			parts := strings.Split(auth, " ")
-			if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
-				return next(ctx, req)
-			}
+// authErrorReasons are the errdetails.ErrorInfo reasons clients get for each
+// way a token can be rejected.
+var authErrorReasons = map[error]string{
+	service.ErrTokenMissing:            "TOKEN_MISSING",
+	service.ErrTokenMalformed:          "TOKEN_MALFORMED",
+	service.ErrTokenExpired:            "TOKEN_EXPIRED",
+	service.ErrTokenNotActive:          "TOKEN_NOT_ACTIVE",
+	service.ErrTokenInvalid:            "TOKEN_INVALID",
+	service.ErrUnexpectedSigningMethod: "UNEXPECTED_SIGNING_METHOD",
+	service.ErrTokenWrongIssuer:        "WRONG_ISSUER",
+	service.ErrTokenWrongAudience:      "WRONG_AUDIENCE",
+	service.ErrTokenWrongRole:          "WRONG_ROLE",
+}
 
-			// Add the token to the context
-			tokenString := parts[1]
-
-			// Parse and validate the token
-			token, err := jwt.ParseWithClaims(tokenString, newClaims(), func(token *jwt.Token) (interface{}, error) {
-				// Validate the signing method
-				if token.Method != method {
-					return nil, service.ErrUnexpectedSigningMethod
-				}
-				return secret, nil
-			})
-			if err != nil {
-				if e, ok := err.(*jwt.ValidationError); ok {
-					switch {
-					case e.Errors&jwt.ValidationErrorMalformed != 0:
-						return nil, service.ErrTokenMalformed
-					case e.Errors&jwt.ValidationErrorExpired != 0:
-						return nil, service.ErrTokenExpired
-					case e.Errors&jwt.ValidationErrorNotValidYet != 0:
-						return nil, service.ErrTokenNotActive
-					case e.Inner != nil:
-						return nil, e.Inner
-					}
-				}
-				return nil, err
-			}
+// jwtInterceptor verifies the JWT in the Authorization header of every
+// request, unary or streaming, and adds its claims to the context.
+type jwtInterceptor struct {
+	keys      KeyProvider
+	methods   []string
+	newClaims service.ClaimsFactory
+	policy    service.ClaimsPolicy
+}
 
-			if !token.Valid {
-				return nil, service.ErrTokenInvalid
-			}
+// JWTMiddleware creates a Connect interceptor that extracts the JWT token from
+// the Authorization header and adds its claims to the context. Tokens must be
+// signed with one of methods, by a key that keys provides, and their claims
+// must satisfy policy. Authenticated procedures without a valid token are
+// rejected with CodeUnauthenticated.
+func JWTMiddleware(keys KeyProvider, methods []string, newClaims service.ClaimsFactory, policy service.ClaimsPolicy) connect.Interceptor {
+	return &jwtInterceptor{
+		keys:      keys,
+		methods:   methods,
+		newClaims: newClaims,
+		policy:    policy,
+	}
+}
+
+// WrapUnary implements connect.Interceptor.
+func (i *jwtInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx, err := i.authenticate(ctx, req.Spec().Procedure, req.Header())
+		if err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor. The server makes no
+// outgoing calls, so client streams are passed through unchanged.
+func (i *jwtInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor. The token is checked
+// once, when the stream is opened, for client, server and bidi streams alike.
+func (i *jwtInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, err := i.authenticate(ctx, conn.Spec().Procedure, conn.RequestHeader())
+		if err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+// authenticate verifies the request's token and returns a context carrying
+// its claims.
+func (i *jwtInterceptor) authenticate(ctx context.Context, procedure string, header http.Header) (context.Context, error) {
+	claims, err := parseToken(header.Get("Authorization"), i.keys, i.methods, i.newClaims, i.policy)
+	if err != nil {
+		// Public procedures work without claims, so a bad token only means
+		// the caller is treated as anonymous.
+		if publicProcedures[procedure] {
+			return ctx, nil
+		}
+		return nil, authError(err)
+	}
+
+	return service.ContextWithClaims(ctx, claims), nil
+}
+
+// parseToken verifies the Bearer token in an Authorization header and returns
+// its claims.
+func parseToken(auth string, keys KeyProvider, methods []string, newClaims service.ClaimsFactory, policy service.ClaimsPolicy) (jwt.Claims, error) {
+	if auth == "" {
+		return nil, service.ErrTokenMissing
+	}
 
-			// Add claims to context
-			ctx = context.WithValue(ctx, service.JWTClaimsContextKey, token.Claims)
+	// Check if it's a Bearer token
+	parts := strings.Split(auth, " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "Bearer") {
+		return nil, service.ErrTokenMissing
+	}
+	tokenString := parts[1]
 
-			return next(ctx, req)
+	// Parse and validate the token
+	token, err := jwt.ParseWithClaims(tokenString, newClaims(), func(token *jwt.Token) (interface{}, error) {
+		// Validate the signing method
+		if !slices.Contains(methods, token.Method.Alg()) {
+			return nil, service.ErrUnexpectedSigningMethod
 		}
+		return keys.Keyfunc(token)
+	})
+	if err != nil {
+		if e, ok := err.(*jwt.ValidationError); ok {
+			switch {
+			case e.Errors&jwt.ValidationErrorMalformed != 0:
+				return nil, service.ErrTokenMalformed
+			case e.Errors&jwt.ValidationErrorExpired != 0:
+				return nil, service.ErrTokenExpired
+			case e.Errors&jwt.ValidationErrorNotValidYet != 0:
+				return nil, service.ErrTokenNotActive
+			case errors.Is(e.Inner, service.ErrUnexpectedSigningMethod):
+				return nil, service.ErrUnexpectedSigningMethod
+			}
+		}
+		return nil, service.ErrTokenInvalid
+	}
+
+	if !token.Valid {
+		return nil, service.ErrTokenInvalid
+	}
+
+	if err := policy.Check(token.Claims); err != nil {
+		return nil, err
+	}
+
+	return token.Claims, nil
+}
+
+// authError converts a token error into a CodeUnauthenticated error whose
+// ErrorInfo reason tells the client why the token was rejected.
+func authError(err error) error {
+	reason, ok := authErrorReasons[err]
+	if !ok {
+		reason = authErrorReasons[service.ErrTokenInvalid]
+	}
+
+	ce := connect.NewError(connect.CodeUnauthenticated, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: authErrorDomain,
+	}); detailErr == nil {
+		ce.AddDetail(detail)
 	}
+	return ce
 }
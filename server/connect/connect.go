@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"kiseki"
 	"kiseki/api/v1"
 	"kiseki/api/v1/apiconnect"
 	"kiseki/service"
@@ -62,20 +63,52 @@ func (lrw *loggingResponseWriter) WriteHeader(code int) {
 	lrw.ResponseWriter.WriteHeader(code)
 }
 
+// Flush sends buffered data to the client. Connect needs it to stream
+// responses.
+func (lrw *loggingResponseWriter) Flush() {
+	if f, ok := lrw.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped http.ResponseWriter for http.ResponseController.
+func (lrw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
+
+// maxRequestSize is the largest request the server reads, leaving room for
+// the other fields of a document upload.
+const maxRequestSize = kiseki.MaxDocumentSize + 1<<20
+
 type handler struct {
 	service service.Service
 }
 
-func NewServer(svc service.Service, jwtSecret []byte) *http.Server {
+func NewServer(svc service.Service, keys KeyProvider, signingMethods []string, claimsPolicy service.ClaimsPolicy) *http.Server {
 	h := &handler{
 		service: svc,
 	}
 
+	newClaims := func() jwt.Claims { return &service.SupabaseClaims{} }
+
 	mux := http.NewServeMux()
-	path, handler := apiconnect.NewServiceHandler(h, connect.WithInterceptors(JWTMiddleware(jwtSecret, jwt.SigningMethodHS256, func() jwt.Claims { return &service.SupabaseClaims{} })))
+	path, handler := apiconnect.NewServiceHandler(h,
+		connect.WithInterceptors(
+			ErrorInterceptor(),
+			JWTMiddleware(keys, signingMethods, newClaims, claimsPolicy),
+			ValidationInterceptor(),
+		),
+		connect.WithReadMaxBytes(maxRequestSize),
+	)
 
 	mux.Handle(path, handler)
 
+	// Exports can also be downloaded as files
+	mux.Handle(exportPath, newExportHandler(svc, keys, signingMethods, newClaims, claimsPolicy))
+
+	// Unsubscribe links in emails open in a browser
+	mux.Handle(UnsubscribePath, newUnsubscribeHandler(svc))
+
 	// Health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -149,3 +182,319 @@ func (h *handler) UpdateJobApplicationStatus(ctx context.Context, req *connect.R
 	}
 	return connect.NewResponse(res), nil
 }
+
+// GetJobApplicationHistory implements apiconnect.ServiceHandler.
+func (h *handler) GetJobApplicationHistory(ctx context.Context, req *connect.Request[api.GetJobApplicationHistoryRequest]) (*connect.Response[api.GetJobApplicationHistoryResponse], error) {
+	res, err := h.service.GetJobApplicationHistory(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SearchJobApplications implements apiconnect.ServiceHandler.
+func (h *handler) SearchJobApplications(ctx context.Context, req *connect.Request[api.SearchJobApplicationsRequest]) (*connect.Response[api.SearchJobApplicationsResponse], error) {
+	res, err := h.service.SearchJobApplications(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetUserSettings implements apiconnect.ServiceHandler.
+func (h *handler) GetUserSettings(ctx context.Context, req *connect.Request[api.GetUserSettingsRequest]) (*connect.Response[api.GetUserSettingsResponse], error) {
+	res, err := h.service.GetUserSettings(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateUserSettings implements apiconnect.ServiceHandler.
+func (h *handler) UpdateUserSettings(ctx context.Context, req *connect.Request[api.UpdateUserSettingsRequest]) (*connect.Response[api.UpdateUserSettingsResponse], error) {
+	res, err := h.service.UpdateUserSettings(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListDeletedJobApplications implements apiconnect.ServiceHandler.
+func (h *handler) ListDeletedJobApplications(ctx context.Context, req *connect.Request[api.ListDeletedJobApplicationsRequest]) (*connect.Response[api.ListDeletedJobApplicationsResponse], error) {
+	res, err := h.service.ListDeletedJobApplications(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// RestoreJobApplication implements apiconnect.ServiceHandler.
+func (h *handler) RestoreJobApplication(ctx context.Context, req *connect.Request[api.RestoreJobApplicationRequest]) (*connect.Response[api.RestoreJobApplicationResponse], error) {
+	res, err := h.service.RestoreJobApplication(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// CreateInterview implements apiconnect.ServiceHandler.
+func (h *handler) CreateInterview(ctx context.Context, req *connect.Request[api.CreateInterviewRequest]) (*connect.Response[api.CreateInterviewResponse], error) {
+	res, err := h.service.CreateInterview(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListInterviews implements apiconnect.ServiceHandler.
+func (h *handler) ListInterviews(ctx context.Context, req *connect.Request[api.ListInterviewsRequest]) (*connect.Response[api.ListInterviewsResponse], error) {
+	res, err := h.service.ListInterviews(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateInterview implements apiconnect.ServiceHandler.
+func (h *handler) UpdateInterview(ctx context.Context, req *connect.Request[api.UpdateInterviewRequest]) (*connect.Response[api.UpdateInterviewResponse], error) {
+	res, err := h.service.UpdateInterview(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// DeleteInterview implements apiconnect.ServiceHandler.
+func (h *handler) DeleteInterview(ctx context.Context, req *connect.Request[api.DeleteInterviewRequest]) (*connect.Response[api.DeleteInterviewResponse], error) {
+	res, err := h.service.DeleteInterview(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListUpcomingInterviews implements apiconnect.ServiceHandler.
+func (h *handler) ListUpcomingInterviews(ctx context.Context, req *connect.Request[api.ListUpcomingInterviewsRequest]) (*connect.Response[api.ListUpcomingInterviewsResponse], error) {
+	res, err := h.service.ListUpcomingInterviews(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// WatchJobApplications implements apiconnect.ServiceHandler.
+func (h *handler) WatchJobApplications(ctx context.Context, req *connect.Request[api.WatchJobApplicationsRequest], stream *connect.ServerStream[api.WatchJobApplicationsResponse]) error {
+	return h.service.WatchJobApplications(ctx, req.Msg, stream.Send)
+}
+
+// UploadDocument implements apiconnect.ServiceHandler.
+func (h *handler) UploadDocument(ctx context.Context, req *connect.Request[api.UploadDocumentRequest]) (*connect.Response[api.UploadDocumentResponse], error) {
+	res, err := h.service.UploadDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetDocument implements apiconnect.ServiceHandler.
+func (h *handler) GetDocument(ctx context.Context, req *connect.Request[api.GetDocumentRequest]) (*connect.Response[api.GetDocumentResponse], error) {
+	res, err := h.service.GetDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// DownloadDocument implements apiconnect.ServiceHandler.
+func (h *handler) DownloadDocument(ctx context.Context, req *connect.Request[api.DownloadDocumentRequest]) (*connect.Response[api.DownloadDocumentResponse], error) {
+	res, err := h.service.DownloadDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// DeleteDocument implements apiconnect.ServiceHandler.
+func (h *handler) DeleteDocument(ctx context.Context, req *connect.Request[api.DeleteDocumentRequest]) (*connect.Response[api.DeleteDocumentResponse], error) {
+	res, err := h.service.DeleteDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateDocument implements apiconnect.ServiceHandler.
+func (h *handler) UpdateDocument(ctx context.Context, req *connect.Request[api.UpdateDocumentRequest]) (*connect.Response[api.UpdateDocumentResponse], error) {
+	res, err := h.service.UpdateDocument(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListDocuments implements apiconnect.ServiceHandler.
+func (h *handler) ListDocuments(ctx context.Context, req *connect.Request[api.ListDocumentsRequest]) (*connect.Response[api.ListDocumentsResponse], error) {
+	res, err := h.service.ListDocuments(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SearchDocuments implements apiconnect.ServiceHandler.
+func (h *handler) SearchDocuments(ctx context.Context, req *connect.Request[api.SearchDocumentsRequest]) (*connect.Response[api.SearchDocumentsResponse], error) {
+	res, err := h.service.SearchDocuments(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ScoreJobApplication implements apiconnect.ServiceHandler.
+func (h *handler) ScoreJobApplication(ctx context.Context, req *connect.Request[api.ScoreJobApplicationRequest]) (*connect.Response[api.ScoreJobApplicationResponse], error) {
+	res, err := h.service.ScoreJobApplication(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ImportJobApplications implements apiconnect.ServiceHandler.
+func (h *handler) ImportJobApplications(ctx context.Context, req *connect.Request[api.ImportJobApplicationsRequest]) (*connect.Response[api.ImportJobApplicationsResponse], error) {
+	res, err := h.service.ImportJobApplications(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ExportJobApplications implements apiconnect.ServiceHandler.
+func (h *handler) ExportJobApplications(ctx context.Context, req *connect.Request[api.ExportJobApplicationsRequest], stream *connect.ServerStream[api.ExportJobApplicationsResponse]) error {
+	return h.service.ExportJobApplications(ctx, req.Msg, stream.Send)
+}
+
+// GetPipelineStats implements apiconnect.ServiceHandler.
+func (h *handler) GetPipelineStats(ctx context.Context, req *connect.Request[api.GetPipelineStatsRequest]) (*connect.Response[api.GetPipelineStatsResponse], error) {
+	res, err := h.service.GetPipelineStats(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListDueFollowUps implements apiconnect.ServiceHandler.
+func (h *handler) ListDueFollowUps(ctx context.Context, req *connect.Request[api.ListDueFollowUpsRequest]) (*connect.Response[api.ListDueFollowUpsResponse], error) {
+	res, err := h.service.ListDueFollowUps(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Unsubscribe implements apiconnect.ServiceHandler.
+func (h *handler) Unsubscribe(ctx context.Context, req *connect.Request[api.UnsubscribeRequest]) (*connect.Response[api.UnsubscribeResponse], error) {
+	res, err := h.service.Unsubscribe(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// CreateCompany implements apiconnect.ServiceHandler.
+func (h *handler) CreateCompany(ctx context.Context, req *connect.Request[api.CreateCompanyRequest]) (*connect.Response[api.CreateCompanyResponse], error) {
+	res, err := h.service.CreateCompany(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateCompany implements apiconnect.ServiceHandler.
+func (h *handler) UpdateCompany(ctx context.Context, req *connect.Request[api.UpdateCompanyRequest]) (*connect.Response[api.UpdateCompanyResponse], error) {
+	res, err := h.service.UpdateCompany(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListCompanies implements apiconnect.ServiceHandler.
+func (h *handler) ListCompanies(ctx context.Context, req *connect.Request[api.ListCompaniesRequest]) (*connect.Response[api.ListCompaniesResponse], error) {
+	res, err := h.service.ListCompanies(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// CreateContact implements apiconnect.ServiceHandler.
+func (h *handler) CreateContact(ctx context.Context, req *connect.Request[api.CreateContactRequest]) (*connect.Response[api.CreateContactResponse], error) {
+	res, err := h.service.CreateContact(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetContact implements apiconnect.ServiceHandler.
+func (h *handler) GetContact(ctx context.Context, req *connect.Request[api.GetContactRequest]) (*connect.Response[api.GetContactResponse], error) {
+	res, err := h.service.GetContact(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UpdateContact implements apiconnect.ServiceHandler.
+func (h *handler) UpdateContact(ctx context.Context, req *connect.Request[api.UpdateContactRequest]) (*connect.Response[api.UpdateContactResponse], error) {
+	res, err := h.service.UpdateContact(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// DeleteContact implements apiconnect.ServiceHandler.
+func (h *handler) DeleteContact(ctx context.Context, req *connect.Request[api.DeleteContactRequest]) (*connect.Response[api.DeleteContactResponse], error) {
+	res, err := h.service.DeleteContact(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// ListContacts implements apiconnect.ServiceHandler.
+func (h *handler) ListContacts(ctx context.Context, req *connect.Request[api.ListContactsRequest]) (*connect.Response[api.ListContactsResponse], error) {
+	res, err := h.service.ListContacts(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// LinkContact implements apiconnect.ServiceHandler.
+func (h *handler) LinkContact(ctx context.Context, req *connect.Request[api.LinkContactRequest]) (*connect.Response[api.LinkContactResponse], error) {
+	res, err := h.service.LinkContact(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// UnlinkContact implements apiconnect.ServiceHandler.
+func (h *handler) UnlinkContact(ctx context.Context, req *connect.Request[api.UnlinkContactRequest]) (*connect.Response[api.UnlinkContactResponse], error) {
+	res, err := h.service.UnlinkContact(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetContactTimeline implements apiconnect.ServiceHandler.
+func (h *handler) GetContactTimeline(ctx context.Context, req *connect.Request[api.GetContactTimelineRequest]) (*connect.Response[api.GetContactTimelineResponse], error) {
+	res, err := h.service.GetContactTimeline(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,72 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// errorInterceptor converts the errors of unary and streaming handlers.
+type errorInterceptor struct{}
+
+// ErrorInterceptor converts gRPC status errors returned by the service layer
+// into Connect errors so their code and details reach the client. Without it
+// Connect reports them as CodeUnknown.
+func ErrorInterceptor() connect.Interceptor {
+	return errorInterceptor{}
+}
+
+// WrapUnary implements connect.Interceptor.
+func (errorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+		return res, nil
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor.
+func (errorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor.
+func (errorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := next(ctx, conn); err != nil {
+			return toConnectError(err)
+		}
+		return nil
+	}
+}
+
+// toConnectError converts err to a *connect.Error if it carries a gRPC status.
+func toConnectError(err error) error {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return err
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	ce := connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	for _, d := range st.Details() {
+		msg, ok := d.(proto.Message)
+		if !ok {
+			continue
+		}
+		if detail, err := connect.NewErrorDetail(msg); err == nil {
+			ce.AddDetail(detail)
+		}
+	}
+
+	return ce
+}
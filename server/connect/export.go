@@ -0,0 +1,98 @@
+package connect
+
+import (
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"kiseki/api/v1"
+	"kiseki/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportPath is where exports are downloaded from, e.g.
+// /export/job-applications?format=csv.
+const exportPath = "/export/job-applications"
+
+// exportFormats maps the format query parameter to export formats.
+var exportFormats = map[string]api.ExportFormat{
+	"csv":    api.ExportFormat_EXPORT_FORMAT_CSV,
+	"ndjson": api.ExportFormat_EXPORT_FORMAT_NDJSON,
+	"ics":    api.ExportFormat_EXPORT_FORMAT_ICS,
+}
+
+// exportHandler serves exports as plain file downloads, for browsers and
+// tools that cannot read the ExportJobApplications stream. Requests carry a
+// token in the Authorization header, the same as RPCs.
+type exportHandler struct {
+	service   service.Service
+	keys      KeyProvider
+	methods   []string
+	newClaims service.ClaimsFactory
+	policy    service.ClaimsPolicy
+}
+
+// newExportHandler returns the handler for exportPath, verifying tokens the
+// same way JWTMiddleware does.
+func newExportHandler(svc service.Service, keys KeyProvider, methods []string, newClaims service.ClaimsFactory, policy service.ClaimsPolicy) http.Handler {
+	return &exportHandler{
+		service:   svc,
+		keys:      keys,
+		methods:   methods,
+		newClaims: newClaims,
+		policy:    policy,
+	}
+}
+
+func (h *exportHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	claims, err := parseToken(r.Header.Get("Authorization"), h.keys, h.methods, h.newClaims, h.policy)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	format, ok := exportFormats[strings.ToLower(r.URL.Query().Get("format"))]
+	if !ok {
+		http.Error(w, "format must be csv, ndjson or ics", http.StatusBadRequest)
+		return
+	}
+
+	// Headers can only be set before the first chunk is written; after that
+	// an error can only cut the download short.
+	started := false
+	ctx := service.ContextWithClaims(r.Context(), claims)
+	err = h.service.ExportJobApplications(ctx, &api.ExportJobApplicationsRequest{Format: format}, func(res *api.ExportJobApplicationsResponse) error {
+		if !started {
+			started = true
+			w.Header().Set("Content-Type", res.ContentType)
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": res.FileName}))
+			w.Header().Set("Cache-Control", "no-store")
+		}
+		_, err := w.Write(res.Data)
+		return err
+	})
+	if err == nil {
+		return
+	}
+
+	if started {
+		log.Printf("Export cut short: %v", err)
+		return
+	}
+	st, _ := status.FromError(err)
+	if st.Code() == codes.InvalidArgument {
+		http.Error(w, st.Message(), http.StatusBadRequest)
+		return
+	}
+	log.Printf("Export failed: %v", err)
+	http.Error(w, "export failed", http.StatusInternalServerError)
+}
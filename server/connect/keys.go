@@ -0,0 +1,181 @@
+package connect
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MicahParks/keyfunc"
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const (
+	// jwksRefreshRateLimit is the shortest time between two fetches of a
+	// remote JWKS. Tokens with an unknown kid trigger a refresh, so without a
+	// limit anyone could make the server hammer the JWKS endpoint.
+	jwksRefreshRateLimit = time.Minute
+
+	// jwksRefreshTimeout bounds a single fetch of a remote JWKS.
+	jwksRefreshTimeout = 10 * time.Second
+)
+
+// ErrNoVerificationKey denotes that no key provider has a key for a token.
+var ErrNoVerificationKey = errors.New("no key to verify the JWT with")
+
+// KeyProvider supplies the key that verifies a token's signature. It is
+// satisfied by *keyfunc.JWKS.
+type KeyProvider interface {
+	Keyfunc(token *jwt.Token) (interface{}, error)
+}
+
+// NewJWKSKeyProvider returns a KeyProvider serving the keys published at a
+// JWKS URL. Keys are cached and refetched every refreshInterval, and as soon
+// as a token names a kid the cache does not know, so rotated keys are picked
+// up without a restart.
+func NewJWKSKeyProvider(url string, refreshInterval time.Duration) (*keyfunc.JWKS, error) {
+	return keyfunc.Get(url, keyfunc.Options{
+		RefreshInterval:   refreshInterval,
+		RefreshRateLimit:  jwksRefreshRateLimit,
+		RefreshTimeout:    jwksRefreshTimeout,
+		RefreshUnknownKID: true,
+		RefreshErrorHandler: func(err error) {
+			log.Printf("Failed to refresh JWKS from %s: %v", url, err)
+		},
+	})
+}
+
+// fileKeyProvider serves the keys of a JWKS file, reloading it when the file
+// changes.
+type fileKeyProvider struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	jwks    *keyfunc.JWKS
+}
+
+// NewJWKSFileKeyProvider returns a KeyProvider serving the keys in a local
+// JWKS file. The file is reloaded whenever its modification time changes.
+func NewJWKSFileKeyProvider(path string) (KeyProvider, error) {
+	p := &fileKeyProvider{path: path}
+	if _, err := p.load(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Keyfunc implements KeyProvider.
+func (p *fileKeyProvider) Keyfunc(token *jwt.Token) (interface{}, error) {
+	jwks, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+	return jwks.Keyfunc(token)
+}
+
+// load returns the keys in the file, reading it again if it has changed since
+// it was last read.
+func (p *fileKeyProvider) load() (*keyfunc.JWKS, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		if p.jwks != nil {
+			// Keep serving the last good keys while the file is replaced.
+			return p.jwks, nil
+		}
+		return nil, err
+	}
+	if p.jwks != nil && info.ModTime().Equal(p.modTime) {
+		return p.jwks, nil
+	}
+
+	b, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, err
+	}
+	jwks, err := keyfunc.NewJSON(b)
+	if err != nil {
+		if p.jwks != nil {
+			log.Printf("Failed to reload JWKS from %s: %v", p.path, err)
+			return p.jwks, nil
+		}
+		return nil, fmt.Errorf("parse JWKS %s: %w", p.path, err)
+	}
+
+	p.jwks = jwks
+	p.modTime = info.ModTime()
+	return jwks, nil
+}
+
+// secretKeyProvider verifies HMAC-signed tokens with a shared secret.
+type secretKeyProvider []byte
+
+// NewSecretKeyProvider returns a KeyProvider that verifies HMAC-signed tokens
+// with secret, as Supabase projects using a legacy JWT secret sign them.
+func NewSecretKeyProvider(secret []byte) KeyProvider {
+	return secretKeyProvider(secret)
+}
+
+// Keyfunc implements KeyProvider.
+func (p secretKeyProvider) Keyfunc(token *jwt.Token) (interface{}, error) {
+	// Never hand the secret out for other algorithms.
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+		return nil, ErrNoVerificationKey
+	}
+	return []byte(p), nil
+}
+
+// keyProviders tries each of its providers in turn.
+type keyProviders []KeyProvider
+
+// NewKeyProviders returns a KeyProvider that asks each provider in turn and
+// returns the first key found. This lets the server accept tokens signed with
+// a legacy secret while it migrates to asymmetric keys.
+func NewKeyProviders(providers ...KeyProvider) KeyProvider {
+	if len(providers) == 1 {
+		return providers[0]
+	}
+	return keyProviders(providers)
+}
+
+// Keyfunc implements KeyProvider.
+func (p keyProviders) Keyfunc(token *jwt.Token) (interface{}, error) {
+	var errs []error
+	for _, provider := range p {
+		key, err := provider.Keyfunc(token)
+		if err == nil {
+			return key, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, ErrNoVerificationKey
+	}
+	return nil, errors.Join(errs...)
+}
+
+// ParseSigningMethods parses a comma-separated list of JWT algorithm names,
+// such as "HS256,RS256,ES256".
+func ParseSigningMethods(s string) ([]string, error) {
+	var methods []string
+	for _, alg := range strings.Split(s, ",") {
+		alg = strings.TrimSpace(alg)
+		if alg == "" {
+			continue
+		}
+		if jwt.GetSigningMethod(alg) == nil || alg == jwt.SigningMethodNone.Alg() {
+			return nil, fmt.Errorf("unsupported JWT algorithm %q", alg)
+		}
+		methods = append(methods, alg)
+	}
+	if len(methods) == 0 {
+		return nil, errors.New("no JWT algorithms given")
+	}
+	return methods, nil
+}
@@ -0,0 +1,432 @@
+package connect
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"kiseki/service"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// testSecret is the HMAC secret the tests sign legacy tokens with.
+var testSecret = []byte("test-secret-with-at-least-32-characters")
+
+// allMethods are the algorithms the tests allow unless they check that one
+// is rejected.
+var allMethods = []string{"HS256", "RS256", "ES256"}
+
+// signingKey is a private key the tests sign tokens with, and the kid of its
+// public half in a JWKS.
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private interface{}
+	jwk     map[string]string
+}
+
+func newRSAKey(t *testing.T, kid string) *signingKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &signingKey{
+		kid:     kid,
+		method:  jwt.SigningMethodRS256,
+		private: key,
+		jwk: map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"alg": "RS256",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		},
+	}
+}
+
+func newECKey(t *testing.T, kid string) *signingKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &signingKey{
+		kid:     kid,
+		method:  jwt.SigningMethodES256,
+		private: key,
+		jwk: map[string]string{
+			"kty": "EC",
+			"kid": kid,
+			"alg": "ES256",
+			"use": "sig",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		},
+	}
+}
+
+// sign returns a token for a user signed by k, naming kid in its header.
+func (k *signingKey) sign(t *testing.T, kid string) string {
+	t.Helper()
+	return signToken(t, k.method, kid, k.private)
+}
+
+func signToken(t *testing.T, method jwt.SigningMethod, kid string, key interface{}) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, &service.SupabaseClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Role: "authenticated",
+	})
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	s, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func jwksJSON(t *testing.T, keys ...*signingKey) []byte {
+	t.Helper()
+	set := struct {
+		Keys []map[string]string `json:"keys"`
+	}{Keys: []map[string]string{}}
+	for _, k := range keys {
+		set.Keys = append(set.Keys, k.jwk)
+	}
+	b, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// jwksServer serves a JWKS that tests can replace, counting the fetches.
+type jwksServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	body    []byte
+	fetches atomic.Int32
+}
+
+func newJWKSServer(t *testing.T, keys ...*signingKey) *jwksServer {
+	t.Helper()
+	s := &jwksServer{body: jwksJSON(t, keys...)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.fetches.Add(1)
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(s.body)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) publish(t *testing.T, keys ...*signingKey) {
+	t.Helper()
+	b := jwksJSON(t, keys...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.body = b
+}
+
+func newTestJWKSKeyProvider(t *testing.T, url string) KeyProvider {
+	t.Helper()
+	jwks, err := NewJWKSKeyProvider(url, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(jwks.EndBackground)
+	return jwks
+}
+
+// verify parses token the way the JWT interceptor does.
+func verify(keys KeyProvider, methods []string, token string) error {
+	newClaims := func() jwt.Claims { return &service.SupabaseClaims{} }
+	_, err := parseToken("Bearer "+token, keys, methods, newClaims, service.ClaimsPolicy{})
+	return err
+}
+
+func TestJWKSKeyProviderSelectsKeyByKID(t *testing.T) {
+	rsaKey := newRSAKey(t, "rsa-1")
+	ecKey := newECKey(t, "ec-1")
+	server := newJWKSServer(t, rsaKey, ecKey)
+	keys := newTestJWKSKeyProvider(t, server.URL)
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"rsa key", rsaKey.sign(t, "rsa-1"), nil},
+		{"ec key", ecKey.sign(t, "ec-1"), nil},
+		{"kid of another key", rsaKey.sign(t, "ec-1"), service.ErrTokenInvalid},
+		{"unknown kid", rsaKey.sign(t, "rsa-2"), service.ErrTokenInvalid},
+		{"no kid", rsaKey.sign(t, ""), service.ErrTokenInvalid},
+		{"key not in the set", newRSAKey(t, "rsa-1").sign(t, "rsa-1"), service.ErrTokenInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verify(keys, allMethods, tt.token); err != tt.want {
+				t.Errorf("verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestJWKSKeyProviderRefreshesAfterRotation(t *testing.T) {
+	oldKey := newRSAKey(t, "key-1")
+	newKey := newRSAKey(t, "key-2")
+	server := newJWKSServer(t, oldKey)
+	keys := newTestJWKSKeyProvider(t, server.URL)
+
+	if err := verify(keys, allMethods, oldKey.sign(t, "key-1")); err != nil {
+		t.Fatalf("old key before rotation: %v", err)
+	}
+	if n := server.fetches.Load(); n != 1 {
+		t.Fatalf("fetches before rotation = %d, want 1", n)
+	}
+
+	server.publish(t, newKey)
+
+	// The first token with the new kid makes the provider fetch the JWKS
+	// again, without waiting for the refresh interval.
+	if err := verify(keys, allMethods, newKey.sign(t, "key-2")); err != nil {
+		t.Fatalf("new key after rotation: %v", err)
+	}
+	if n := server.fetches.Load(); n != 2 {
+		t.Fatalf("fetches after rotation = %d, want 2", n)
+	}
+
+	// The retired key is gone, and asking for it again is rate limited.
+	if err := verify(keys, allMethods, oldKey.sign(t, "key-1")); err != service.ErrTokenInvalid {
+		t.Errorf("old key after rotation: verify() = %v, want %v", err, service.ErrTokenInvalid)
+	}
+	if err := verify(keys, allMethods, newRSAKey(t, "key-3").sign(t, "key-3")); err != service.ErrTokenInvalid {
+		t.Errorf("unknown key after rotation: verify() = %v, want %v", err, service.ErrTokenInvalid)
+	}
+	if n := server.fetches.Load(); n != 2 {
+		t.Errorf("fetches within the rate limit = %d, want 2", n)
+	}
+}
+
+func TestParseTokenRejectsMethodsNotAllowed(t *testing.T) {
+	rsaKey := newRSAKey(t, "rsa-1")
+	ecKey := newECKey(t, "ec-1")
+	server := newJWKSServer(t, rsaKey, ecKey)
+	keys := NewKeyProviders(newTestJWKSKeyProvider(t, server.URL), NewSecretKeyProvider(testSecret))
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&rsaKey.private.(*rsa.PrivateKey).PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, &service.SupabaseClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1"},
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		methods []string
+		token   string
+		want    error
+	}{
+		{"hmac when only rsa is allowed", []string{"RS256"}, signToken(t, jwt.SigningMethodHS256, "", testSecret), service.ErrUnexpectedSigningMethod},
+		{"ec when only rsa is allowed", []string{"RS256"}, ecKey.sign(t, "ec-1"), service.ErrUnexpectedSigningMethod},
+		{"rsa when only hmac is allowed", []string{"HS256"}, rsaKey.sign(t, "rsa-1"), service.ErrUnexpectedSigningMethod},
+		{"rs512 with an rs256 key", allMethods, signToken(t, jwt.SigningMethodRS512, "rsa-1", rsaKey.private), service.ErrUnexpectedSigningMethod},
+		{"none", allMethods, unsigned, service.ErrUnexpectedSigningMethod},
+		// A token signed with HMAC, using the public RSA key as the secret,
+		// must not be verified with that key.
+		{"hmac with the rsa public key", allMethods, signToken(t, jwt.SigningMethodHS256, "rsa-1", publicPEM), service.ErrTokenInvalid},
+		{"allowed", []string{"RS256"}, rsaKey.sign(t, "rsa-1"), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verify(keys, tt.methods, tt.token); err != tt.want {
+				t.Errorf("verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSigningMethods(t *testing.T) {
+	methods, err := ParseSigningMethods(" HS256, RS256,,ES256 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(methods) != 3 || methods[0] != "HS256" || methods[1] != "RS256" || methods[2] != "ES256" {
+		t.Errorf("ParseSigningMethods() = %v", methods)
+	}
+
+	for _, s := range []string{"", " , ", "none", "HS256,none", "XX999"} {
+		if _, err := ParseSigningMethods(s); err == nil {
+			t.Errorf("ParseSigningMethods(%q) succeeded, want an error", s)
+		}
+	}
+}
+
+func TestJWKSFileKeyProvider(t *testing.T) {
+	oldKey := newRSAKey(t, "key-1")
+	newKey := newECKey(t, "key-2")
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwksJSON(t, oldKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := NewJWKSFileKeyProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(keys, allMethods, oldKey.sign(t, "key-1")); err != nil {
+		t.Fatalf("key in the file: %v", err)
+	}
+	if err := verify(keys, allMethods, newKey.sign(t, "key-2")); err != service.ErrTokenInvalid {
+		t.Fatalf("key not in the file yet: verify() = %v, want %v", err, service.ErrTokenInvalid)
+	}
+
+	// Rotate the key in the file. Its modification time is moved on
+	// explicitly, as the rewrite may land within the file system's timestamp
+	// resolution.
+	if err := os.WriteFile(path, jwksJSON(t, newKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(keys, allMethods, newKey.sign(t, "key-2")); err != nil {
+		t.Fatalf("key after rotation: %v", err)
+	}
+	if err := verify(keys, allMethods, oldKey.sign(t, "key-1")); err != service.ErrTokenInvalid {
+		t.Fatalf("retired key: verify() = %v, want %v", err, service.ErrTokenInvalid)
+	}
+
+	// A broken or missing file keeps the last good keys.
+	if err := os.WriteFile(path, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	later = later.Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(keys, allMethods, newKey.sign(t, "key-2")); err != nil {
+		t.Errorf("after a broken rewrite: %v", err)
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(keys, allMethods, newKey.sign(t, "key-2")); err != nil {
+		t.Errorf("after the file is removed: %v", err)
+	}
+}
+
+func TestNewJWKSFileKeyProviderFailsWithoutKeys(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := NewJWKSFileKeyProvider(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("missing file: want an error")
+	}
+
+	path := filepath.Join(dir, "broken.json")
+	if err := os.WriteFile(path, []byte("not json"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewJWKSFileKeyProvider(path); err == nil {
+		t.Error("broken file: want an error")
+	}
+}
+
+func TestSecretKeyProvider(t *testing.T) {
+	keys := NewSecretKeyProvider(testSecret)
+
+	if err := verify(keys, allMethods, signToken(t, jwt.SigningMethodHS256, "", testSecret)); err != nil {
+		t.Errorf("signed with the secret: %v", err)
+	}
+	if err := verify(keys, allMethods, signToken(t, jwt.SigningMethodHS256, "", []byte("another-secret-with-at-least-32-characters"))); err != service.ErrTokenInvalid {
+		t.Errorf("signed with another secret: verify() = %v, want %v", err, service.ErrTokenInvalid)
+	}
+
+	// The secret is never handed out to check an asymmetric signature.
+	token, _, err := new(jwt.Parser).ParseUnverified(newRSAKey(t, "rsa-1").sign(t, "rsa-1"), &service.SupabaseClaims{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key, err := keys.Keyfunc(token); err != ErrNoVerificationKey {
+		t.Errorf("Keyfunc(RS256) = %v, %v, want %v", key, err, ErrNoVerificationKey)
+	}
+}
+
+// TestKeyProviders checks the providers as cmd/main.go combines them: a
+// remote JWKS, a JWKS file and the legacy secret, each accepting its own
+// tokens.
+func TestKeyProviders(t *testing.T) {
+	remoteKey := newRSAKey(t, "remote-1")
+	fileKey := newECKey(t, "file-1")
+	server := newJWKSServer(t, remoteKey)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, jwksJSON(t, fileKey), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	fileKeys, err := NewJWKSFileKeyProvider(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys := NewKeyProviders(
+		newTestJWKSKeyProvider(t, server.URL),
+		fileKeys,
+		NewSecretKeyProvider(testSecret),
+	)
+
+	tests := []struct {
+		name  string
+		token string
+		want  error
+	}{
+		{"remote jwks", remoteKey.sign(t, "remote-1"), nil},
+		{"jwks file", fileKey.sign(t, "file-1"), nil},
+		{"secret", signToken(t, jwt.SigningMethodHS256, "", testSecret), nil},
+		{"unknown key", newRSAKey(t, "other").sign(t, "other"), service.ErrTokenInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verify(keys, allMethods, tt.token); err != tt.want {
+				t.Errorf("verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if keys := NewKeyProviders(); keys == nil {
+		t.Fatal("NewKeyProviders() = nil")
+	} else if _, err := keys.Keyfunc(&jwt.Token{Method: jwt.SigningMethodHS256}); err != ErrNoVerificationKey {
+		t.Errorf("no providers: Keyfunc() error = %v, want %v", err, ErrNoVerificationKey)
+	}
+}
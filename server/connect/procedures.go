@@ -0,0 +1,72 @@
+package connect
+
+import (
+	"fmt"
+
+	"kiseki/api/v1"
+	"kiseki/api/v1/apiconnect"
+)
+
+// publicProcedures can be called without a token. A valid token is still
+// verified and its claims added to the context.
+var publicProcedures = map[string]bool{
+	// Unsubscribe links in emails must work without signing in.
+	apiconnect.ServiceUnsubscribeProcedure: true,
+}
+
+// authenticatedProcedures are rejected with CodeUnauthenticated unless they
+// carry a valid token.
+var authenticatedProcedures = map[string]bool{
+	apiconnect.ServiceCreateJobApplicationProcedure:       true,
+	apiconnect.ServiceListJobApplicationsProcedure:        true,
+	apiconnect.ServiceUpdateJobApplicationProcedure:       true,
+	apiconnect.ServiceDeleteJobApplicationProcedure:       true,
+	apiconnect.ServiceUpdateJobApplicationStatusProcedure: true,
+	apiconnect.ServiceGetJobApplicationHistoryProcedure:   true,
+	apiconnect.ServiceSearchJobApplicationsProcedure:      true,
+	apiconnect.ServiceGetUserSettingsProcedure:            true,
+	apiconnect.ServiceUpdateUserSettingsProcedure:         true,
+	apiconnect.ServiceListDeletedJobApplicationsProcedure: true,
+	apiconnect.ServiceRestoreJobApplicationProcedure:      true,
+	apiconnect.ServiceCreateInterviewProcedure:            true,
+	apiconnect.ServiceListInterviewsProcedure:             true,
+	apiconnect.ServiceUpdateInterviewProcedure:            true,
+	apiconnect.ServiceDeleteInterviewProcedure:            true,
+	apiconnect.ServiceListUpcomingInterviewsProcedure:     true,
+	apiconnect.ServiceWatchJobApplicationsProcedure:       true,
+	apiconnect.ServiceUploadDocumentProcedure:             true,
+	apiconnect.ServiceGetDocumentProcedure:                true,
+	apiconnect.ServiceDownloadDocumentProcedure:           true,
+	apiconnect.ServiceDeleteDocumentProcedure:             true,
+	apiconnect.ServiceUpdateDocumentProcedure:             true,
+	apiconnect.ServiceListDocumentsProcedure:              true,
+	apiconnect.ServiceSearchDocumentsProcedure:            true,
+	apiconnect.ServiceScoreJobApplicationProcedure:        true,
+	apiconnect.ServiceImportJobApplicationsProcedure:      true,
+	apiconnect.ServiceExportJobApplicationsProcedure:      true,
+	apiconnect.ServiceGetPipelineStatsProcedure:           true,
+	apiconnect.ServiceListDueFollowUpsProcedure:           true,
+	apiconnect.ServiceCreateCompanyProcedure:              true,
+	apiconnect.ServiceUpdateCompanyProcedure:              true,
+	apiconnect.ServiceListCompaniesProcedure:              true,
+	apiconnect.ServiceCreateContactProcedure:              true,
+	apiconnect.ServiceGetContactProcedure:                 true,
+	apiconnect.ServiceUpdateContactProcedure:              true,
+	apiconnect.ServiceDeleteContactProcedure:              true,
+	apiconnect.ServiceListContactsProcedure:               true,
+	apiconnect.ServiceLinkContactProcedure:                true,
+	apiconnect.ServiceUnlinkContactProcedure:              true,
+	apiconnect.ServiceGetContactTimelineProcedure:         true,
+}
+
+// init checks that every procedure of the service is listed as either public
+// or authenticated, so a new RPC cannot be exposed without a decision.
+func init() {
+	methods := api.File_api_v1_api_proto.Services().ByName("Service").Methods()
+	for i := range methods.Len() {
+		procedure := fmt.Sprintf("/%s/%s", apiconnect.ServiceName, methods.Get(i).Name())
+		if publicProcedures[procedure] == authenticatedProcedures[procedure] {
+			panic(fmt.Sprintf("connect: procedure %s must be listed as either public or authenticated", procedure))
+		}
+	}
+}
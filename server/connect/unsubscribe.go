@@ -0,0 +1,90 @@
+package connect
+
+import (
+	"html/template"
+	"log"
+	"net/http"
+
+	"kiseki/api/v1"
+	"kiseki/service"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// UnsubscribePath is where the unsubscribe links in emails point, e.g.
+// /unsubscribe?token=....
+const UnsubscribePath = "/unsubscribe"
+
+// maxUnsubscribeSize caps the size of an unsubscribe form.
+const maxUnsubscribeSize = 4 << 10
+
+// unsubscribePage asks to confirm unsubscribing, or says it is done. Link
+// checkers in mail clients follow links, so opening the page must not
+// unsubscribe on its own.
+var unsubscribePage = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Unsubscribe</title></head>
+<body>
+{{- if .Done }}
+<h2>You are unsubscribed</h2>
+<p>You will no longer get emails about your job applications. You can turn them back on in your settings.</p>
+{{- else }}
+<h2>Unsubscribe</h2>
+<p>Stop getting emails about your job applications?</p>
+<form method="post"><input type="hidden" name="token" value="{{ .Token }}"><button type="submit">Unsubscribe</button></form>
+{{- end }}
+</body>
+</html>
+`))
+
+// unsubscribeHandler lets users unsubscribe from a link in an email without
+// signing in. It also takes the one-click POSTs mail clients send to the
+// List-Unsubscribe URL.
+type unsubscribeHandler struct {
+	service service.Service
+}
+
+func newUnsubscribeHandler(svc service.Service) http.Handler {
+	return &unsubscribeHandler{service: svc}
+}
+
+func (h *unsubscribeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+
+	switch r.Method {
+	case http.MethodGet:
+		h.render(w, r.URL.Query().Get("token"), false)
+	case http.MethodPost:
+		r.Body = http.MaxBytesReader(w, r.Body, maxUnsubscribeSize)
+		token := r.FormValue("token")
+		if token == "" {
+			http.Error(w, "unsubscribe link not recognised", http.StatusNotFound)
+			return
+		}
+		_, err := h.service.Unsubscribe(r.Context(), &api.UnsubscribeRequest{Token: token})
+		if status.Code(err) == codes.NotFound {
+			http.Error(w, "unsubscribe link not recognised", http.StatusNotFound)
+			return
+		}
+		if err != nil {
+			log.Printf("Unsubscribe failed: %v", err)
+			http.Error(w, "unsubscribe failed", http.StatusInternalServerError)
+			return
+		}
+		h.render(w, "", true)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *unsubscribeHandler) render(w http.ResponseWriter, token string, done bool) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := unsubscribePage.Execute(w, struct {
+		Token string
+		Done  bool
+	}{token, done}); err != nil {
+		log.Printf("Failed to render unsubscribe page: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package connect
+
+import (
+	"context"
+	"errors"
+
+	"kiseki/validation"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// validationInterceptor validates the requests of unary and streaming
+// handlers.
+type validationInterceptor struct{}
+
+// ValidationInterceptor rejects requests that break the rules in the
+// validation package with CodeInvalidArgument. The error carries an
+// errdetails.BadRequest listing every field violation, so clients can show
+// each problem next to its input.
+func ValidationInterceptor() connect.Interceptor {
+	return validationInterceptor{}
+}
+
+// WrapUnary implements connect.Interceptor.
+func (validationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := validate(req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient implements connect.Interceptor.
+func (validationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+// WrapStreamingHandler implements connect.Interceptor. Each message is
+// validated as the handler receives it.
+func (validationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingConn{StreamingHandlerConn: conn})
+	}
+}
+
+// validatingConn validates the messages received on a stream.
+type validatingConn struct {
+	connect.StreamingHandlerConn
+}
+
+// Receive implements connect.StreamingHandlerConn.
+func (c *validatingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return validate(msg)
+}
+
+// validate returns a CodeInvalidArgument error if msg breaks its rules.
+func validate(msg any) error {
+	m, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	violations := validation.Validate(m)
+	if len(violations) == 0 {
+		return nil
+	}
+
+	err := connect.NewError(connect.CodeInvalidArgument, errors.New("invalid request"))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{FieldViolations: violations}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
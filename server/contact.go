@@ -0,0 +1,92 @@
+package kiseki
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Contact is a person met during a job search, such as a recruiter or a
+// hiring manager. Contacts link to the job applications they are involved
+// in, many to many.
+type Contact struct {
+	ID     string
+	UserID string
+	Name   string
+	// Role is the contact's job, e.g. "Technical recruiter".
+	Role        *string
+	Email       *string
+	Phone       *string
+	LinkedInURL *string
+	// CompanyID is the company the contact works for, if known.
+	CompanyID *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NewContactParams struct {
+	UserID      string
+	Name        string
+	Role        *string
+	Email       *string
+	Phone       *string
+	LinkedInURL *string
+	CompanyID   *string
+}
+
+func NewContact(params NewContactParams) Contact {
+	now := time.Now()
+	return Contact{
+		ID:          uuid.New().String(),
+		UserID:      params.UserID,
+		Name:        strings.TrimSpace(params.Name),
+		Role:        params.Role,
+		Email:       params.Email,
+		Phone:       params.Phone,
+		LinkedInURL: params.LinkedInURL,
+		CompanyID:   params.CompanyID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+type UpdateContactParams struct {
+	Name        string
+	Role        *string
+	Email       *string
+	Phone       *string
+	LinkedInURL *string
+	CompanyID   *string
+}
+
+// Update replaces the contact's fields.
+func (c *Contact) Update(params UpdateContactParams) {
+	c.Name = strings.TrimSpace(params.Name)
+	c.Role = params.Role
+	c.Email = params.Email
+	c.Phone = params.Phone
+	c.LinkedInURL = params.LinkedInURL
+	c.CompanyID = params.CompanyID
+	c.UpdatedAt = time.Now()
+}
+
+// ListContactsParams lists a user's contacts by name.
+type ListContactsParams struct {
+	UserID string
+	// CompanyID, if set, keeps the contacts working for the company.
+	CompanyID *string
+	// JobApplicationID, if set, keeps the contacts linked to the job
+	// application.
+	JobApplicationID *string
+}
+
+// ContactTimelineEntry is a job application a contact is involved in, as it
+// appears on the contact's timeline.
+type ContactTimelineEntry struct {
+	JobApplication *JobApplication
+	// LinkedAt is when the contact was linked to the job application.
+	LinkedAt time.Time
+	// History is the job application's events, oldest first.
+	History []*JobApplicationEvent
+}
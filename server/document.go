@@ -0,0 +1,213 @@
+package kiseki
+
+import (
+	"errors"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// MaxDocumentSize is the largest document that can be uploaded, in bytes.
+const MaxDocumentSize = 10 << 20
+
+// DocumentPreviewLength is the number of characters of a document's text
+// shown as its preview.
+const DocumentPreviewLength = 500
+
+const (
+	DocumentTypePDF  = "application/pdf"
+	DocumentTypeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	DocumentTypeText = "text/plain"
+)
+
+var (
+	// ErrDocumentTooLarge is returned for a document larger than
+	// MaxDocumentSize.
+	ErrDocumentTooLarge = errors.New("document is too large")
+
+	// ErrUnsupportedDocumentType is returned for a document that is not a PDF,
+	// a Word document or plain text.
+	ErrUnsupportedDocumentType = errors.New("document must be a PDF, a Word document or plain text")
+
+	// ErrDocumentInUse is returned when deleting a document that job
+	// applications still refer to, or changing its kind.
+	ErrDocumentInUse = errors.New("document is used by a job application")
+)
+
+// DocumentKind is the domain enum for what a document is used as. The numeric
+// values intentionally match the protobuf enum values.
+type DocumentKind int32
+
+const (
+	DocumentKindUnspecified DocumentKind = 0
+	DocumentKindCV          DocumentKind = 1
+	DocumentKindCoverLetter DocumentKind = 2
+)
+
+// DocumentKindToDB converts the domain enum to the DB enum label (no prefix), e.g. CV.
+func DocumentKindToDB(k DocumentKind) string {
+	switch k {
+	case DocumentKindCV:
+		return "CV"
+	case DocumentKindCoverLetter:
+		return "COVER_LETTER"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// DocumentKindFromDB converts a DB label to the domain enum. Case-insensitive.
+func DocumentKindFromDB(db string) DocumentKind {
+	switch strings.ToUpper(strings.TrimSpace(db)) {
+	case "CV":
+		return DocumentKindCV
+	case "COVER_LETTER":
+		return DocumentKindCoverLetter
+	default:
+		return DocumentKindUnspecified
+	}
+}
+
+// Document is a file uploaded by a user, such as a CV, that job applications
+// can refer to. The content lives in a BlobStore under StorageKey.
+type Document struct {
+	ID     string
+	UserID string
+	Kind   DocumentKind
+	Name   string
+	// Label tells versions of the same document apart, e.g. "v3, backend".
+	Label       string
+	ContentType string
+	Size        int64
+	StorageKey  string
+	// Text is the text extracted from the content for searching, empty if
+	// none could be extracted.
+	Text      string
+	CreatedAt time.Time
+}
+
+type NewDocumentParams struct {
+	UserID      string
+	Kind        DocumentKind
+	Name        string
+	Label       string
+	ContentType string
+	Size        int64
+	Text        string
+}
+
+func NewDocument(params NewDocumentParams) Document {
+	id := uuid.New().String()
+	return Document{
+		ID:          id,
+		UserID:      params.UserID,
+		Kind:        params.Kind,
+		Name:        path.Base(params.Name),
+		Label:       params.Label,
+		ContentType: params.ContentType,
+		Size:        params.Size,
+		// Keys are grouped by user, which keeps a user's files together in
+		// the bucket and makes them easy to remove.
+		StorageKey: params.UserID + "/" + id,
+		Text:       params.Text,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// DetectDocumentType returns the content type of a document from its first
+// bytes, checking that it is one of the supported types. The declared type
+// is only trusted to tell a Word document from other ZIP archives.
+func DetectDocumentType(declared string, head []byte) (string, error) {
+	detected, _, _ := strings.Cut(http.DetectContentType(head), ";")
+	switch {
+	case detected == DocumentTypePDF:
+		return DocumentTypePDF, nil
+	case detected == "application/zip" && declared == DocumentTypeDOCX:
+		return DocumentTypeDOCX, nil
+	case detected == DocumentTypeText:
+		return DocumentTypeText, nil
+	default:
+		return "", ErrUnsupportedDocumentType
+	}
+}
+
+// TextPreview returns the start of the document's text, cut at a word
+// boundary where possible.
+func (d *Document) TextPreview() string {
+	if utf8.RuneCountInString(d.Text) <= DocumentPreviewLength {
+		return d.Text
+	}
+
+	runes := []rune(d.Text)[:DocumentPreviewLength]
+	preview := string(runes)
+	if i := strings.LastIndexAny(preview, " \n"); i > DocumentPreviewLength/2 {
+		preview = preview[:i]
+	}
+	return preview + "…"
+}
+
+type UpdateDocumentParams struct {
+	Kind  DocumentKind
+	Name  string
+	Label string
+}
+
+// Update replaces the document's details. Its content cannot be changed; a
+// new version is uploaded as a new document.
+func (d *Document) Update(params UpdateDocumentParams) {
+	d.Kind = params.Kind
+	d.Name = path.Base(params.Name)
+	d.Label = params.Label
+}
+
+// DocumentSummary is a document in a user's library along with the number of
+// job applications that use it.
+type DocumentSummary struct {
+	Document *Document
+	// ApplicationCount counts the job applications, outside the trash, that
+	// use the document as their CV or cover letter.
+	ApplicationCount int
+}
+
+// DocumentCursor marks the last document of a page of documents.
+type DocumentCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// ListDocumentsParams lists a user's documents, newest first.
+type ListDocumentsParams struct {
+	UserID string
+	// Kind, if specified, only lists documents of that kind.
+	Kind DocumentKind
+	// After, if set, skips documents up to and including the cursor.
+	After *DocumentCursor
+	// Limit caps the number of documents returned. Zero means no limit.
+	Limit int
+}
+
+// SearchDocumentsParams describes a full-text search over the names, labels
+// and text of a user's documents.
+type SearchDocumentsParams struct {
+	UserID string
+	// Query uses the same web search syntax as SearchJobApplicationsParams.
+	Query string
+	// Kind, if specified, only searches documents of that kind.
+	Kind   DocumentKind
+	Offset int
+	Limit  int
+}
+
+// DocumentSearchResult is a document matching a search, with its relevance
+// and a highlighted snippet of its text, empty if only the name or label
+// matched. Like SearchSnippet, the snippet is HTML.
+type DocumentSearchResult struct {
+	Document         *Document
+	ApplicationCount int
+	Rank             float64
+	Snippet          string
+}
@@ -0,0 +1,21 @@
+package kiseki
+
+// ExportJobApplicationsParams selects what an export of a user's job
+// applications includes.
+type ExportJobApplicationsParams struct {
+	UserID string
+	// IncludeHistory loads the events of every job application.
+	IncludeHistory bool
+	// IncludeInterviews loads the interviews of every job application.
+	IncludeInterviews bool
+}
+
+// ExportedJobApplication is a job application being exported along with the
+// records the export asked for.
+type ExportedJobApplication struct {
+	JobApplication *JobApplication
+	// History holds the job application's events, oldest first.
+	History []*JobApplicationEvent
+	// Interviews holds the job application's interviews, soonest first.
+	Interviews []*Interview
+}
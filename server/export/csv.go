@@ -0,0 +1,103 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"kiseki"
+	"kiseki/importer"
+)
+
+// csvHeader names the CSV columns. Columns the importer reads use its field
+// names, so an export can be imported again without a mapping.
+var csvHeader = []string{
+	"id",
+	importer.FieldExternalID,
+	importer.FieldCompany,
+	importer.FieldTitle,
+	importer.FieldStatus,
+	importer.FieldAppliedOn,
+	importer.FieldDescription,
+	importer.FieldNotes,
+	importer.FieldCV,
+	importer.FieldCoverLetter,
+	"match_score",
+	"created_at",
+	"updated_at",
+}
+
+type csvWriter struct {
+	w           *csv.Writer
+	wroteHeader bool
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+// Write implements Writer.
+func (c *csvWriter) Write(exported *kiseki.ExportedJobApplication) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+
+	ja := exported.JobApplication
+	var matchScore string
+	if ja.MatchScore != nil {
+		matchScore = strconv.Itoa(int(*ja.MatchScore))
+	}
+	return c.w.Write([]string{
+		ja.ID,
+		cell(deref(ja.ExternalID)),
+		cell(ja.Company),
+		cell(ja.Title),
+		kiseki.StatusToDB(ja.Status),
+		formatDate(ja.AppliedOn),
+		cell(deref(ja.Description)),
+		cell(deref(ja.Notes)),
+		cell(deref(ja.CV)),
+		cell(deref(ja.CoverLetter)),
+		matchScore,
+		ja.CreatedAt.UTC().Format(time.RFC3339),
+		ja.UpdatedAt.UTC().Format(time.RFC3339),
+	})
+}
+
+// Close implements Writer.
+func (c *csvWriter) Close() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// writeHeader writes the header row before the first row, or on its own for
+// an export with no rows.
+func (c *csvWriter) writeHeader() error {
+	if c.wroteHeader {
+		return nil
+	}
+	c.wroteHeader = true
+	return c.w.Write(csvHeader)
+}
+
+// cell guards a user-entered value against being run as a formula when the
+// CSV file is opened in a spreadsheet, by quoting values that start like one.
+func cell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+// deref returns the string s points to, or an empty string if it is nil.
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
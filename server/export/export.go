@@ -0,0 +1,83 @@
+// Package export writes a user's job applications out in formats other tools
+// can read: CSV for spreadsheets, newline-delimited JSON for scripts and
+// iCalendar for calendars.
+package export
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"kiseki"
+)
+
+// Format is the format of an export. The numeric values intentionally match
+// the protobuf enum values.
+type Format int32
+
+const (
+	FormatUnspecified Format = 0
+	FormatCSV         Format = 1
+	FormatNDJSON      Format = 2
+	FormatICS         Format = 3
+)
+
+// ErrUnknownFormat is returned for a format other than CSV, NDJSON or ICS.
+var ErrUnknownFormat = errors.New("export format must be CSV, NDJSON or ICS")
+
+// ContentType returns the media type of exports in f.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatICS:
+		return "text/calendar; charset=utf-8"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// FileName returns the name exports in f are downloaded as.
+func (f Format) FileName() string {
+	switch f {
+	case FormatCSV:
+		return "job-applications.csv"
+	case FormatNDJSON:
+		return "job-applications.ndjson"
+	case FormatICS:
+		return "job-applications.ics"
+	default:
+		return "job-applications"
+	}
+}
+
+// Writer writes job applications one at a time, so an export never has to
+// hold them all.
+type Writer interface {
+	// Write writes a job application, with its history or interviews if
+	// the format includes them.
+	Write(ja *kiseki.ExportedJobApplication) error
+	// Close finishes the export. It does not close the underlying writer.
+	Close() error
+}
+
+// NewWriter returns a Writer writing an export in format to w.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w), nil
+	case FormatNDJSON:
+		return newNDJSONWriter(w), nil
+	case FormatICS:
+		return newICSWriter(w), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// formatDate formats the day of t, which job applications are applied on.
+func formatDate(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
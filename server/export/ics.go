@@ -0,0 +1,157 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"kiseki"
+)
+
+const (
+	// icsDateLayout and icsTimeLayout format dates and UTC times as RFC 5545
+	// values.
+	icsDateLayout = "20060102"
+	icsTimeLayout = "20060102T150405Z"
+
+	// icsLineLength is the number of octets a content line may hold before it
+	// must be folded.
+	icsLineLength = 75
+
+	// icsUIDDomain makes event UIDs globally unique, as RFC 5545 asks.
+	icsUIDDomain = "kiseki"
+)
+
+// icsWriter writes an iCalendar feed with an all-day event on the day each
+// job application was applied on and an event for each of its interviews.
+type icsWriter struct {
+	w           io.Writer
+	wroteHeader bool
+	// stampedAt is when the feed was generated, which every event is
+	// stamped with.
+	stampedAt time.Time
+}
+
+func newICSWriter(w io.Writer) *icsWriter {
+	return &icsWriter{w: w, stampedAt: time.Now()}
+}
+
+// Write implements Writer.
+func (c *icsWriter) Write(exported *kiseki.ExportedJobApplication) error {
+	var b icsBuilder
+	if !c.wroteHeader {
+		c.writeHeader(&b)
+	}
+
+	ja := exported.JobApplication
+	b.line("BEGIN", "VEVENT")
+	b.line("UID", ja.ID+"-applied@"+icsUIDDomain)
+	b.line("DTSTAMP", c.stampedAt.UTC().Format(icsTimeLayout))
+	b.line("DTSTART;VALUE=DATE", ja.AppliedOn.UTC().Format(icsDateLayout))
+	b.line("DTEND;VALUE=DATE", ja.AppliedOn.UTC().AddDate(0, 0, 1).Format(icsDateLayout))
+	b.line("SUMMARY", icsText(fmt.Sprintf("Applied: %s at %s", ja.Title, ja.Company)))
+	b.line("DESCRIPTION", icsText("Status: "+kiseki.StatusToDB(ja.Status)))
+	b.line("LAST-MODIFIED", ja.UpdatedAt.UTC().Format(icsTimeLayout))
+	// Applying takes no time, so the day stays free.
+	b.line("TRANSP", "TRANSPARENT")
+	b.line("END", "VEVENT")
+
+	for _, interview := range exported.Interviews {
+		b.line("BEGIN", "VEVENT")
+		b.line("UID", interview.ID+"@"+icsUIDDomain)
+		b.line("DTSTAMP", c.stampedAt.UTC().Format(icsTimeLayout))
+		b.line("DTSTART", interview.ScheduledAt.UTC().Format(icsTimeLayout))
+		b.line("DTEND", interview.ScheduledAt.Add(interview.Duration).UTC().Format(icsTimeLayout))
+		b.line("SUMMARY", icsText(fmt.Sprintf("%s: %s at %s", interview.Round, ja.Title, ja.Company)))
+		if description := interviewDescription(interview); description != "" {
+			b.line("DESCRIPTION", icsText(description))
+		}
+		if interview.Location != "" {
+			b.line("LOCATION", icsText(interview.Location))
+		}
+		b.line("LAST-MODIFIED", interview.UpdatedAt.UTC().Format(icsTimeLayout))
+		if interview.Outcome == kiseki.InterviewOutcomeCancelled {
+			b.line("STATUS", "CANCELLED")
+		} else {
+			b.line("STATUS", "CONFIRMED")
+		}
+		b.line("END", "VEVENT")
+	}
+
+	_, err := io.WriteString(c.w, b.String())
+	return err
+}
+
+// Close implements Writer.
+func (c *icsWriter) Close() error {
+	var b icsBuilder
+	if !c.wroteHeader {
+		c.writeHeader(&b)
+	}
+	b.line("END", "VCALENDAR")
+	_, err := io.WriteString(c.w, b.String())
+	return err
+}
+
+// writeHeader starts the calendar.
+func (c *icsWriter) writeHeader(b *icsBuilder) {
+	c.wroteHeader = true
+	b.line("BEGIN", "VCALENDAR")
+	b.line("VERSION", "2.0")
+	b.line("PRODID", "-//Kiseki//Job applications//EN")
+	b.line("CALSCALE", "GREGORIAN")
+	b.line("METHOD", "PUBLISH")
+	b.line("X-WR-CALNAME", "Job applications")
+}
+
+// interviewDescription describes the format and interviewers of an
+// interview.
+func interviewDescription(interview *kiseki.Interview) string {
+	var parts []string
+	if interview.Format != kiseki.InterviewFormatUnspecified {
+		parts = append(parts, "Format: "+strings.ToLower(kiseki.InterviewFormatToDB(interview.Format)))
+	}
+	if len(interview.Interviewers) > 0 {
+		parts = append(parts, "Interviewers: "+strings.Join(interview.Interviewers, ", "))
+	}
+	return strings.Join(parts, "\n")
+}
+
+// icsText escapes s as an RFC 5545 TEXT value.
+func icsText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+// icsBuilder builds iCalendar content lines.
+type icsBuilder struct {
+	strings.Builder
+}
+
+// line adds a content line, folding it so no line is longer than
+// icsLineLength octets. Folds never split a UTF-8 sequence.
+func (b *icsBuilder) line(name, value string) {
+	s := name + ":" + value
+	limit := icsLineLength
+	for len(s) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with a space, which counts.
+		limit = icsLineLength - 1
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
@@ -0,0 +1,100 @@
+package export
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+
+	"kiseki"
+)
+
+// jobApplicationRecord is a line of an NDJSON export.
+type jobApplicationRecord struct {
+	ID                    string        `json:"id"`
+	ExternalID            *string       `json:"external_id,omitempty"`
+	Company               string        `json:"company"`
+	Title                 string        `json:"title"`
+	Status                string        `json:"status"`
+	AppliedOn             string        `json:"applied_on"`
+	Description           *string       `json:"description,omitempty"`
+	Notes                 *string       `json:"notes,omitempty"`
+	CV                    *string       `json:"cv,omitempty"`
+	CoverLetter           *string       `json:"cover_letter,omitempty"`
+	CVDocumentID          *string       `json:"cv_document_id,omitempty"`
+	CoverLetterDocumentID *string       `json:"cover_letter_document_id,omitempty"`
+	MatchScore            *int32        `json:"match_score,omitempty"`
+	CreatedAt             time.Time     `json:"created_at"`
+	UpdatedAt             time.Time     `json:"updated_at"`
+	History               []eventRecord `json:"history"`
+}
+
+// eventRecord is an event in the history of a jobApplicationRecord.
+type eventRecord struct {
+	Type      string         `json:"type"`
+	Changes   []changeRecord `json:"changes"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// changeRecord is a field changed by an eventRecord.
+type changeRecord struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+}
+
+type ndjsonWriter struct {
+	encoder *json.Encoder
+}
+
+func newNDJSONWriter(w io.Writer) *ndjsonWriter {
+	encoder := json.NewEncoder(w)
+	// Notes and descriptions are shown as written, not as HTML.
+	encoder.SetEscapeHTML(false)
+	return &ndjsonWriter{encoder: encoder}
+}
+
+// Write implements Writer. The encoder ends every record with a newline.
+func (n *ndjsonWriter) Write(exported *kiseki.ExportedJobApplication) error {
+	ja := exported.JobApplication
+	record := jobApplicationRecord{
+		ID:                    ja.ID,
+		ExternalID:            ja.ExternalID,
+		Company:               ja.Company,
+		Title:                 ja.Title,
+		Status:                kiseki.StatusToDB(ja.Status),
+		AppliedOn:             formatDate(ja.AppliedOn),
+		Description:           ja.Description,
+		Notes:                 ja.Notes,
+		CV:                    ja.CV,
+		CoverLetter:           ja.CoverLetter,
+		CVDocumentID:          ja.CVDocumentID,
+		CoverLetterDocumentID: ja.CoverLetterDocumentID,
+		MatchScore:            ja.MatchScore,
+		CreatedAt:             ja.CreatedAt.UTC(),
+		UpdatedAt:             ja.UpdatedAt.UTC(),
+		History:               make([]eventRecord, 0, len(exported.History)),
+	}
+
+	for _, event := range exported.History {
+		changes := make([]changeRecord, 0, len(event.Changes))
+		for _, c := range event.Changes {
+			changes = append(changes, changeRecord{
+				Field:    string(c.Field),
+				OldValue: c.OldValue,
+				NewValue: c.NewValue,
+			})
+		}
+		record.History = append(record.History, eventRecord{
+			Type:      kiseki.EventTypeToDB(event.Type),
+			Changes:   changes,
+			CreatedAt: event.CreatedAt.UTC(),
+		})
+	}
+
+	return n.encoder.Encode(record)
+}
+
+// Close implements Writer.
+func (n *ndjsonWriter) Close() error {
+	return nil
+}
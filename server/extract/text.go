@@ -0,0 +1,119 @@
+// Package extract pulls the plain text out of uploaded documents so they can
+// be searched and previewed.
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"kiseki"
+
+	"github.com/ledongthuc/pdf"
+)
+
+// MaxTextLength is the most text kept for a document, in bytes. It keeps
+// the search index of a document well below the Postgres tsvector limit.
+const MaxTextLength = 256 << 10
+
+// Text returns the text of a document with the given content type, which
+// must be one kiseki.DetectDocumentType returns. Documents it cannot read
+// text from, such as Word documents, have no text.
+func Text(contentType string, content []byte) (string, error) {
+	switch contentType {
+	case kiseki.DocumentTypePDF:
+		return pdfText(content)
+	case kiseki.DocumentTypeText:
+		return normalize(string(content)), nil
+	default:
+		return "", nil
+	}
+}
+
+// pdfText returns the text of a PDF, page by page.
+func pdfText(content []byte) (text string, err error) {
+	// The parser panics on some malformed files rather than failing.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("read PDF: %v", r)
+		}
+	}()
+
+	reader, err := pdf.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return "", fmt.Errorf("read PDF: %w", err)
+	}
+
+	var b strings.Builder
+	for i := 1; i <= reader.NumPage() && b.Len() < MaxTextLength; i++ {
+		page := reader.Page(i)
+		if page.V.IsNull() {
+			continue
+		}
+		writeLines(&b, page.Content().Text)
+	}
+
+	return normalize(b.String()), nil
+}
+
+// wordGap is the gap between two glyphs, relative to the font size, from
+// which they are taken to belong to different words.
+const wordGap = 0.15
+
+// writeLines writes the glyphs of a page to b line by line. PDFs position
+// every glyph rather than storing spaces, so lines and word breaks are
+// worked out from where the glyphs are.
+func writeLines(b *strings.Builder, glyphs []pdf.Text) {
+	// Glyphs on the same baseline make a line; lines go from the top of the
+	// page down, and glyphs from left to right.
+	sort.SliceStable(glyphs, func(i, j int) bool {
+		yi, yj := math.Round(glyphs[i].Y), math.Round(glyphs[j].Y)
+		if yi != yj {
+			return yi > yj
+		}
+		return glyphs[i].X < glyphs[j].X
+	})
+
+	for i, g := range glyphs {
+		if i > 0 {
+			prev := glyphs[i-1]
+			switch {
+			case math.Round(prev.Y) != math.Round(g.Y):
+				b.WriteByte('\n')
+			case g.X-(prev.X+prev.W) > wordGap*g.FontSize:
+				b.WriteByte(' ')
+			}
+		}
+		b.WriteString(g.S)
+	}
+	b.WriteByte('\n')
+}
+
+// normalize collapses the whitespace in each line of s, drops blank lines and
+// characters Postgres cannot store, and truncates s to MaxTextLength.
+func normalize(s string) string {
+	s = strings.ToValidUTF8(s, "")
+	// Glyphs the parser cannot map come out as U+FFFD.
+	s = strings.NewReplacer("\x00", "", "\uFFFD", "").Replace(s)
+
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	s = strings.Join(lines, "\n")
+
+	if len(s) <= MaxTextLength {
+		return s
+	}
+	s = s[:MaxTextLength]
+	// Do not cut a character in half.
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+	return s
+}
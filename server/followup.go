@@ -0,0 +1,89 @@
+package kiseki
+
+import (
+	"slices"
+	"time"
+)
+
+// FollowUpReason is the domain enum for why a job application is due a
+// follow-up. The numeric values intentionally match the protobuf enum values.
+type FollowUpReason int32
+
+const (
+	FollowUpReasonUnspecified FollowUpReason = 0
+	// FollowUpReasonScheduled means the follow-up date the user picked has
+	// come.
+	FollowUpReasonScheduled FollowUpReason = 1
+	// FollowUpReasonGhosted means the job application has gone without a
+	// status change for longer than its ghosting rule allows.
+	FollowUpReasonGhosted FollowUpReason = 2
+)
+
+// GhostingRule flags job applications that stay in Status for AfterDays days
+// without a status change, which usually means the company stopped replying.
+type GhostingRule struct {
+	Status    JobApplicationStatus
+	AfterDays int
+}
+
+// DefaultGhostingRules returns the rules used for a user who has not chosen
+// their own. Only statuses that wait on the company have a rule.
+func DefaultGhostingRules() []GhostingRule {
+	return []GhostingRule{
+		{Status: JobApplicationStatusApplied, AfterDays: 14},
+		{Status: JobApplicationStatusScreening, AfterDays: 10},
+		{Status: JobApplicationStatusInterview, AfterDays: 10},
+		{Status: JobApplicationStatusOffer, AfterDays: 7},
+	}
+}
+
+// GhostingRuleFor returns the rule for status, if rules have one.
+func GhostingRuleFor(rules []GhostingRule, status JobApplicationStatus) (GhostingRule, bool) {
+	i := slices.IndexFunc(rules, func(r GhostingRule) bool { return r.Status == status })
+	if i < 0 {
+		return GhostingRule{}, false
+	}
+	return rules[i], true
+}
+
+// GhostedSince returns when the job application became ghosted under rules,
+// which may be in the future, or false if no rule covers its status.
+func (j *JobApplication) GhostedSince(rules []GhostingRule) (time.Time, bool) {
+	rule, ok := GhostingRuleFor(rules, j.Status)
+	if !ok || rule.AfterDays <= 0 {
+		return time.Time{}, false
+	}
+	return j.StatusChangedAt.AddDate(0, 0, rule.AfterDays), true
+}
+
+// FollowUp is a job application that is due a follow-up.
+type FollowUp struct {
+	JobApplication *JobApplication
+	Reason         FollowUpReason
+	// DueAt is when the follow-up became due.
+	DueAt time.Time
+}
+
+// DueFollowUp returns the follow-up the job application is due at now under
+// rules, or nil if none is. A follow-up date the user picked comes before
+// the ghosting rules.
+func (j *JobApplication) DueFollowUp(rules []GhostingRule, now time.Time) *FollowUp {
+	if j.FollowUpOn != nil && !j.FollowUpOn.After(now) {
+		return &FollowUp{JobApplication: j, Reason: FollowUpReasonScheduled, DueAt: *j.FollowUpOn}
+	}
+
+	if since, ok := j.GhostedSince(rules); ok && !since.After(now) {
+		return &FollowUp{JobApplication: j, Reason: FollowUpReasonGhosted, DueAt: since}
+	}
+
+	return nil
+}
+
+// ListDueFollowUpsParams lists a user's job applications outside the trash
+// that are due a follow-up at Now: those whose follow-up date has come, and
+// those ghosted under Rules.
+type ListDueFollowUpsParams struct {
+	UserID string
+	Rules  []GhostingRule
+	Now    time.Time
+}
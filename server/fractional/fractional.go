@@ -0,0 +1,366 @@
+// Package fractional generates fractional-indexing keys: strings that sort
+// between two other strings, so an item can be moved by changing only its own
+// key. It produces the same keys as the fractional-indexing package the
+// frontend uses, and like it compares keys byte-wise.
+package fractional
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// digits are the base 62 digits in ascending byte order.
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// smallestInteger is the integer part no key may consist of on its own.
+var smallestInteger = "A" + strings.Repeat(string(digits[0]), 26)
+
+var (
+	// ErrInvalidKey denotes a key that is not a valid fractional index.
+	ErrInvalidKey = errors.New("invalid fractional index key")
+
+	// ErrKeyOrder denotes bounds that are not in ascending order.
+	ErrKeyOrder = errors.New("fractional index keys are out of order")
+
+	// ErrKeySpaceExhausted denotes that no key exists beyond the given bound.
+	ErrKeySpaceExhausted = errors.New("fractional index key space exhausted")
+)
+
+// KeyBetween returns a key that sorts after a and before b. An empty a means
+// "before b" and an empty b means "after a"; both empty returns the first key.
+func KeyBetween(a, b string) (string, error) {
+	if a != "" {
+		if err := Validate(a); err != nil {
+			return "", err
+		}
+	}
+	if b != "" {
+		if err := Validate(b); err != nil {
+			return "", err
+		}
+	}
+	if a != "" && b != "" && a >= b {
+		return "", fmt.Errorf("%w: %q >= %q", ErrKeyOrder, a, b)
+	}
+
+	if a == "" {
+		if b == "" {
+			return "a" + string(digits[0]), nil
+		}
+
+		ib, err := integerPart(b)
+		if err != nil {
+			return "", err
+		}
+		fb := b[len(ib):]
+		if ib == smallestInteger {
+			m, err := midpoint("", fb)
+			if err != nil {
+				return "", err
+			}
+			return ib + m, nil
+		}
+		if ib < b {
+			return ib, nil
+		}
+		res, ok := decrementInteger(ib)
+		if !ok {
+			return "", ErrKeySpaceExhausted
+		}
+		return res, nil
+	}
+
+	ia, err := integerPart(a)
+	if err != nil {
+		return "", err
+	}
+	fa := a[len(ia):]
+
+	if b == "" {
+		i, ok := incrementInteger(ia)
+		if ok {
+			return i, nil
+		}
+		m, err := midpoint(fa, "")
+		if err != nil {
+			return "", err
+		}
+		return ia + m, nil
+	}
+
+	ib, err := integerPart(b)
+	if err != nil {
+		return "", err
+	}
+	fb := b[len(ib):]
+
+	if ia == ib {
+		m, err := midpoint(fa, fb)
+		if err != nil {
+			return "", err
+		}
+		return ia + m, nil
+	}
+
+	i, ok := incrementInteger(ia)
+	if !ok {
+		return "", ErrKeySpaceExhausted
+	}
+	if i < b {
+		return i, nil
+	}
+	m, err := midpoint(fa, "")
+	if err != nil {
+		return "", err
+	}
+	return ia + m, nil
+}
+
+// NKeysBetween returns n ascending keys that sort after a and before b, with
+// the same meaning of empty bounds as KeyBetween. The keys are spread out so
+// they stay short, which makes it suitable for rebalancing a whole list.
+func NKeysBetween(a, b string, n int) ([]string, error) {
+	switch {
+	case n <= 0:
+		return nil, nil
+	case n == 1:
+		k, err := KeyBetween(a, b)
+		if err != nil {
+			return nil, err
+		}
+		return []string{k}, nil
+	}
+
+	if b == "" {
+		keys := make([]string, 0, n)
+		c := a
+		for i := 0; i < n; i++ {
+			k, err := KeyBetween(c, b)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, k)
+			c = k
+		}
+		return keys, nil
+	}
+
+	if a == "" {
+		keys := make([]string, n)
+		c := b
+		for i := n - 1; i >= 0; i-- {
+			k, err := KeyBetween(a, c)
+			if err != nil {
+				return nil, err
+			}
+			keys[i] = k
+			c = k
+		}
+		return keys, nil
+	}
+
+	mid := n / 2
+	c, err := KeyBetween(a, b)
+	if err != nil {
+		return nil, err
+	}
+	before, err := NKeysBetween(a, c, mid)
+	if err != nil {
+		return nil, err
+	}
+	after, err := NKeysBetween(c, b, n-mid-1)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, n)
+	keys = append(keys, before...)
+	keys = append(keys, c)
+	keys = append(keys, after...)
+	return keys, nil
+}
+
+// Validate returns ErrInvalidKey if key is not a valid fractional index.
+func Validate(key string) error {
+	if key == smallestInteger {
+		return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	i, err := integerPart(key)
+	if err != nil {
+		return err
+	}
+	f := key[len(i):]
+	if strings.HasSuffix(f, string(digits[0])) {
+		return fmt.Errorf("%w: %q has a trailing zero", ErrInvalidKey, key)
+	}
+	for j := 0; j < len(f); j++ {
+		if strings.IndexByte(digits, f[j]) < 0 {
+			return fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return nil
+}
+
+// midpoint returns a fractional part between a and b, where an empty b means
+// "no upper bound". Neither may have a trailing zero.
+func midpoint(a, b string) (string, error) {
+	zero := digits[0]
+	if b != "" && a >= b {
+		return "", fmt.Errorf("%w: %q >= %q", ErrKeyOrder, a, b)
+	}
+	if (a != "" && a[len(a)-1] == zero) || (b != "" && b[len(b)-1] == zero) {
+		return "", fmt.Errorf("%w: trailing zero", ErrInvalidKey)
+	}
+
+	if b != "" {
+		// Skip the common prefix, treating missing digits of a as zero.
+		n := 0
+		for n < len(b) {
+			ca := zero
+			if n < len(a) {
+				ca = a[n]
+			}
+			if ca != b[n] {
+				break
+			}
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			m, err := midpoint(rest, b[n:])
+			if err != nil {
+				return "", err
+			}
+			return b[:n] + m, nil
+		}
+	}
+
+	digitA := 0
+	if a != "" {
+		digitA = strings.IndexByte(digits, a[0])
+	}
+	digitB := len(digits)
+	if b != "" {
+		digitB = strings.IndexByte(digits, b[0])
+	}
+
+	if digitB-digitA > 1 {
+		return string(digits[(digitA+digitB+1)/2]), nil
+	}
+
+	if len(b) > 1 {
+		return b[:1], nil
+	}
+
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	m, err := midpoint(rest, "")
+	if err != nil {
+		return "", err
+	}
+	return string(digits[digitA]) + m, nil
+}
+
+// integerLength returns the length of the integer part of a key with the
+// given head character.
+func integerLength(head byte) (int, error) {
+	switch {
+	case head >= 'a' && head <= 'z':
+		return int(head-'a') + 2, nil
+	case head >= 'A' && head <= 'Z':
+		return int('Z'-head) + 2, nil
+	default:
+		return 0, fmt.Errorf("%w: invalid head %q", ErrInvalidKey, head)
+	}
+}
+
+func integerPart(key string) (string, error) {
+	if key == "" {
+		return "", fmt.Errorf("%w: empty key", ErrInvalidKey)
+	}
+	n, err := integerLength(key[0])
+	if err != nil {
+		return "", err
+	}
+	if n > len(key) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+	}
+	for i := 1; i < n; i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return "", fmt.Errorf("%w: %q", ErrInvalidKey, key)
+		}
+	}
+	return key[:n], nil
+}
+
+// incrementInteger returns the integer part after x, or false if x is the
+// largest one.
+func incrementInteger(x string) (string, bool) {
+	head, digs := x[0], []byte(x[1:])
+	carry := true
+	for i := len(digs) - 1; carry && i >= 0; i-- {
+		d := strings.IndexByte(digits, digs[i]) + 1
+		if d == len(digits) {
+			digs[i] = digits[0]
+		} else {
+			digs[i] = digits[d]
+			carry = false
+		}
+	}
+	if !carry {
+		return string(head) + string(digs), true
+	}
+
+	switch head {
+	case 'Z':
+		return "a" + string(digits[0]), true
+	case 'z':
+		return "", false
+	}
+	h := head + 1
+	if h > 'a' {
+		digs = append(digs, digits[0])
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+	return string(h) + string(digs), true
+}
+
+// decrementInteger returns the integer part before x, or false if x is the
+// smallest one.
+func decrementInteger(x string) (string, bool) {
+	head, digs := x[0], []byte(x[1:])
+	borrow := true
+	for i := len(digs) - 1; borrow && i >= 0; i-- {
+		d := strings.IndexByte(digits, digs[i]) - 1
+		if d == -1 {
+			digs[i] = digits[len(digits)-1]
+		} else {
+			digs[i] = digits[d]
+			borrow = false
+		}
+	}
+	if !borrow {
+		return string(head) + string(digs), true
+	}
+
+	switch head {
+	case 'a':
+		return "Z" + string(digits[len(digits)-1]), true
+	case 'A':
+		return "", false
+	}
+	h := head - 1
+	if h < 'Z' {
+		digs = append(digs, digits[len(digits)-1])
+	} else {
+		digs = digs[:len(digs)-1]
+	}
+	return string(h) + string(digs), true
+}
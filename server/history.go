@@ -0,0 +1,228 @@
+package kiseki
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobApplicationField names a field of a job application. The values match
+// the protobuf field names so they can be shown to clients as-is.
+type JobApplicationField string
+
+const (
+	JobApplicationFieldCompany     JobApplicationField = "company"
+	JobApplicationFieldTitle       JobApplicationField = "title"
+	JobApplicationFieldDescription JobApplicationField = "description"
+	JobApplicationFieldNotes       JobApplicationField = "notes"
+	JobApplicationFieldCV          JobApplicationField = "cv"
+	JobApplicationFieldCoverLetter JobApplicationField = "cover_letter"
+	JobApplicationFieldAppliedOn   JobApplicationField = "applied_on"
+	JobApplicationFieldStatus      JobApplicationField = "status"
+	JobApplicationFieldPosition    JobApplicationField = "position"
+	JobApplicationFieldDeletedAt   JobApplicationField = "deleted_at"
+
+	JobApplicationFieldCVDocumentID          JobApplicationField = "cv_document_id"
+	JobApplicationFieldCoverLetterDocumentID JobApplicationField = "cover_letter_document_id"
+	JobApplicationFieldMatchScore            JobApplicationField = "match_score"
+	JobApplicationFieldExternalID            JobApplicationField = "external_id"
+	JobApplicationFieldFollowUpOn            JobApplicationField = "follow_up_on"
+	JobApplicationFieldCompanyID             JobApplicationField = "company_id"
+)
+
+// JobApplicationEventType is the domain enum for the kind of change recorded
+// in a job application's history. The numeric values intentionally match the
+// protobuf enum values.
+type JobApplicationEventType int32
+
+const (
+	JobApplicationEventTypeUnspecified   JobApplicationEventType = 0
+	JobApplicationEventTypeCreated       JobApplicationEventType = 1
+	JobApplicationEventTypeUpdated       JobApplicationEventType = 2
+	JobApplicationEventTypeStatusChanged JobApplicationEventType = 3
+	JobApplicationEventTypeDeleted       JobApplicationEventType = 4
+	JobApplicationEventTypeRestored      JobApplicationEventType = 5
+)
+
+// EventTypeToDB converts the domain enum to the DB enum label (no prefix), e.g. CREATED.
+func EventTypeToDB(t JobApplicationEventType) string {
+	switch t {
+	case JobApplicationEventTypeCreated:
+		return "CREATED"
+	case JobApplicationEventTypeUpdated:
+		return "UPDATED"
+	case JobApplicationEventTypeStatusChanged:
+		return "STATUS_CHANGED"
+	case JobApplicationEventTypeDeleted:
+		return "DELETED"
+	case JobApplicationEventTypeRestored:
+		return "RESTORED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// EventTypeFromDB converts a DB label to the domain enum. Case-insensitive.
+func EventTypeFromDB(db string) JobApplicationEventType {
+	switch strings.ToUpper(strings.TrimSpace(db)) {
+	case "CREATED":
+		return JobApplicationEventTypeCreated
+	case "UPDATED":
+		return JobApplicationEventTypeUpdated
+	case "STATUS_CHANGED":
+		return JobApplicationEventTypeStatusChanged
+	case "DELETED":
+		return JobApplicationEventTypeDeleted
+	case "RESTORED":
+		return JobApplicationEventTypeRestored
+	default:
+		return JobApplicationEventTypeUnspecified
+	}
+}
+
+// FieldChange records the old and new value of a single field. Values are
+// stored in their textual form; a nil value means the field was empty.
+type FieldChange struct {
+	Field    JobApplicationField
+	OldValue *string
+	NewValue *string
+}
+
+// JobApplicationEvent is an immutable entry in a job application's history.
+type JobApplicationEvent struct {
+	ID               string
+	JobApplicationID string
+	UserID           string
+	Type             JobApplicationEventType
+	Changes          []FieldChange
+	CreatedAt        time.Time
+	// TxID is the ID of the transaction that stored the event and Seq a
+	// sequence number assigned when it was stored. Together they give the
+	// event's Position.
+	TxID int64
+	Seq  int64
+}
+
+// Position returns the point in the user's history just after e.
+func (e *JobApplicationEvent) Position() EventPosition {
+	return EventPosition{TxID: e.TxID, Seq: e.Seq}
+}
+
+type NewJobApplicationEventParams struct {
+	JobApplicationID string
+	UserID           string
+	Type             JobApplicationEventType
+	Changes          []FieldChange
+}
+
+func NewJobApplicationEvent(params NewJobApplicationEventParams) JobApplicationEvent {
+	return JobApplicationEvent{
+		ID:               uuid.New().String(),
+		JobApplicationID: params.JobApplicationID,
+		UserID:           params.UserID,
+		Type:             params.Type,
+		Changes:          params.Changes,
+		CreatedAt:        time.Now(),
+	}
+}
+
+// DiffJobApplications returns the fields that differ between before and
+// after. A nil before is treated as an empty job application, which makes
+// the result describe a newly created one.
+func DiffJobApplications(before, after *JobApplication) []FieldChange {
+	if before == nil {
+		before = &JobApplication{}
+	}
+
+	var changes []FieldChange
+	add := func(field JobApplicationField, oldValue, newValue *string) {
+		if equalStringPtr(oldValue, newValue) {
+			return
+		}
+		changes = append(changes, FieldChange{Field: field, OldValue: oldValue, NewValue: newValue})
+	}
+
+	add(JobApplicationFieldCompany, nonEmpty(before.Company), nonEmpty(after.Company))
+	add(JobApplicationFieldTitle, nonEmpty(before.Title), nonEmpty(after.Title))
+	add(JobApplicationFieldDescription, before.Description, after.Description)
+	add(JobApplicationFieldNotes, before.Notes, after.Notes)
+	add(JobApplicationFieldCV, before.CV, after.CV)
+	add(JobApplicationFieldCoverLetter, before.CoverLetter, after.CoverLetter)
+	add(JobApplicationFieldAppliedOn, formatDate(before.AppliedOn), formatDate(after.AppliedOn))
+	add(JobApplicationFieldStatus, formatStatus(before.Status), formatStatus(after.Status))
+	add(JobApplicationFieldPosition, nonEmpty(before.Position), nonEmpty(after.Position))
+	add(JobApplicationFieldDeletedAt, formatTime(before.DeletedAt), formatTime(after.DeletedAt))
+	add(JobApplicationFieldCVDocumentID, before.CVDocumentID, after.CVDocumentID)
+	add(JobApplicationFieldCoverLetterDocumentID, before.CoverLetterDocumentID, after.CoverLetterDocumentID)
+	add(JobApplicationFieldMatchScore, formatScore(before.MatchScore), formatScore(after.MatchScore))
+	add(JobApplicationFieldExternalID, before.ExternalID, after.ExternalID)
+	add(JobApplicationFieldFollowUpOn, formatOptionalDate(before.FollowUpOn), formatOptionalDate(after.FollowUpOn))
+	add(JobApplicationFieldCompanyID, before.CompanyID, after.CompanyID)
+
+	return changes
+}
+
+// HasFieldChange reports whether changes contain a change to field.
+func HasFieldChange(changes []FieldChange, field JobApplicationField) bool {
+	for _, c := range changes {
+		if c.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func formatDate(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	s := t.Format(time.DateOnly)
+	return &s
+}
+
+func formatOptionalDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	return formatDate(*t)
+}
+
+func formatTime(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.UTC().Format(time.RFC3339)
+	return &s
+}
+
+func formatScore(score *int32) *string {
+	if score == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*score))
+	return &s
+}
+
+func formatStatus(s JobApplicationStatus) *string {
+	if s == JobApplicationStatusUnspecified {
+		return nil
+	}
+	db := StatusToDB(s)
+	return &db
+}
@@ -0,0 +1,8 @@
+package kiseki
+
+// ImportedJobApplication is a job application to import along with the event
+// recording its creation.
+type ImportedJobApplication struct {
+	JobApplication *JobApplication
+	Event          *JobApplicationEvent
+}
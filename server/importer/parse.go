@@ -0,0 +1,207 @@
+package importer
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"kiseki"
+)
+
+// Limits on the size of imported fields, the same as for job applications
+// created through the API.
+const (
+	maxNameLength       = 200
+	maxTextLength       = 20000
+	maxPathLength       = 1024
+	maxExternalIDLength = 200
+)
+
+// Options configures how an import is read.
+type Options struct {
+	Format  Format
+	Mapping Mapping
+	// DayFirst reads dates such as 03/04/2025 as 3 April rather than
+	// March 4.
+	DayFirst bool
+}
+
+// Row is a row of an import, read into the parameters of a new job
+// application.
+type Row struct {
+	// Number counts the rows from 1, not counting a CSV header.
+	Number     int
+	ExternalID string
+	// Params has no user ID; the importer sets it. It is incomplete if Err
+	// is set.
+	Params kiseki.NewJobApplicationParams
+	Err    *RowError
+}
+
+// RowError explains why a row cannot be imported.
+type RowError struct {
+	Field   string
+	Message string
+}
+
+func (e *RowError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Read reads the rows of an import. It fails if the input as a whole cannot
+// be read; problems with single rows are reported in Row.Err instead.
+func Read(r io.Reader, opts Options) ([]Row, error) {
+	records, err := readRecords(r, opts.Format, opts.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]Row, 0, len(records))
+	seen := map[string]int{}
+	for _, rec := range records {
+		row := parseRow(rec, opts)
+
+		// The same external ID twice would silently drop the second row.
+		if row.Err == nil && row.ExternalID != "" {
+			if first, ok := seen[row.ExternalID]; ok {
+				row.Err = &RowError{Field: FieldExternalID, Message: fmt.Sprintf("duplicates row %d", first)}
+			} else {
+				seen[row.ExternalID] = row.Number
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseRow reads a record into the parameters of a new job application.
+func parseRow(rec record, opts Options) Row {
+	row := Row{Number: rec.number}
+	value := func(field string) string {
+		return strings.TrimSpace(rec.values[field])
+	}
+	fail := func(field, format string, args ...any) Row {
+		row.Err = &RowError{Field: field, Message: fmt.Sprintf(format, args...)}
+		return row
+	}
+
+	row.ExternalID = value(FieldExternalID)
+	if utf8.RuneCountInString(row.ExternalID) > maxExternalIDLength {
+		return fail(FieldExternalID, "must be at most %d characters", maxExternalIDLength)
+	}
+
+	for _, f := range []struct {
+		field string
+		dest  *string
+	}{
+		{FieldCompany, &row.Params.Company},
+		{FieldTitle, &row.Params.Title},
+	} {
+		*f.dest = value(f.field)
+		if *f.dest == "" {
+			return fail(f.field, "must not be empty")
+		}
+		if utf8.RuneCountInString(*f.dest) > maxNameLength {
+			return fail(f.field, "must be at most %d characters", maxNameLength)
+		}
+	}
+
+	for _, f := range []struct {
+		field     string
+		dest      **string
+		maxLength int
+	}{
+		{FieldDescription, &row.Params.Description, maxTextLength},
+		{FieldNotes, &row.Params.Notes, maxTextLength},
+		{FieldCV, &row.Params.CV, maxPathLength},
+		{FieldCoverLetter, &row.Params.CoverLetter, maxTextLength},
+	} {
+		v := value(f.field)
+		if v == "" {
+			continue
+		}
+		if utf8.RuneCountInString(v) > f.maxLength {
+			return fail(f.field, "must be at most %d characters", f.maxLength)
+		}
+		*f.dest = &v
+	}
+
+	status, err := parseStatus(value(FieldStatus))
+	if err != nil {
+		return fail(FieldStatus, "%v", err)
+	}
+	row.Params.Status = status
+
+	appliedOn, err := parseDate(value(FieldAppliedOn), opts.DayFirst)
+	if err != nil {
+		return fail(FieldAppliedOn, "%v", err)
+	}
+	row.Params.AppliedOn = appliedOn
+
+	return row
+}
+
+// parseStatus reads a status label such as "Applied" or "interview". Rows
+// without a status are taken to have been applied to.
+func parseStatus(s string) (kiseki.JobApplicationStatus, error) {
+	if s == "" {
+		return kiseki.JobApplicationStatusApplied, nil
+	}
+
+	status := kiseki.StatusFromDB(strings.NewReplacer(" ", "_", "-", "_").Replace(s))
+	if status == kiseki.JobApplicationStatusUnspecified {
+		return status, fmt.Errorf("unknown status %q", s)
+	}
+	return status, nil
+}
+
+// dateLayouts are the date formats read, besides numeric dates with slashes
+// whose order depends on Options.DayFirst.
+var dateLayouts = []string{
+	"2006-01-02",
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006/01/02",
+	"02.01.2006",
+	"2.1.2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"Jan 2 2006",
+	"January 2 2006",
+}
+
+// parseDate reads a date in any of the formats people and spreadsheets
+// commonly write them in.
+func parseDate(s string, dayFirst bool) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, fmt.Errorf("must not be empty")
+	}
+
+	slashLayouts := []string{"01/02/2006", "1/2/2006", "01/02/06", "1/2/06"}
+	if dayFirst {
+		slashLayouts = []string{"02/01/2006", "2/1/2006", "02/01/06", "2/1/06"}
+	}
+	layouts := slices.Concat(dateLayouts, slashLayouts)
+
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			// Only the day matters, as for job applications created through
+			// the API.
+			return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognised date %q", s)
+}
@@ -0,0 +1,207 @@
+// Package importer reads job applications from CSV and JSON exports, such as
+// spreadsheets, so they can be imported.
+package importer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MaxRows is the most rows a single import can hold.
+const MaxRows = 5000
+
+// Format is the format of an import. The numeric values intentionally match
+// the protobuf enum values.
+type Format int32
+
+const (
+	FormatUnspecified Format = 0
+	FormatCSV         Format = 1
+	FormatJSON        Format = 2
+)
+
+// The fields of a job application that columns can be mapped to.
+const (
+	FieldExternalID  = "external_id"
+	FieldCompany     = "company"
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldNotes       = "notes"
+	FieldCV          = "cv"
+	FieldCoverLetter = "cover_letter"
+	FieldStatus      = "status"
+	FieldAppliedOn   = "applied_on"
+)
+
+// Fields lists every field columns can be mapped to.
+var Fields = []string{
+	FieldExternalID,
+	FieldCompany,
+	FieldTitle,
+	FieldDescription,
+	FieldNotes,
+	FieldCV,
+	FieldCoverLetter,
+	FieldStatus,
+	FieldAppliedOn,
+}
+
+// ErrTooManyRows is returned for an import with more than MaxRows rows.
+var ErrTooManyRows = fmt.Errorf("an import can hold at most %d rows", MaxRows)
+
+// Mapping maps fields to the names of the columns, or JSON keys, holding
+// them. Fields that are not mapped are read from the column with the same
+// name as the field, if there is one. Column names are matched ignoring case.
+type Mapping map[string]string
+
+// record is a row of the input, holding the values of the fields found in it.
+type record struct {
+	// number counts the rows from 1, not counting a CSV header.
+	number int
+	values map[string]string
+}
+
+// readRecords reads the rows of r in format.
+func readRecords(r io.Reader, format Format, mapping Mapping) ([]record, error) {
+	for field := range mapping {
+		if !slices.Contains(Fields, field) {
+			return nil, fmt.Errorf("cannot map column to unknown field %q", field)
+		}
+	}
+
+	switch format {
+	case FormatCSV:
+		return readCSV(r, mapping)
+	case FormatJSON:
+		return readJSON(r, mapping)
+	default:
+		return nil, errors.New("import format must be CSV or JSON")
+	}
+}
+
+// readCSV reads a CSV file whose first row names the columns.
+func readCSV(r io.Reader, mapping Mapping) ([]record, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read CSV: %w", err)
+	}
+	// Spreadsheet programs often start UTF-8 files with a byte order mark.
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+
+	// Find the column of every field.
+	columns := map[string]int{}
+	for _, field := range Fields {
+		name, mapped := mapping[field]
+		if !mapped {
+			name = field
+		}
+		i := indexFold(header, name)
+		if i < 0 {
+			if mapped {
+				return nil, fmt.Errorf("CSV file has no column %q for %s", name, field)
+			}
+			continue
+		}
+		columns[field] = i
+	}
+
+	var records []record
+	for number := 1; ; number++ {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read CSV: %w", err)
+		}
+		if number > MaxRows {
+			return nil, ErrTooManyRows
+		}
+
+		rec := record{number: number, values: map[string]string{}}
+		for field, i := range columns {
+			if i < len(row) {
+				rec.values[field] = row[i]
+			}
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// readJSON reads a JSON array of objects, one per row.
+func readJSON(r io.Reader, mapping Mapping) ([]record, error) {
+	var rows []map[string]any
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	if err := decoder.Decode(&rows); err != nil {
+		return nil, fmt.Errorf("read JSON: expected an array of objects: %w", err)
+	}
+	if len(rows) > MaxRows {
+		return nil, ErrTooManyRows
+	}
+
+	records := make([]record, 0, len(rows))
+	for i, row := range rows {
+		rec := record{number: i + 1, values: map[string]string{}}
+		for _, field := range Fields {
+			name, mapped := mapping[field]
+			if !mapped {
+				name = field
+			}
+			for key, value := range row {
+				if strings.EqualFold(key, name) {
+					rec.values[field] = jsonString(value)
+					break
+				}
+			}
+		}
+		records = append(records, rec)
+	}
+
+	return records, nil
+}
+
+// jsonString returns a JSON value as the text it would have in a CSV file.
+func jsonString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// indexFold returns the index of the first of names equal to name ignoring
+// case and surrounding space, or -1.
+func indexFold(names []string, name string) int {
+	for i, n := range names {
+		if strings.EqualFold(strings.TrimSpace(n), strings.TrimSpace(name)) {
+			return i
+		}
+	}
+	return -1
+}
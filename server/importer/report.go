@@ -0,0 +1,36 @@
+package importer
+
+// Outcome is what an import does with a row. The numeric values
+// intentionally match the protobuf enum values.
+type Outcome int32
+
+const (
+	OutcomeUnspecified Outcome = 0
+	// OutcomeCreated rows become new job applications.
+	OutcomeCreated Outcome = 1
+	// OutcomeSkipped rows have an external ID that was imported before.
+	OutcomeSkipped Outcome = 2
+	// OutcomeInvalid rows cannot be imported; Result.Err says why.
+	OutcomeInvalid Outcome = 3
+)
+
+// Result is what an import did, or would do in a dry run, with a row.
+type Result struct {
+	Row        int
+	ExternalID string
+	Outcome    Outcome
+	Err        *RowError
+	// JobApplicationID is the ID of the job application created for the row.
+	JobApplicationID string
+}
+
+// Report sums up an import.
+type Report struct {
+	Results []Result
+	Created int
+	Skipped int
+	Invalid int
+	// Committed is false if nothing was saved, either because the import was
+	// a dry run or because some rows were invalid.
+	Committed bool
+}
@@ -0,0 +1,187 @@
+package kiseki
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// InterviewFormat is the domain enum for how an interview takes place. The
+// numeric values intentionally match the protobuf enum values.
+type InterviewFormat int32
+
+const (
+	InterviewFormatUnspecified InterviewFormat = 0
+	InterviewFormatPhone       InterviewFormat = 1
+	InterviewFormatVideo       InterviewFormat = 2
+	InterviewFormatOnsite      InterviewFormat = 3
+)
+
+// InterviewFormatToDB converts the domain enum to the DB enum label (no prefix), e.g. VIDEO.
+func InterviewFormatToDB(f InterviewFormat) string {
+	switch f {
+	case InterviewFormatPhone:
+		return "PHONE"
+	case InterviewFormatVideo:
+		return "VIDEO"
+	case InterviewFormatOnsite:
+		return "ONSITE"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// InterviewFormatFromDB converts a DB label to the domain enum. Case-insensitive.
+func InterviewFormatFromDB(db string) InterviewFormat {
+	switch strings.ToUpper(strings.TrimSpace(db)) {
+	case "PHONE":
+		return InterviewFormatPhone
+	case "VIDEO":
+		return InterviewFormatVideo
+	case "ONSITE":
+		return InterviewFormatOnsite
+	default:
+		return InterviewFormatUnspecified
+	}
+}
+
+// InterviewOutcome is the domain enum for how an interview went. The numeric
+// values intentionally match the protobuf enum values.
+type InterviewOutcome int32
+
+const (
+	InterviewOutcomeUnspecified InterviewOutcome = 0
+	InterviewOutcomePending     InterviewOutcome = 1
+	InterviewOutcomePassed      InterviewOutcome = 2
+	InterviewOutcomeFailed      InterviewOutcome = 3
+	InterviewOutcomeCancelled   InterviewOutcome = 4
+)
+
+// InterviewOutcomeToDB converts the domain enum to the DB enum label (no prefix), e.g. PASSED.
+func InterviewOutcomeToDB(o InterviewOutcome) string {
+	switch o {
+	case InterviewOutcomePending:
+		return "PENDING"
+	case InterviewOutcomePassed:
+		return "PASSED"
+	case InterviewOutcomeFailed:
+		return "FAILED"
+	case InterviewOutcomeCancelled:
+		return "CANCELLED"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+// InterviewOutcomeFromDB converts a DB label to the domain enum. Case-insensitive.
+func InterviewOutcomeFromDB(db string) InterviewOutcome {
+	switch strings.ToUpper(strings.TrimSpace(db)) {
+	case "PENDING":
+		return InterviewOutcomePending
+	case "PASSED":
+		return InterviewOutcomePassed
+	case "FAILED":
+		return InterviewOutcomeFailed
+	case "CANCELLED":
+		return InterviewOutcomeCancelled
+	default:
+		return InterviewOutcomeUnspecified
+	}
+}
+
+// Interview is one round of interviews for a job application.
+type Interview struct {
+	ID               string
+	UserID           string
+	JobApplicationID string
+	// Round names the interview, e.g. "Technical screen".
+	Round       string
+	ScheduledAt time.Time
+	// TimeZone is the IANA time zone the interview was scheduled in, so it
+	// can be shown in local time wherever it is viewed from.
+	TimeZone     string
+	Duration     time.Duration
+	Interviewers []string
+	Format       InterviewFormat
+	// Location is an address for onsite interviews, or a phone number or
+	// meeting link otherwise.
+	Location  string
+	Outcome   InterviewOutcome
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NewInterviewParams struct {
+	UserID           string
+	JobApplicationID string
+	Round            string
+	ScheduledAt      time.Time
+	TimeZone         string
+	Duration         time.Duration
+	Interviewers     []string
+	Format           InterviewFormat
+	Location         string
+}
+
+// NewInterview schedules an interview. Its outcome is pending until updated.
+func NewInterview(params NewInterviewParams) Interview {
+	now := time.Now()
+	return Interview{
+		ID:               uuid.New().String(),
+		UserID:           params.UserID,
+		JobApplicationID: params.JobApplicationID,
+		Round:            params.Round,
+		ScheduledAt:      params.ScheduledAt,
+		TimeZone:         params.TimeZone,
+		Duration:         params.Duration,
+		Interviewers:     params.Interviewers,
+		Format:           params.Format,
+		Location:         params.Location,
+		Outcome:          InterviewOutcomePending,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+type UpdateInterviewParams struct {
+	Round        string
+	ScheduledAt  time.Time
+	TimeZone     string
+	Duration     time.Duration
+	Interviewers []string
+	Format       InterviewFormat
+	Location     string
+	Outcome      InterviewOutcome
+}
+
+// Update replaces the interview's fields.
+func (i *Interview) Update(params UpdateInterviewParams) {
+	i.Round = params.Round
+	i.ScheduledAt = params.ScheduledAt
+	i.TimeZone = params.TimeZone
+	i.Duration = params.Duration
+	i.Interviewers = params.Interviewers
+	i.Format = params.Format
+	i.Location = params.Location
+	i.Outcome = params.Outcome
+	i.UpdatedAt = time.Now()
+}
+
+// InterviewCursor marks the last interview of a page of upcoming interviews.
+type InterviewCursor struct {
+	ScheduledAt time.Time
+	ID          string
+}
+
+// ListUpcomingInterviewsParams lists a user's interviews scheduled from a
+// point in time onwards, soonest first. Cancelled interviews and interviews
+// for deleted job applications are left out.
+type ListUpcomingInterviewsParams struct {
+	UserID string
+	From   time.Time
+	// After, if set, skips interviews up to and including the cursor.
+	After *InterviewCursor
+	// Limit caps the number of interviews returned. Zero means no limit.
+	Limit int
+}
@@ -1,6 +1,9 @@
 package kiseki
 
 import (
+	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,39 +24,86 @@ type JobApplication struct {
 	DeletedAt   *time.Time
 	AppliedOn   time.Time
 	Status      JobApplicationStatus
-	Position    string
+	// StatusChangedAt is when the job application entered its status.
+	StatusChangedAt time.Time
+	Position        string
+	// CVDocumentID and CoverLetterDocumentID refer to documents uploaded
+	// through the server, which replace the free-form CV and CoverLetter.
+	CVDocumentID          *string
+	CoverLetterDocumentID *string
+	// MatchScore rates from 0 to 100 how well the CV fits the description,
+	// as last scored. Nil if the job application has not been scored since
+	// either changed.
+	MatchScore *int32
+	// ExternalID identifies a job application imported from elsewhere, such
+	// as a spreadsheet row, so importing it again does not duplicate it.
+	ExternalID *string
+	// FollowUpOn is the day the user means to follow up on the job
+	// application, if they have picked one.
+	FollowUpOn *time.Time
+	// CompanyID is the company the job application is linked to. Company
+	// holds its name.
+	CompanyID *string
+	// Version counts the saved changes to the job application. Saving fails
+	// with ErrVersionConflict if it was changed since it was read.
+	Version int64
 }
 
+// ErrVersionConflict is returned when a job application was changed by
+// someone else since it was read.
+var ErrVersionConflict = errors.New("job application was changed by someone else")
+
 type NewJobApplicationParams struct {
-	UserID      string
-	Company     string
-	Title       string
-	Description *string
-	Notes       *string
-	CV          *string
-	CoverLetter *string
-	AppliedOn   time.Time
-	Status      JobApplicationStatus
-	Position    string
+	UserID                string
+	Company               string
+	Title                 string
+	Description           *string
+	Notes                 *string
+	CV                    *string
+	CoverLetter           *string
+	AppliedOn             time.Time
+	Status                JobApplicationStatus
+	Position              string
+	CVDocumentID          *string
+	CoverLetterDocumentID *string
+	ExternalID            *string
+	FollowUpOn            *time.Time
+	CompanyID             *string
 }
 
 func NewJobApplication(params NewJobApplicationParams) JobApplication {
 	now := time.Now()
 	return JobApplication{
-		ID:          uuid.New().String(),
-		UserID:      params.UserID,
-		Company:     params.Company,
-		Title:       params.Title,
-		Description: params.Description,
-		Notes:       params.Notes,
-		CV:          params.CV,
-		CoverLetter: params.CoverLetter,
-		CreatedAt:   now,
-		UpdatedAt:   now,
-		AppliedOn:   params.AppliedOn,
-		Status:      params.Status,
-		Position:    params.Position,
+		ID:                    uuid.New().String(),
+		UserID:                params.UserID,
+		Company:               params.Company,
+		Title:                 params.Title,
+		Description:           params.Description,
+		Notes:                 params.Notes,
+		CV:                    params.CV,
+		CoverLetter:           params.CoverLetter,
+		CreatedAt:             now,
+		UpdatedAt:             now,
+		AppliedOn:             params.AppliedOn,
+		Status:                params.Status,
+		StatusChangedAt:       now,
+		Position:              params.Position,
+		CVDocumentID:          params.CVDocumentID,
+		CoverLetterDocumentID: params.CoverLetterDocumentID,
+		ExternalID:            params.ExternalID,
+		FollowUpOn:            params.FollowUpOn,
+		CompanyID:             params.CompanyID,
+	}
+}
+
+// CheckVersion returns ErrVersionConflict unless version is the job
+// application's current version. A zero version skips the check, for clients
+// that do not track versions.
+func (j *JobApplication) CheckVersion(version int64) error {
+	if version != 0 && version != j.Version {
+		return ErrVersionConflict
 	}
+	return nil
 }
 
 func (j *JobApplication) Delete() {
@@ -61,6 +111,12 @@ func (j *JobApplication) Delete() {
 	j.DeletedAt = &now
 }
 
+// Restore takes a deleted job application back out of the trash.
+func (j *JobApplication) Restore() {
+	j.DeletedAt = nil
+	j.UpdatedAt = time.Now()
+}
+
 type UpdateJobApplicationParams struct {
 	Company     string
 	Title       string
@@ -71,21 +127,157 @@ type UpdateJobApplicationParams struct {
 	AppliedOn   time.Time
 	Status      JobApplicationStatus
 	Position    string
+	// CVDocumentID and CoverLetterDocumentID must name documents the owner
+	// of the job application uploaded.
+	CVDocumentID          *string
+	CoverLetterDocumentID *string
+	FollowUpOn            *time.Time
+	CompanyID             *string
+	// Fields limits the update to the listed fields, leaving the others as
+	// they are. Nil replaces every field.
+	Fields []JobApplicationField
+}
+
+// UpdatableFields are the fields UpdateJobApplicationParams.Fields may list.
+var UpdatableFields = []JobApplicationField{
+	JobApplicationFieldCompany,
+	JobApplicationFieldTitle,
+	JobApplicationFieldDescription,
+	JobApplicationFieldNotes,
+	JobApplicationFieldCV,
+	JobApplicationFieldCoverLetter,
+	JobApplicationFieldAppliedOn,
+	JobApplicationFieldStatus,
+	JobApplicationFieldPosition,
+	JobApplicationFieldCVDocumentID,
+	JobApplicationFieldCoverLetterDocumentID,
+	JobApplicationFieldFollowUpOn,
+	JobApplicationFieldCompanyID,
+}
+
+// UnknownFieldError is returned for a field path that does not name an
+// updatable field.
+type UnknownFieldError struct {
+	Path string
+}
+
+func (e *UnknownFieldError) Error() string {
+	return fmt.Sprintf("unknown field %q", e.Path)
+}
+
+// ParseUpdateFields converts field paths, such as those of a field mask, to
+// the fields they name. It returns an *UnknownFieldError for the first path
+// that is not an updatable field.
+func ParseUpdateFields(paths []string) ([]JobApplicationField, error) {
+	fields := make([]JobApplicationField, 0, len(paths))
+	for _, path := range paths {
+		field := JobApplicationField(path)
+		if !slices.Contains(UpdatableFields, field) {
+			return nil, &UnknownFieldError{Path: path}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// Update changes the job application's fields, or only params.Fields when
+// set. A status change is checked against policy first and nothing is
+// changed if it is not allowed.
+func (j *JobApplication) Update(params UpdateJobApplicationParams, policy TransitionPolicy) error {
+	updates := func(field JobApplicationField) bool {
+		return params.Fields == nil || slices.Contains(params.Fields, field)
+	}
+
+	if updates(JobApplicationFieldStatus) {
+		if err := policy.Check(j.Status, params.Status); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	j.UpdatedAt = now
+	description, cvDocumentID := j.Description, j.CVDocumentID
+
+	if updates(JobApplicationFieldCompany) {
+		j.Company = params.Company
+	}
+	if updates(JobApplicationFieldTitle) {
+		j.Title = params.Title
+	}
+	if updates(JobApplicationFieldDescription) {
+		j.Description = params.Description
+	}
+	if updates(JobApplicationFieldNotes) {
+		j.Notes = params.Notes
+	}
+	if updates(JobApplicationFieldCV) {
+		j.CV = params.CV
+	}
+	if updates(JobApplicationFieldCoverLetter) {
+		j.CoverLetter = params.CoverLetter
+	}
+	if updates(JobApplicationFieldAppliedOn) {
+		j.AppliedOn = params.AppliedOn
+	}
+	if updates(JobApplicationFieldStatus) {
+		j.setStatus(params.Status, now)
+	}
+	if updates(JobApplicationFieldPosition) {
+		j.Position = params.Position
+	}
+	if updates(JobApplicationFieldCVDocumentID) {
+		j.CVDocumentID = params.CVDocumentID
+	}
+	if updates(JobApplicationFieldCoverLetterDocumentID) {
+		j.CoverLetterDocumentID = params.CoverLetterDocumentID
+	}
+	if updates(JobApplicationFieldFollowUpOn) {
+		j.FollowUpOn = params.FollowUpOn
+	}
+	if updates(JobApplicationFieldCompanyID) {
+		j.CompanyID = params.CompanyID
+	}
+
+	// The score no longer says anything once the job or the CV changes.
+	if !equalStringPtr(description, j.Description) || !equalStringPtr(cvDocumentID, j.CVDocumentID) {
+		j.MatchScore = nil
+	}
+
+	return nil
+}
+
+// SetMatchScore records how well the CV fits the description, from 0 to 100.
+func (j *JobApplication) SetMatchScore(score int) {
+	s := int32(min(max(score, 0), 100))
+	j.MatchScore = &s
 }
 
-func (j *JobApplication) Update(params UpdateJobApplicationParams) {
+// LinkCompany links the job application to company, taking its name.
+func (j *JobApplication) LinkCompany(company *Company) {
+	j.CompanyID = &company.ID
+	j.Company = company.Name
+}
+
+// ChangeStatus moves the job application to status if policy allows it.
+func (j *JobApplication) ChangeStatus(status JobApplicationStatus, policy TransitionPolicy) error {
+	if err := policy.Check(j.Status, status); err != nil {
+		return err
+	}
+
 	now := time.Now()
+	j.setStatus(status, now)
 	j.UpdatedAt = now
 
-	j.Company = params.Company
-	j.Title = params.Title
-	j.Description = params.Description
-	j.Notes = params.Notes
-	j.CV = params.CV
-	j.CoverLetter = params.CoverLetter
-	j.AppliedOn = params.AppliedOn
-	j.Status = params.Status
-	j.Position = params.Position
+	return nil
+}
+
+// setStatus moves the job application to status, noting the time if the
+// status changes.
+func (j *JobApplication) setStatus(status JobApplicationStatus, at time.Time) {
+	if status != j.Status {
+		j.StatusChangedAt = at
+	}
+	j.Status = status
 }
 
 // JobApplicationStatus is the domain enum for job application status.
@@ -0,0 +1,91 @@
+package kiseki
+
+import "time"
+
+// JobApplicationSortKey is the domain enum for the order job applications are
+// listed in. The numeric values intentionally match the protobuf enum values.
+type JobApplicationSortKey int32
+
+const (
+	// JobApplicationSortKeyUnspecified sorts in board order: by status, then
+	// by position within the status.
+	JobApplicationSortKeyUnspecified JobApplicationSortKey = 0
+	JobApplicationSortKeyAppliedOn   JobApplicationSortKey = 1
+	JobApplicationSortKeyCreatedAt   JobApplicationSortKey = 2
+	JobApplicationSortKeyUpdatedAt   JobApplicationSortKey = 3
+	JobApplicationSortKeyCompany     JobApplicationSortKey = 4
+	// JobApplicationSortKeyMatchScore sorts by match score, with job
+	// applications that have not been scored below any score, so they come
+	// first in ascending order and last in descending order.
+	JobApplicationSortKeyMatchScore JobApplicationSortKey = 5
+)
+
+// JobApplicationFilter restricts the job applications returned by a list.
+// Zero values do not filter.
+type JobApplicationFilter struct {
+	Statuses []JobApplicationStatus
+	// Company matches job applications whose company contains it, ignoring case.
+	Company        string
+	AppliedOnFrom  *time.Time
+	AppliedOnTo    *time.Time
+	HasCV          *bool
+	HasCoverLetter *bool
+	// CVDocumentID and CoverLetterDocumentID, if set, match job applications
+	// that use the document.
+	CVDocumentID          string
+	CoverLetterDocumentID string
+	// CVQuery, if set, matches job applications whose CV's name, label or
+	// text matches it, in web search syntax.
+	CVQuery string
+}
+
+// JobApplicationCursor marks the last job application of a page. The next
+// page starts after it in the requested sort order.
+type JobApplicationCursor struct {
+	ID        string
+	Status    JobApplicationStatus
+	Position  string
+	AppliedOn time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Company   string
+	DeletedAt time.Time
+	// MatchScore is -1 for a job application that has not been scored.
+	MatchScore int32
+}
+
+// CursorFor returns a cursor positioned at jobApplication.
+func CursorFor(jobApplication *JobApplication) JobApplicationCursor {
+	var deletedAt time.Time
+	if jobApplication.DeletedAt != nil {
+		deletedAt = *jobApplication.DeletedAt
+	}
+
+	matchScore := int32(-1)
+	if jobApplication.MatchScore != nil {
+		matchScore = *jobApplication.MatchScore
+	}
+
+	return JobApplicationCursor{
+		ID:         jobApplication.ID,
+		Status:     jobApplication.Status,
+		Position:   jobApplication.Position,
+		AppliedOn:  jobApplication.AppliedOn,
+		CreatedAt:  jobApplication.CreatedAt,
+		UpdatedAt:  jobApplication.UpdatedAt,
+		Company:    jobApplication.Company,
+		DeletedAt:  deletedAt,
+		MatchScore: matchScore,
+	}
+}
+
+type ListJobApplicationsParams struct {
+	UserID     string
+	Filter     JobApplicationFilter
+	SortKey    JobApplicationSortKey
+	Descending bool
+	// After, if set, skips job applications up to and including the cursor.
+	After *JobApplicationCursor
+	// Limit caps the number of job applications returned. Zero means no limit.
+	Limit int
+}
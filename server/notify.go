@@ -0,0 +1,76 @@
+package kiseki
+
+import (
+	"context"
+	"time"
+)
+
+// Email is a message to a single recipient, with an HTML body and a plain
+// text alternative.
+type Email struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string
+	// UnsubscribeURL, if set, is offered to mail clients so the recipient can
+	// unsubscribe in one click.
+	UnsubscribeURL string
+}
+
+// Notifier delivers emails to users.
+type Notifier interface {
+	Send(ctx context.Context, email *Email) error
+}
+
+// DigestPeriod is how often the digest is sent, and the time it covers.
+const DigestPeriod = 7 * 24 * time.Hour
+
+// ActivityParams selects what happened to a user's job applications outside
+// the trash between Since and Until.
+type ActivityParams struct {
+	UserID string
+	Since  time.Time
+	Until  time.Time
+}
+
+// Activity is what happened to a user's job applications over a period.
+type Activity struct {
+	// Created are the job applications added, oldest first.
+	Created []*JobApplication
+	// StageMoves are the status changes of job applications that were not
+	// added in the period, oldest first.
+	StageMoves []StageMove
+}
+
+// StageMove is a job application moving from one status to another.
+type StageMove struct {
+	JobApplication *JobApplication
+	From           JobApplicationStatus
+	To             JobApplicationStatus
+	At             time.Time
+}
+
+// ScheduledInterview is an interview together with the job application it is
+// for.
+type ScheduledInterview struct {
+	Interview      *Interview
+	JobApplication *JobApplication
+}
+
+// Digest sums up a user's job search over a period.
+type Digest struct {
+	Since time.Time
+	Until time.Time
+	Activity
+	// UpcomingInterviews are the interviews scheduled for the coming period,
+	// soonest first.
+	UpcomingInterviews []ScheduledInterview
+	// Stale are the job applications ghosted under the user's rules, longest
+	// overdue first.
+	Stale []*FollowUp
+}
+
+// Empty reports whether the digest has nothing to tell.
+func (d *Digest) Empty() bool {
+	return len(d.Created) == 0 && len(d.StageMoves) == 0 && len(d.UpcomingInterviews) == 0 && len(d.Stale) == 0
+}
@@ -0,0 +1,202 @@
+// Package notify renders the emails sent to users and delivers them over
+// SMTP.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"kiseki"
+)
+
+// SMTPConfig configures the SMTP server emails are sent through.
+type SMTPConfig struct {
+	Host string
+	Port int
+	// Username and Password, if set, authenticate with PLAIN, which the
+	// connection must be encrypted for unless Host is localhost.
+	Username string
+	Password string
+	// From is the sender, e.g. "Kiseki <noreply@example.com>".
+	From string
+	// ImplicitTLS connects over TLS from the start, usually on port 465.
+	// Otherwise STARTTLS is used when the server offers it.
+	ImplicitTLS bool
+}
+
+// NewSMTPNotifier returns a kiseki.Notifier that sends emails through the
+// SMTP server configured by config.
+func NewSMTPNotifier(config SMTPConfig) (kiseki.Notifier, error) {
+	from, err := mail.ParseAddress(config.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender %q: %w", config.From, err)
+	}
+	return &smtpNotifier{config: config, from: from}, nil
+}
+
+type smtpNotifier struct {
+	config SMTPConfig
+	from   *mail.Address
+}
+
+func (n *smtpNotifier) Send(ctx context.Context, email *kiseki.Email) error {
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return fmt.Errorf("invalid recipient %q: %w", email.To, err)
+	}
+
+	msg, err := n.message(to, email)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(n.config.Host, strconv.Itoa(n.config.Port))
+	dialer := &net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if n.config.ImplicitTLS {
+		conn = tls.Client(conn, &tls.Config{ServerName: n.config.Host})
+	}
+
+	// net/smtp does not take a context, so stop talking when it ends.
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	client, err := smtp.NewClient(conn, n.config.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if err := n.deliver(client, to, msg); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return err
+	}
+	return nil
+}
+
+// deliver sends msg from the sender to to over client.
+func (n *smtpNotifier) deliver(client *smtp.Client, to *mail.Address, msg []byte) error {
+	if !n.config.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(&tls.Config{ServerName: n.config.Host}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if n.config.Username != "" {
+		auth := smtp.PlainAuth("", n.config.Username, n.config.Password, n.config.Host)
+		if err := client.Auth(auth); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to.Address); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return client.Quit()
+}
+
+// message returns email as a multipart/alternative MIME message with CRLF
+// line endings.
+func (n *smtpNotifier) message(to *mail.Address, email *kiseki.Email) ([]byte, error) {
+	if strings.ContainsAny(email.UnsubscribeURL, "\r\n<>") {
+		return nil, errors.New("invalid unsubscribe URL")
+	}
+
+	var body bytes.Buffer
+	parts := multipart.NewWriter(&body)
+	for _, alt := range []struct{ contentType, content string }{
+		{"text/plain; charset=utf-8", email.Text},
+		{"text/html; charset=utf-8", email.HTML},
+	} {
+		w, err := parts.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {alt.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(crlf(alt.content))); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := parts.Close(); err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	header := func(name, value string) {
+		fmt.Fprintf(&msg, "%s: %s\r\n", name, value)
+	}
+	header("From", n.from.String())
+	header("To", to.String())
+	header("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", n.messageID())
+	if email.UnsubscribeURL != "" {
+		header("List-Unsubscribe", "<"+email.UnsubscribeURL+">")
+		header("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	header("MIME-Version", "1.0")
+	header("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), nil
+}
+
+// messageID returns a new Message-ID in the sender's domain.
+func (n *smtpNotifier) messageID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	domain := n.from.Address[strings.LastIndexByte(n.from.Address, '@')+1:]
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
+
+// crlf returns s with every line ending in CRLF, as SMTP requires.
+func crlf(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.ReplaceAll(s, "\n", "\r\n")
+}
@@ -0,0 +1,166 @@
+package notify
+
+import (
+	"context"
+	"mime"
+	"net/mail"
+	"strings"
+	"testing"
+
+	"kiseki"
+	"kiseki/notify/smtptest"
+)
+
+func newTestNotifier(t *testing.T) (kiseki.Notifier, *smtptest.Server) {
+	t.Helper()
+	server, err := smtptest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { server.Close() })
+
+	notifier, err := NewSMTPNotifier(SMTPConfig{
+		Host: server.Host,
+		Port: server.Port,
+		From: "Kiseki <noreply@kiseki.example>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return notifier, server
+}
+
+func TestSMTPNotifierSend(t *testing.T) {
+	notifier, server := newTestNotifier(t)
+
+	err := notifier.Send(context.Background(), &kiseki.Email{
+		To:             "Jo Doe <jo@example.com>",
+		Subject:        "Your week — in job applications",
+		Text:           "First line\nSecond line, with a long enough tail that quoted-printable has to wrap it somewhere along the way\n",
+		HTML:           "<p>Café</p>\n",
+		UnsubscribeURL: "https://kiseki.example/unsubscribe?token=abc",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := server.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+	msg := messages[0]
+	if msg.From != "noreply@kiseki.example" {
+		t.Errorf("envelope sender = %q", msg.From)
+	}
+	if len(msg.To) != 1 || msg.To[0] != "jo@example.com" {
+		t.Errorf("envelope recipients = %q", msg.To)
+	}
+
+	header, parts, err := msg.Parts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(header.Get("Subject"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := mail.ParseAddress(header.Get("To"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{
+		"From":                  header.Get("From"),
+		"To":                    to.String(),
+		"Subject":               subject,
+		"List-Unsubscribe":      header.Get("List-Unsubscribe"),
+		"List-Unsubscribe-Post": header.Get("List-Unsubscribe-Post"),
+		"MIME-Version":          header.Get("MIME-Version"),
+	}
+	want := map[string]string{
+		"From":                  `"Kiseki" <noreply@kiseki.example>`,
+		"To":                    `"Jo Doe" <jo@example.com>`,
+		"Subject":               "Your week — in job applications",
+		"List-Unsubscribe":      "<https://kiseki.example/unsubscribe?token=abc>",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+		"MIME-Version":          "1.0",
+	}
+	for name := range want {
+		if got[name] != want[name] {
+			t.Errorf("%s = %q, want %q", name, got[name], want[name])
+		}
+	}
+	if id := header.Get("Message-ID"); !strings.HasSuffix(id, "@kiseki.example>") {
+		t.Errorf("Message-ID = %q, want one in the sender's domain", id)
+	}
+	if _, err := header.Date(); err != nil {
+		t.Errorf("Date: %v", err)
+	}
+
+	// The server reads the message with its line endings normalised.
+	wantText := "First line\nSecond line, with a long enough tail that quoted-printable has to wrap it somewhere along the way\n"
+	if got := parts["text/plain"]; got != wantText {
+		t.Errorf("text part = %q, want %q", got, wantText)
+	}
+	if got := parts["text/html"]; got != "<p>Café</p>\n" {
+		t.Errorf("html part = %q", got)
+	}
+}
+
+func TestMessageLineEndings(t *testing.T) {
+	from, err := mail.ParseAddress("noreply@kiseki.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &smtpNotifier{from: from}
+
+	msg, err := n.message(&mail.Address{Address: "jo@example.com"}, &kiseki.Email{
+		Subject: "Hello",
+		Text:    "One\nTwo\r\nThree\n",
+		HTML:    "<p>One</p>\n<p>Two</p>\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bare := strings.Count(string(msg), "\n") - strings.Count(string(msg), "\r\n"); bare != 0 {
+		t.Errorf("message has %d bare LF line endings", bare)
+	}
+}
+
+func TestSMTPNotifierSendWithoutUnsubscribeURL(t *testing.T) {
+	notifier, server := newTestNotifier(t)
+
+	err := notifier.Send(context.Background(), &kiseki.Email{
+		To:      "jo@example.com",
+		Subject: "Hello",
+		Text:    "Hello\n",
+		HTML:    "<p>Hello</p>\n",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	header, _, err := server.Messages()[0].Parts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := header.Get("List-Unsubscribe"); got != "" {
+		t.Errorf("List-Unsubscribe = %q, want none", got)
+	}
+}
+
+func TestSMTPNotifierSendRejectsBadInput(t *testing.T) {
+	notifier, server := newTestNotifier(t)
+
+	for name, email := range map[string]*kiseki.Email{
+		"recipient":       {To: "not an address", Subject: "Hello"},
+		"unsubscribe url": {To: "jo@example.com", Subject: "Hello", UnsubscribeURL: "https://kiseki.example/\r\nBcc: eve@example.com"},
+	} {
+		if err := notifier.Send(context.Background(), email); err == nil {
+			t.Errorf("%s: Send succeeded, want an error", name)
+		}
+	}
+	if n := len(server.Messages()); n != 0 {
+		t.Errorf("got %d messages, want none", n)
+	}
+}
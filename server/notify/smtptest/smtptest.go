@@ -0,0 +1,188 @@
+// Package smtptest runs an in-process SMTP server that keeps the messages
+// sent to it, for testing code that sends email.
+package smtptest
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// Message is a message the server accepted.
+type Message struct {
+	// From and To are the envelope sender and recipients.
+	From string
+	To   []string
+	// Data is the message as sent, headers and body.
+	Data []byte
+}
+
+// Server is an SMTP server listening on the loopback interface. It offers
+// no extensions, so clients neither start TLS nor authenticate.
+type Server struct {
+	Host string
+	Port int
+
+	listener net.Listener
+	wg       sync.WaitGroup
+
+	mu       sync.Mutex
+	messages []Message
+}
+
+// NewServer starts a Server on a free port. Close it when done.
+func NewServer() (*Server, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	addr := l.Addr().(*net.TCPAddr)
+	s := &Server{Host: addr.IP.String(), Port: addr.Port, listener: l}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Messages returns the messages accepted so far, oldest first.
+func (s *Server) Messages() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Close stops the server and waits for open sessions to end.
+func (s *Server) Close() error {
+	err := s.listener.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer conn.Close()
+			s.session(textproto.NewConn(conn))
+		}()
+	}
+}
+
+// session talks SMTP with one client until it quits or hangs up.
+func (s *Server) session(c *textproto.Conn) {
+	var msg Message
+	reply := func(format string, args ...any) bool {
+		return c.PrintfLine(format, args...) == nil
+	}
+
+	if !reply("220 localhost smtptest") {
+		return
+	}
+	for {
+		line, err := c.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+
+		var ok bool
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			ok = reply("250 localhost")
+		case "MAIL":
+			msg = Message{From: path(arg, "FROM:")}
+			ok = reply("250 OK")
+		case "RCPT":
+			msg.To = append(msg.To, path(arg, "TO:"))
+			ok = reply("250 OK")
+		case "DATA":
+			if !reply("354 End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := io.ReadAll(c.DotReader())
+			if err != nil {
+				return
+			}
+			msg.Data = data
+			s.mu.Lock()
+			s.messages = append(s.messages, msg)
+			s.mu.Unlock()
+			ok = reply("250 OK")
+		case "RSET", "NOOP":
+			ok = reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			return
+		default:
+			ok = reply("502 Command not implemented")
+		}
+		if !ok {
+			return
+		}
+	}
+}
+
+// path returns the address in a MAIL or RCPT argument such as
+// "FROM:<a@example.com>".
+func path(arg, prefix string) string {
+	if len(arg) >= len(prefix) && strings.EqualFold(arg[:len(prefix)], prefix) {
+		arg = arg[len(prefix):]
+	}
+	arg, _, _ = strings.Cut(strings.TrimSpace(arg), " ")
+	return strings.Trim(arg, "<>")
+}
+
+// Parts parses the message, returning its header and the decoded body of
+// each part by media type. A message that is not multipart has one part.
+func (m Message) Parts() (mail.Header, map[string]string, error) {
+	msg, err := mail.ReadMessage(strings.NewReader(string(m.Data)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		body, err := io.ReadAll(msg.Body)
+		if err != nil {
+			return nil, nil, err
+		}
+		return msg.Header, map[string]string{mediaType: string(body)}, nil
+	}
+
+	parts := map[string]string{}
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		// NextPart undoes quoted-printable encoding.
+		p, err := r.NextPart()
+		if errors.Is(err, io.EOF) {
+			return msg.Header, parts, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		partType, _, err := mime.ParseMediaType(p.Header.Get("Content-Type"))
+		if err != nil {
+			return nil, nil, err
+		}
+		body, err := io.ReadAll(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		parts[partType] = string(body)
+	}
+}
@@ -0,0 +1,89 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"kiseki"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+// statusNames are the statuses as written in emails.
+var statusNames = map[kiseki.JobApplicationStatus]string{
+	kiseki.JobApplicationStatusApplied:   "applied",
+	kiseki.JobApplicationStatusScreening: "screening",
+	kiseki.JobApplicationStatusInterview: "interviewing",
+	kiseki.JobApplicationStatusOffer:     "offered",
+	kiseki.JobApplicationStatusRejected:  "rejected",
+	kiseki.JobApplicationStatusWithdrawn: "withdrawn",
+	kiseki.JobApplicationStatusAccepted:  "accepted",
+}
+
+var templateFuncs = map[string]any{
+	"status": func(s kiseki.JobApplicationStatus) string { return statusNames[s] },
+	"date":   func(t time.Time) string { return t.Format("Mon 2 Jan 2006") },
+	// dateTime shows t in the IANA time zone tz, or in UTC if there is none.
+	"dateTime": func(t time.Time, tz string) string {
+		if loc, err := time.LoadLocation(tz); err == nil && tz != "" {
+			t = t.In(loc)
+		}
+		return t.Format("Mon 2 Jan 2006 15:04 MST")
+	},
+	"scheduled": func(r kiseki.FollowUpReason) bool { return r == kiseki.FollowUpReasonScheduled },
+}
+
+var (
+	htmlTemplates = htmltemplate.Must(htmltemplate.New("").Funcs(templateFuncs).ParseFS(templateFS, "templates/*.html"))
+	textTemplates = texttemplate.Must(texttemplate.New("").Funcs(templateFuncs).ParseFS(templateFS, "templates/*.txt"))
+)
+
+// digestData is what the digest templates are rendered with.
+type digestData struct {
+	*kiseki.Digest
+	UnsubscribeURL string
+}
+
+// reminderData is what the reminder templates are rendered with.
+type reminderData struct {
+	*kiseki.FollowUp
+	UnsubscribeURL string
+}
+
+// Digest returns the digest email to send to to.
+func Digest(to string, digest *kiseki.Digest, unsubscribeURL string) (*kiseki.Email, error) {
+	return render("digest", digestData{Digest: digest, UnsubscribeURL: unsubscribeURL}, &kiseki.Email{
+		To:             to,
+		Subject:        "Your week in job applications",
+		UnsubscribeURL: unsubscribeURL,
+	})
+}
+
+// Reminder returns the email reminding to of a follow-up.
+func Reminder(to string, followUp *kiseki.FollowUp, unsubscribeURL string) (*kiseki.Email, error) {
+	ja := followUp.JobApplication
+	return render("reminder", reminderData{FollowUp: followUp, UnsubscribeURL: unsubscribeURL}, &kiseki.Email{
+		To:             to,
+		Subject:        "Follow up: " + ja.Title + " at " + ja.Company,
+		UnsubscribeURL: unsubscribeURL,
+	})
+}
+
+// render fills in email's bodies from the templates called name.
+func render(name string, data any, email *kiseki.Email) (*kiseki.Email, error) {
+	var html, text bytes.Buffer
+	if err := htmlTemplates.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return nil, err
+	}
+	if err := textTemplates.ExecuteTemplate(&text, name+".txt", data); err != nil {
+		return nil, err
+	}
+	email.HTML = html.String()
+	email.Text = strings.TrimSpace(text.String()) + "\n"
+	return email, nil
+}
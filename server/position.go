@@ -0,0 +1,23 @@
+package kiseki
+
+import "errors"
+
+// ErrPositionTaken is returned when saving a job application at a position
+// another job application in its group took since it was placed.
+var ErrPositionTaken = errors.New("position is taken by another job application")
+
+// PositionGroup is one board column: a user's job applications with the same
+// status. Positions are only ordered, and only need to be unique, within a
+// group.
+type PositionGroup struct {
+	UserID string
+	Status JobApplicationStatus
+}
+
+// PositionGroupFor returns the group jobApplication is positioned in.
+func PositionGroupFor(jobApplication *JobApplication) PositionGroup {
+	return PositionGroup{
+		UserID: jobApplication.UserID,
+		Status: jobApplication.Status,
+	}
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/samber/lo"
+)
+
+func (r *jobApplicationRepository) Activity(ctx context.Context, params kiseki.ActivityParams) (*kiseki.Activity, error) {
+	activity := &kiseki.Activity{}
+
+	query, args, err := sq.Select(jobApplicationColumns...).
+		From("job_applications").
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(sq.Eq{"deleted_at": nil}).
+		Where(sq.GtOrEq{"created_at": params.Since}).
+		Where(sq.Lt{"created_at": params.Until}).
+		OrderBy("created_at", "id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	if activity.Created, err = scanJobApplications(rows); err != nil {
+		return nil, err
+	}
+
+	if activity.StageMoves, err = r.stageMoves(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return activity, nil
+}
+
+// stageMoves lists the status changes made over the period to job
+// applications added before it. The status a job application is added with
+// is not a move.
+func (r *jobApplicationRepository) stageMoves(ctx context.Context, params kiseki.ActivityParams) ([]kiseki.StageMove, error) {
+	columns := lo.Map(jobApplicationColumns, func(c string, _ int) string { return "ja." + c })
+
+	query, args, err := sq.Select(columns...).
+		Columns("s.from_status", "s.to_status", "s.changed_at").
+		From("job_application_status_changes s").
+		Join("job_applications ja ON ja.id = s.job_application_id").
+		Where(sq.Eq{"ja.user_id": params.UserID}).
+		Where(sq.Eq{"ja.deleted_at": nil}).
+		Where(sq.NotEq{"s.from_status": nil}).
+		Where(sq.Lt{"ja.created_at": params.Since}).
+		Where(sq.GtOrEq{"s.changed_at": params.Since}).
+		Where(sq.Lt{"s.changed_at": params.Until}).
+		OrderBy("s.changed_at", "s.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var moves []kiseki.StageMove
+	for rows.Next() {
+		var ja kiseki.JobApplication
+		var statusStr, from, to string
+		var move kiseki.StageMove
+		dest := append(jobApplicationDest(&ja, &statusStr), &from, &to, &move.At)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		ja.Status = kiseki.StatusFromDB(statusStr)
+		move.JobApplication = &ja
+		move.From = kiseki.StatusFromDB(from)
+		move.To = kiseki.StatusFromDB(to)
+		moves = append(moves, move)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return moves, nil
+}
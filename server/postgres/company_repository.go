@@ -0,0 +1,269 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// uniqueViolation is the SQLSTATE of a unique constraint violation.
+const uniqueViolation = "23505"
+
+func NewCompanyRepository(pool *pgxpool.Pool) kiseki.CompanyRepository {
+	return &companyRepository{pool: pool}
+}
+
+type companyRepository struct {
+	pool *pgxpool.Pool
+}
+
+// querier runs queries on the pool or in a transaction.
+type querier interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// companyColumns are the columns scanCompany reads, in order.
+var companyColumns = []string{
+	"c.id",
+	"c.user_id",
+	"c.name",
+	"c.website",
+	"c.location",
+	"c.size",
+	"c.industry",
+	"c.notes",
+	"c.created_at",
+	"c.updated_at",
+}
+
+func (r *companyRepository) Save(ctx context.Context, company *kiseki.Company) error {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	query, args, err := sq.Insert("companies").
+		Columns(
+			"id",
+			"user_id",
+			"name",
+			"website",
+			"location",
+			"size",
+			"industry",
+			"notes",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			company.ID,
+			company.UserID,
+			company.Name,
+			company.Website,
+			company.Location,
+			kiseki.CompanySizeToDB(company.Size),
+			company.Industry,
+			company.Notes,
+			company.CreatedAt,
+			company.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			website = EXCLUDED.website,
+			location = EXCLUDED.location,
+			size = EXCLUDED.size,
+			industry = EXCLUDED.industry,
+			notes = EXCLUDED.notes,
+			updated_at = EXCLUDED.updated_at`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return kiseki.ErrCompanyExists
+	}
+	if err != nil {
+		return err
+	}
+
+	// Linked job applications show the company's name. Bumping their
+	// version makes clients holding the old name reload them.
+	query, args, err = sq.Update("job_applications").
+		Set("company", company.Name).
+		Set("updated_at", company.UpdatedAt).
+		Set("version", sq.Expr("version + 1")).
+		Where(sq.Eq{"company_id": company.ID}).
+		Where(sq.NotEq{"company": company.Name}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *companyRepository) Find(ctx context.Context, id string) (*kiseki.Company, error) {
+	query, args, err := sq.Select(companyColumns...).
+		From("companies c").
+		Where(sq.Eq{"c.id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	company, err := scanCompany(r.pool.QueryRow(ctx, query, args...))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return company, nil
+}
+
+func (r *companyRepository) FindOrCreate(ctx context.Context, userID string, name string) (*kiseki.Company, error) {
+	return findOrCreateCompany(ctx, r.pool, userID, name)
+}
+
+// findOrCreateCompany returns the user's company by the name, creating it if
+// there is none. Names are compared by normalize_company_name.
+func findOrCreateCompany(ctx context.Context, q querier, userID string, name string) (*kiseki.Company, error) {
+	company := kiseki.NewCompany(kiseki.NewCompanyParams{UserID: userID, Name: name})
+
+	query, args, err := sq.Insert("companies").
+		Columns("id", "user_id", "name", "created_at", "updated_at").
+		Values(company.ID, company.UserID, company.Name, company.CreatedAt, company.UpdatedAt).
+		Suffix("ON CONFLICT (user_id, normalized_name) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := q.Exec(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	query, args, err = sq.Select(companyColumns...).
+		From("companies c").
+		Where(sq.Eq{"c.user_id": userID}).
+		Where(sq.Expr("c.normalized_name = normalize_company_name(?)", company.Name)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanCompany(q.QueryRow(ctx, query, args...))
+}
+
+func (r *companyRepository) List(ctx context.Context, params kiseki.ListCompaniesParams) ([]*kiseki.CompanySummary, error) {
+	query, args, err := sq.Select(companyColumns...).
+		Columns(
+			`coalesce((
+				SELECT json_object_agg(status, n) FROM (
+					SELECT ja.status, count(*) AS n FROM job_applications ja
+					WHERE ja.company_id = c.id AND ja.deleted_at IS NULL
+					GROUP BY ja.status
+				) counts
+			), '{}')`,
+			`(SELECT max(ja.applied_on) FROM job_applications ja WHERE ja.company_id = c.id AND ja.deleted_at IS NULL)`,
+		).
+		From("companies c").
+		Where(sq.Eq{"c.user_id": params.UserID}).
+		OrderBy("lower(c.name)", "c.id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []*kiseki.CompanySummary
+	for rows.Next() {
+		var c kiseki.Company
+		var size string
+		var countsJSON []byte
+		var lastAppliedOn *time.Time
+		dest := append(companyDest(&c, &size), &countsJSON, &lastAppliedOn)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		c.Size = kiseki.CompanySizeFromDB(size)
+
+		var counts map[string]int
+		if err := json.Unmarshal(countsJSON, &counts); err != nil {
+			return nil, err
+		}
+		summary := &kiseki.CompanySummary{
+			Company:       &c,
+			StatusCounts:  make(map[kiseki.JobApplicationStatus]int, len(counts)),
+			LastAppliedOn: lastAppliedOn,
+		}
+		for status, n := range counts {
+			summary.StatusCounts[kiseki.StatusFromDB(status)] = n
+		}
+		summaries = append(summaries, summary)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// companyDest returns the scan destinations for companyColumns. The size
+// label is scanned into size for the caller to convert.
+func companyDest(c *kiseki.Company, size *string) []any {
+	return []any{
+		&c.ID,
+		&c.UserID,
+		&c.Name,
+		&c.Website,
+		&c.Location,
+		size,
+		&c.Industry,
+		&c.Notes,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	}
+}
+
+// scanCompany reads a company selected with companyColumns.
+func scanCompany(row pgx.Row) (*kiseki.Company, error) {
+	var c kiseki.Company
+	var size string
+	if err := row.Scan(companyDest(&c, &size)...); err != nil {
+		return nil, err
+	}
+	c.Size = kiseki.CompanySizeFromDB(size)
+	return &c, nil
+}
@@ -0,0 +1,260 @@
+package postgres
+
+import (
+	"context"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
+)
+
+func NewContactRepository(pool *pgxpool.Pool) kiseki.ContactRepository {
+	return &contactRepository{pool: pool}
+}
+
+type contactRepository struct {
+	pool *pgxpool.Pool
+}
+
+// contactColumns are the columns scanContact reads, in order.
+var contactColumns = []string{
+	"contacts.id",
+	"contacts.user_id",
+	"contacts.name",
+	"contacts.role",
+	"contacts.email",
+	"contacts.phone",
+	"contacts.linkedin_url",
+	"contacts.company_id",
+	"contacts.created_at",
+	"contacts.updated_at",
+}
+
+func (r *contactRepository) Save(ctx context.Context, contact *kiseki.Contact) error {
+	query, args, err := sq.Insert("contacts").
+		Columns(
+			"id",
+			"user_id",
+			"name",
+			"role",
+			"email",
+			"phone",
+			"linkedin_url",
+			"company_id",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			contact.ID,
+			contact.UserID,
+			contact.Name,
+			contact.Role,
+			contact.Email,
+			contact.Phone,
+			contact.LinkedInURL,
+			contact.CompanyID,
+			contact.CreatedAt,
+			contact.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name,
+			role = EXCLUDED.role,
+			email = EXCLUDED.email,
+			phone = EXCLUDED.phone,
+			linkedin_url = EXCLUDED.linkedin_url,
+			company_id = EXCLUDED.company_id,
+			updated_at = EXCLUDED.updated_at`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *contactRepository) Find(ctx context.Context, id string) (*kiseki.Contact, error) {
+	query, args, err := sq.Select(contactColumns...).
+		From("contacts").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	contact, err := scanContact(r.pool.QueryRow(ctx, query, args...))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+func (r *contactRepository) Delete(ctx context.Context, id string) error {
+	query, args, err := sq.Delete("contacts").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *contactRepository) List(ctx context.Context, params kiseki.ListContactsParams) ([]*kiseki.Contact, error) {
+	builder := sq.Select(contactColumns...).
+		From("contacts").
+		Where(sq.Eq{"contacts.user_id": params.UserID}).
+		OrderBy("lower(contacts.name)", "contacts.id")
+
+	if params.CompanyID != nil {
+		builder = builder.Where(sq.Eq{"contacts.company_id": *params.CompanyID})
+	}
+
+	if params.JobApplicationID != nil {
+		builder = builder.
+			Join("job_application_contacts ON job_application_contacts.contact_id = contacts.id").
+			Where(sq.Eq{"job_application_contacts.job_application_id": *params.JobApplicationID})
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var contacts []*kiseki.Contact
+	for rows.Next() {
+		contact, err := scanContact(rows)
+		if err != nil {
+			return nil, err
+		}
+		contacts = append(contacts, contact)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+func (r *contactRepository) Link(ctx context.Context, contactID string, jobApplicationID string) error {
+	query, args, err := sq.Insert("job_application_contacts").
+		Columns("contact_id", "job_application_id", "user_id", "created_at").
+		Select(sq.Select("id").
+			Column(sq.Expr("?::TEXT", jobApplicationID)).
+			Columns("user_id", "NOW()").
+			From("contacts").
+			Where(sq.Eq{"id": contactID})).
+		Suffix("ON CONFLICT (contact_id, job_application_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *contactRepository) Unlink(ctx context.Context, contactID string, jobApplicationID string) error {
+	query, args, err := sq.Delete("job_application_contacts").
+		Where(sq.Eq{"contact_id": contactID}).
+		Where(sq.Eq{"job_application_id": jobApplicationID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *contactRepository) Timeline(ctx context.Context, contactID string) ([]*kiseki.ContactTimelineEntry, error) {
+	columns := lo.Map(jobApplicationColumns, func(c string, _ int) string { return "job_applications." + c })
+
+	query, args, err := sq.Select(columns...).
+		Column("job_application_contacts.created_at").
+		Column(exportHistory).
+		From("job_applications").
+		Join("job_application_contacts ON job_application_contacts.job_application_id = job_applications.id").
+		Where(sq.Eq{"job_application_contacts.contact_id": contactID}).
+		Where(sq.Eq{"job_applications.deleted_at": nil}).
+		OrderBy("job_applications.applied_on ASC", "job_applications.created_at ASC", "job_applications.id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*kiseki.ContactTimelineEntry
+	for rows.Next() {
+		var ja kiseki.JobApplication
+		var statusStr string
+		var historyJSON []byte
+		entry := &kiseki.ContactTimelineEntry{JobApplication: &ja}
+		dest := append(jobApplicationDest(&ja, &statusStr), &entry.LinkedAt, &historyJSON)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		ja.Status = kiseki.StatusFromDB(statusStr)
+
+		if entry.History, err = exportedHistory(&ja, historyJSON); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// scanContact reads a contact selected with contactColumns.
+func scanContact(row pgx.Row) (*kiseki.Contact, error) {
+	var c kiseki.Contact
+	err := row.Scan(
+		&c.ID,
+		&c.UserID,
+		&c.Name,
+		&c.Role,
+		&c.Email,
+		&c.Phone,
+		&c.LinkedInURL,
+		&c.CompanyID,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
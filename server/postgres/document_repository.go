@@ -0,0 +1,286 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// foreignKeyViolation is the SQLSTATE of a foreign key violation.
+const foreignKeyViolation = "23503"
+
+func NewDocumentRepository(pool *pgxpool.Pool) kiseki.DocumentRepository {
+	return &documentRepository{pool: pool}
+}
+
+type documentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// documentColumns are the document columns every query selects, in the order
+// documentDest scans them.
+var documentColumns = []string{
+	"documents.id",
+	"documents.user_id",
+	"documents.kind",
+	"documents.name",
+	"documents.label",
+	"documents.content_type",
+	"documents.size",
+	"documents.storage_key",
+	"documents.text",
+	"documents.created_at",
+}
+
+func (r *documentRepository) Save(ctx context.Context, document *kiseki.Document) error {
+	query, args, err := sq.Insert("documents").
+		Columns(
+			"id",
+			"user_id",
+			"kind",
+			"name",
+			"label",
+			"content_type",
+			"size",
+			"storage_key",
+			"text",
+			"created_at",
+		).
+		Values(
+			document.ID,
+			document.UserID,
+			kiseki.DocumentKindToDB(document.Kind),
+			document.Name,
+			document.Label,
+			document.ContentType,
+			document.Size,
+			document.StorageKey,
+			document.Text,
+			document.CreatedAt,
+		).
+		// Job applications, including those in the trash, refer to a
+		// document as a CV or a cover letter, so its kind is kept while any
+		// does.
+		Suffix(`ON CONFLICT (id) DO UPDATE SET
+			kind = EXCLUDED.kind,
+			name = EXCLUDED.name,
+			label = EXCLUDED.label
+		WHERE documents.kind = EXCLUDED.kind
+			OR NOT EXISTS (
+				SELECT 1 FROM job_applications
+				WHERE cv_document_id = documents.id
+					OR cover_letter_document_id = documents.id
+			)`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return kiseki.ErrDocumentInUse
+	}
+	return nil
+}
+
+func (r *documentRepository) Find(ctx context.Context, id string) (*kiseki.Document, error) {
+	query, args, err := sq.Select(documentColumns...).
+		From("documents").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := scanDocument(r.pool.QueryRow(ctx, query, args...))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+// documentApplicationCount counts the job applications outside the trash that
+// use a document.
+const documentApplicationCount = `(
+	SELECT count(*) FROM job_applications
+	WHERE job_applications.deleted_at IS NULL
+	AND (job_applications.cv_document_id = documents.id OR job_applications.cover_letter_document_id = documents.id)
+)`
+
+func (r *documentRepository) List(ctx context.Context, params kiseki.ListDocumentsParams) ([]*kiseki.DocumentSummary, error) {
+	builder := sq.Select(documentColumns...).
+		Column(documentApplicationCount).
+		From("documents").
+		Where(sq.Eq{"documents.user_id": params.UserID}).
+		OrderBy("documents.created_at DESC", "documents.id DESC")
+
+	if params.Kind != kiseki.DocumentKindUnspecified {
+		builder = builder.Where(sq.Eq{"documents.kind": kiseki.DocumentKindToDB(params.Kind)})
+	}
+
+	if params.After != nil {
+		builder = builder.Where(sq.Expr("(documents.created_at, documents.id) < (?, ?)", params.After.CreatedAt, params.After.ID))
+	}
+
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var summaries []*kiseki.DocumentSummary
+	for rows.Next() {
+		var d kiseki.Document
+		var kind string
+		var count int
+		if err := rows.Scan(append(documentDest(&d, &kind), &count)...); err != nil {
+			return nil, err
+		}
+		d.Kind = kiseki.DocumentKindFromDB(kind)
+		summaries = append(summaries, &kiseki.DocumentSummary{Document: &d, ApplicationCount: count})
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// documentHeadlineOptions configures ts_headline for document snippets.
+const documentHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=1"
+
+func (r *documentRepository) Search(ctx context.Context, params kiseki.SearchDocumentsParams) ([]*kiseki.DocumentSearchResult, error) {
+	builder := sq.Select(documentColumns...).
+		Column(documentApplicationCount).
+		Column("ts_rank(documents.search_vector, query) AS rank").
+		Column(fmt.Sprintf(
+			"CASE WHEN to_tsvector('english', documents.text) @@ query THEN ts_headline('english', %s, query, '%s') ELSE '' END",
+			escapeHTML("documents.text"), documentHeadlineOptions,
+		)).
+		From("documents").
+		JoinClause("CROSS JOIN websearch_to_tsquery('english', ?) AS query", params.Query).
+		Where(sq.Eq{"documents.user_id": params.UserID}).
+		Where("documents.search_vector @@ query").
+		OrderBy("rank DESC", "documents.id ASC").
+		Offset(uint64(params.Offset))
+
+	if params.Kind != kiseki.DocumentKindUnspecified {
+		builder = builder.Where(sq.Eq{"documents.kind": kiseki.DocumentKindToDB(params.Kind)})
+	}
+
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*kiseki.DocumentSearchResult
+	for rows.Next() {
+		var d kiseki.Document
+		var kind string
+		var result kiseki.DocumentSearchResult
+		var rank float32
+		dest := append(documentDest(&d, &kind), &result.ApplicationCount, &rank, &result.Snippet)
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		d.Kind = kiseki.DocumentKindFromDB(kind)
+		result.Document = &d
+		result.Rank = float64(rank)
+		results = append(results, &result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *documentRepository) Delete(ctx context.Context, id string) error {
+	query, args, err := sq.Delete("documents").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+
+	// Job applications, including those in the trash, keep their documents.
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return kiseki.ErrDocumentInUse
+	}
+	return err
+}
+
+// documentDest returns the scan destinations for documentColumns. The kind
+// label is scanned into kind for the caller to convert.
+func documentDest(d *kiseki.Document, kind *string) []any {
+	return []any{
+		&d.ID,
+		&d.UserID,
+		kind,
+		&d.Name,
+		&d.Label,
+		&d.ContentType,
+		&d.Size,
+		&d.StorageKey,
+		&d.Text,
+		&d.CreatedAt,
+	}
+}
+
+// scanDocument scans a row selected with documentColumns.
+func scanDocument(row pgx.Row) (*kiseki.Document, error) {
+	var d kiseki.Document
+	var kind string
+	if err := row.Scan(documentDest(&d, &kind)...); err != nil {
+		return nil, err
+	}
+
+	d.Kind = kiseki.DocumentKindFromDB(kind)
+	return &d, nil
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"kiseki"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// eventChannel is the channel job_application_events inserts are announced on,
+// with the user ID as payload. It must match the trigger in the migrations.
+const eventChannel = "job_application_events"
+
+const (
+	// minListenBackoff and maxListenBackoff bound the wait before the listener
+	// reconnects after losing its connection.
+	minListenBackoff = time.Second
+	maxListenBackoff = 30 * time.Second
+)
+
+// EventListener turns Postgres notifications about new job application events
+// into signals for the watchers subscribed to each user. Every server
+// instance listens on its own connection, so a change made through one
+// instance reaches watchers connected to any other.
+type EventListener struct {
+	pool *pgxpool.Pool
+
+	mu          sync.Mutex
+	subscribers map[string]map[chan struct{}]struct{}
+}
+
+var _ kiseki.JobApplicationEventSubscriber = (*EventListener)(nil)
+
+func NewEventListener(pool *pgxpool.Pool) *EventListener {
+	return &EventListener{
+		pool:        pool,
+		subscribers: map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+// Subscribe implements kiseki.JobApplicationEventSubscriber.
+func (l *EventListener) Subscribe(userID string) (<-chan struct{}, func()) {
+	// One buffered slot is enough: a pending signal already tells the watcher
+	// to catch up on everything.
+	ch := make(chan struct{}, 1)
+
+	l.mu.Lock()
+	if l.subscribers[userID] == nil {
+		l.subscribers[userID] = map[chan struct{}]struct{}{}
+	}
+	l.subscribers[userID][ch] = struct{}{}
+	l.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			delete(l.subscribers[userID], ch)
+			if len(l.subscribers[userID]) == 0 {
+				delete(l.subscribers, userID)
+			}
+		})
+	}
+}
+
+// Run listens for notifications until ctx is cancelled, reconnecting whenever
+// the connection is lost.
+func (l *EventListener) Run(ctx context.Context) {
+	backoff := minListenBackoff
+	for {
+		start := time.Now()
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		log.Printf("Job application event listener disconnected: %v", err)
+
+		// Reset the backoff once a connection has stayed up for a while.
+		if time.Since(start) > maxListenBackoff {
+			backoff = minListenBackoff
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxListenBackoff)
+	}
+}
+
+// listen holds a connection listening on eventChannel and dispatches its
+// notifications until ctx is cancelled or the connection fails.
+func (l *EventListener) listen(ctx context.Context) error {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	// The connection is LISTENing, so it must not go back to the pool.
+	defer conn.Release()
+	defer conn.Conn().Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+pgx.Identifier{eventChannel}.Sanitize()); err != nil {
+		return err
+	}
+
+	// Notifications sent while the listener was disconnected are lost, so
+	// have every watcher catch up.
+	l.notifyAll()
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		l.notify(n.Payload)
+	}
+}
+
+// notify signals the subscribers of userID.
+func (l *EventListener) notify(userID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for ch := range l.subscribers[userID] {
+		signal(ch)
+	}
+}
+
+// notifyAll signals every subscriber.
+func (l *EventListener) notifyAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, chans := range l.subscribers {
+		for ch := range chans {
+			signal(ch)
+		}
+	}
+}
+
+// signal sends to ch unless a signal is already pending.
+func signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
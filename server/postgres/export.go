@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// The created_at and updated_at columns of events and interviews are UTC
+// timestamps without a time zone, which JSON would write without an offset
+// that time.Time can parse. exportHistory and exportInterviews convert them
+// to timestamptz first.
+
+// exportHistory aggregates the events of each exported job application into
+// a JSON array, oldest first.
+const exportHistory = `(
+	SELECT coalesce(json_agg(json_build_object(
+		'id', e.id,
+		'seq', e.seq,
+		'type', e.type,
+		'changes', e.changes,
+		'created_at', e.created_at AT TIME ZONE 'UTC'
+	) ORDER BY e.created_at, e.seq), '[]')
+	FROM job_application_events e
+	WHERE e.job_application_id = job_applications.id
+)`
+
+// exportInterviews aggregates the interviews of each exported job
+// application into a JSON array, soonest first.
+const exportInterviews = `(
+	SELECT coalesce(json_agg(json_build_object(
+		'id', i.id,
+		'round', i.round,
+		'scheduled_at', i.scheduled_at,
+		'time_zone', i.time_zone,
+		'duration_seconds', i.duration_seconds,
+		'interviewers', i.interviewers,
+		'format', i.format,
+		'location', i.location,
+		'outcome', i.outcome,
+		'created_at', i.created_at AT TIME ZONE 'UTC',
+		'updated_at', i.updated_at AT TIME ZONE 'UTC'
+	) ORDER BY i.scheduled_at, i.id), '[]')
+	FROM interviews i
+	WHERE i.job_application_id = job_applications.id
+)`
+
+// exportedEvent is an event as aggregated by exportHistory.
+type exportedEvent struct {
+	ID        string        `json:"id"`
+	Seq       int64         `json:"seq"`
+	Type      string        `json:"type"`
+	Changes   []fieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// exportedInterview is an interview as aggregated by exportInterviews.
+type exportedInterview struct {
+	ID              string    `json:"id"`
+	Round           string    `json:"round"`
+	ScheduledAt     time.Time `json:"scheduled_at"`
+	TimeZone        string    `json:"time_zone"`
+	DurationSeconds int64     `json:"duration_seconds"`
+	Interviewers    []string  `json:"interviewers"`
+	Format          string    `json:"format"`
+	Location        string    `json:"location"`
+	Outcome         string    `json:"outcome"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+func (r *jobApplicationRepository) Export(ctx context.Context, params kiseki.ExportJobApplicationsParams, fn func(*kiseki.ExportedJobApplication) error) error {
+	builder := sq.Select(jobApplicationColumns...).
+		From("job_applications").
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(sq.Eq{"deleted_at": nil}).
+		OrderBy("applied_on ASC", "created_at ASC", "id ASC")
+
+	if params.IncludeHistory {
+		builder = builder.Column(exportHistory)
+	}
+	if params.IncludeInterviews {
+		builder = builder.Column(exportInterviews)
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ja kiseki.JobApplication
+		var statusStr string
+		var historyJSON, interviewsJSON []byte
+		dest := jobApplicationDest(&ja, &statusStr)
+		if params.IncludeHistory {
+			dest = append(dest, &historyJSON)
+		}
+		if params.IncludeInterviews {
+			dest = append(dest, &interviewsJSON)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		ja.Status = kiseki.StatusFromDB(statusStr)
+
+		exported := &kiseki.ExportedJobApplication{JobApplication: &ja}
+		if params.IncludeHistory {
+			if exported.History, err = exportedHistory(&ja, historyJSON); err != nil {
+				return err
+			}
+		}
+		if params.IncludeInterviews {
+			if exported.Interviews, err = exportedInterviews(&ja, interviewsJSON); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(exported); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// exportedHistory decodes the events of ja aggregated by exportHistory.
+func exportedHistory(ja *kiseki.JobApplication, data []byte) ([]*kiseki.JobApplicationEvent, error) {
+	var events []exportedEvent
+	if err := json.Unmarshal(data, &events); err != nil {
+		return nil, err
+	}
+
+	history := make([]*kiseki.JobApplicationEvent, 0, len(events))
+	for _, e := range events {
+		event := &kiseki.JobApplicationEvent{
+			ID:               e.ID,
+			JobApplicationID: ja.ID,
+			UserID:           ja.UserID,
+			Type:             kiseki.EventTypeFromDB(e.Type),
+			CreatedAt:        e.CreatedAt,
+			Seq:              e.Seq,
+		}
+		for _, c := range e.Changes {
+			event.Changes = append(event.Changes, kiseki.FieldChange{
+				Field:    kiseki.JobApplicationField(c.Field),
+				OldValue: c.OldValue,
+				NewValue: c.NewValue,
+			})
+		}
+		history = append(history, event)
+	}
+	return history, nil
+}
+
+// exportedInterviews decodes the interviews of ja aggregated by
+// exportInterviews.
+func exportedInterviews(ja *kiseki.JobApplication, data []byte) ([]*kiseki.Interview, error) {
+	var rows []exportedInterview
+	if err := json.Unmarshal(data, &rows); err != nil {
+		return nil, err
+	}
+
+	interviews := make([]*kiseki.Interview, 0, len(rows))
+	for _, i := range rows {
+		interviews = append(interviews, &kiseki.Interview{
+			ID:               i.ID,
+			UserID:           ja.UserID,
+			JobApplicationID: ja.ID,
+			Round:            i.Round,
+			ScheduledAt:      i.ScheduledAt,
+			TimeZone:         i.TimeZone,
+			Duration:         time.Duration(i.DurationSeconds) * time.Second,
+			Interviewers:     i.Interviewers,
+			Format:           kiseki.InterviewFormatFromDB(i.Format),
+			Location:         i.Location,
+			Outcome:          kiseki.InterviewOutcomeFromDB(i.Outcome),
+			CreatedAt:        i.CreatedAt,
+			UpdatedAt:        i.UpdatedAt,
+		})
+	}
+	return interviews, nil
+}
@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"kiseki"
+	"kiseki/export"
+)
+
+// The history and interviews below are written as json_build_object writes
+// timestamptz values, in sessions on UTC and on another time zone.
+const (
+	exportedHistoryJSON = `[{
+		"id": "event-1",
+		"seq": 7,
+		"type": "STATUS_CHANGED",
+		"changes": [{"field": "status", "old_value": "APPLIED", "new_value": "INTERVIEW"}],
+		"created_at": "2025-12-01T09:30:12.123456+00:00"
+	}]`
+
+	exportedInterviewsJSON = `[{
+		"id": "interview-1",
+		"round": "Technical screen",
+		"scheduled_at": "2025-12-03T15:00:00+01:00",
+		"time_zone": "Europe/Paris",
+		"duration_seconds": 3600,
+		"interviewers": ["Ada", "Grace"],
+		"format": "VIDEO",
+		"location": "https://meet.example/abc",
+		"outcome": "PENDING",
+		"created_at": "2025-12-01T10:30:12.5+01:00",
+		"updated_at": "2025-12-02T08:00:00+00:00"
+	}]`
+)
+
+func TestExportRoundTrip(t *testing.T) {
+	ja := &kiseki.JobApplication{
+		ID:        "ja-1",
+		UserID:    "user-1",
+		Company:   "Acme",
+		Title:     "Backend Engineer",
+		Status:    kiseki.JobApplicationStatusInterview,
+		AppliedOn: time.Date(2025, 11, 28, 0, 0, 0, 0, time.UTC),
+		CreatedAt: time.Date(2025, 11, 28, 9, 0, 0, 0, time.UTC),
+		UpdatedAt: time.Date(2025, 12, 1, 9, 30, 12, 0, time.UTC),
+	}
+
+	history, err := exportedHistory(ja, []byte(exportedHistoryJSON))
+	if err != nil {
+		t.Fatalf("exportedHistory: %v", err)
+	}
+	interviews, err := exportedInterviews(ja, []byte(exportedInterviewsJSON))
+	if err != nil {
+		t.Fatalf("exportedInterviews: %v", err)
+	}
+
+	if len(history) != 1 || len(interviews) != 1 {
+		t.Fatalf("got %d events and %d interviews, want 1 of each", len(history), len(interviews))
+	}
+	eventAt := time.Date(2025, 12, 1, 9, 30, 12, 123456000, time.UTC)
+	if !history[0].CreatedAt.Equal(eventAt) {
+		t.Errorf("event created at %v, want %v", history[0].CreatedAt, eventAt)
+	}
+	if history[0].Type != kiseki.JobApplicationEventTypeStatusChanged || history[0].JobApplicationID != ja.ID {
+		t.Errorf("event = %+v", history[0])
+	}
+	interview := interviews[0]
+	if want := time.Date(2025, 12, 3, 14, 0, 0, 0, time.UTC); !interview.ScheduledAt.Equal(want) {
+		t.Errorf("interview scheduled at %v, want %v", interview.ScheduledAt, want)
+	}
+	if want := time.Date(2025, 12, 1, 9, 30, 12, 500000000, time.UTC); !interview.CreatedAt.Equal(want) {
+		t.Errorf("interview created at %v, want %v", interview.CreatedAt, want)
+	}
+	if interview.Duration != time.Hour || interview.Format != kiseki.InterviewFormatVideo {
+		t.Errorf("interview = %+v", interview)
+	}
+
+	exported := &kiseki.ExportedJobApplication{JobApplication: ja, History: history, Interviews: interviews}
+
+	t.Run("ndjson", func(t *testing.T) {
+		out := writeExport(t, export.FormatNDJSON, exported)
+
+		var record struct {
+			ID      string `json:"id"`
+			History []struct {
+				Type      string    `json:"type"`
+				CreatedAt time.Time `json:"created_at"`
+			} `json:"history"`
+		}
+		if err := json.Unmarshal([]byte(out), &record); err != nil {
+			t.Fatalf("decode %s: %v", out, err)
+		}
+		if record.ID != ja.ID || len(record.History) != 1 {
+			t.Fatalf("record = %+v", record)
+		}
+		if got := record.History[0]; got.Type != "STATUS_CHANGED" || !got.CreatedAt.Equal(eventAt) {
+			t.Errorf("history = %+v, want a status change at %v", got, eventAt)
+		}
+		if !strings.Contains(out, `"created_at":"2025-12-01T09:30:12.123456Z"`) {
+			t.Errorf("event time not written in UTC:\n%s", out)
+		}
+	})
+
+	t.Run("ics", func(t *testing.T) {
+		out := writeExport(t, export.FormatICS, exported)
+
+		for _, line := range []string{
+			"UID:interview-1@kiseki",
+			"DTSTART:20251203T140000Z",
+			"DTEND:20251203T150000Z",
+			"SUMMARY:Technical screen: Backend Engineer at Acme",
+			"LAST-MODIFIED:20251202T080000Z",
+			"STATUS:CONFIRMED",
+		} {
+			if !strings.Contains(out, line+"\r\n") {
+				t.Errorf("calendar has no line %q:\n%s", line, out)
+			}
+		}
+	})
+}
+
+func writeExport(t *testing.T, format export.Format, exported *kiseki.ExportedJobApplication) string {
+	t.Helper()
+	var b bytes.Buffer
+	w, err := export.NewWriter(format, &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(exported); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *jobApplicationRepository) ListDueFollowUps(ctx context.Context, params kiseki.ListDueFollowUpsParams) ([]*kiseki.JobApplication, error) {
+	due := sq.Or{sq.LtOrEq{"follow_up_on": params.Now}}
+	for _, rule := range params.Rules {
+		if rule.AfterDays <= 0 {
+			continue
+		}
+		due = append(due, sq.And{
+			sq.Eq{"status": kiseki.StatusToDB(rule.Status)},
+			sq.LtOrEq{"status_changed_at": params.Now.AddDate(0, 0, -rule.AfterDays)},
+		})
+	}
+
+	query, args, err := sq.Select(jobApplicationColumns...).
+		From("job_applications").
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(sq.Eq{"deleted_at": nil}).
+		Where(due).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobApplications(rows)
+}
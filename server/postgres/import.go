@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"kiseki"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func (r *jobApplicationRepository) Import(ctx context.Context, jobApplications []kiseki.ImportedJobApplication, dryRun bool) ([]bool, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rolling back after a commit does nothing.
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	now := time.Now()
+	saved := make([]bool, len(jobApplications))
+	companies := map[string]*kiseki.Company{}
+	for i, imported := range jobApplications {
+		ja := imported.JobApplication
+		ja.CreatedAt = now
+		ja.UpdatedAt = now
+		ja.Version = 1
+
+		ok, err := importJobApplication(ctx, tx, imported, companies)
+		if err != nil {
+			return nil, err
+		}
+		saved[i] = ok
+	}
+
+	if dryRun {
+		return saved, nil
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// importJobApplication saves an imported job application in tx, linked to
+// its company, and reports whether it did. It works in a savepoint so a job
+// application skipped as imported before leaves no company behind. companies
+// caches the companies linked so far by name.
+func importJobApplication(ctx context.Context, tx pgx.Tx, imported kiseki.ImportedJobApplication, companies map[string]*kiseki.Company) (bool, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer savepoint.Rollback(context.WithoutCancel(ctx))
+
+	ja := imported.JobApplication
+	name := ja.Company
+	company, cached := companies[name]
+	if !cached {
+		company, err = findOrCreateCompany(ctx, savepoint, ja.UserID, name)
+		if err != nil {
+			return false, err
+		}
+	}
+	ja.LinkCompany(company)
+	// The company's name may differ from the one imported.
+	imported.Event.Changes = kiseki.DiffJobApplications(nil, ja)
+
+	// Skip job applications imported before, matched by external ID.
+	query, args, err := insertJobApplication(ja).
+		Suffix("ON CONFLICT (user_id, external_id) WHERE external_id IS NOT NULL DO NOTHING RETURNING id").
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var id string
+	err = savepoint.QueryRow(ctx, query, args...).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	query, args, err = insertEvent(imported.Event)
+	if err != nil {
+		return false, err
+	}
+	if err := savepoint.QueryRow(ctx, query, args...).Scan(&imported.Event.Seq); err != nil {
+		return false, err
+	}
+
+	if err := savepoint.Commit(ctx); err != nil {
+		return false, err
+	}
+	companies[name] = company
+	return true, nil
+}
@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func NewInterviewRepository(pool *pgxpool.Pool) kiseki.InterviewRepository {
+	return &interviewRepository{pool: pool}
+}
+
+type interviewRepository struct {
+	pool *pgxpool.Pool
+}
+
+// interviewColumns are the columns scanInterview reads, in order.
+var interviewColumns = []string{
+	"interviews.id",
+	"interviews.user_id",
+	"interviews.job_application_id",
+	"interviews.round",
+	"interviews.scheduled_at",
+	"interviews.time_zone",
+	"interviews.duration_seconds",
+	"interviews.interviewers",
+	"interviews.format",
+	"interviews.location",
+	"interviews.outcome",
+	"interviews.created_at",
+	"interviews.updated_at",
+}
+
+func (r *interviewRepository) Save(ctx context.Context, interview *kiseki.Interview) error {
+	query, args, err := sq.Insert("interviews").
+		Columns(
+			"id",
+			"user_id",
+			"job_application_id",
+			"round",
+			"scheduled_at",
+			"time_zone",
+			"duration_seconds",
+			"interviewers",
+			"format",
+			"location",
+			"outcome",
+			"created_at",
+			"updated_at",
+		).
+		Values(
+			interview.ID,
+			interview.UserID,
+			interview.JobApplicationID,
+			interview.Round,
+			interview.ScheduledAt,
+			interview.TimeZone,
+			int64(interview.Duration/time.Second),
+			interview.Interviewers,
+			kiseki.InterviewFormatToDB(interview.Format),
+			interview.Location,
+			kiseki.InterviewOutcomeToDB(interview.Outcome),
+			interview.CreatedAt,
+			interview.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (id) DO UPDATE SET
+			round = EXCLUDED.round,
+			scheduled_at = EXCLUDED.scheduled_at,
+			time_zone = EXCLUDED.time_zone,
+			duration_seconds = EXCLUDED.duration_seconds,
+			interviewers = EXCLUDED.interviewers,
+			format = EXCLUDED.format,
+			location = EXCLUDED.location,
+			outcome = EXCLUDED.outcome,
+			updated_at = EXCLUDED.updated_at`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *interviewRepository) Find(ctx context.Context, id string) (*kiseki.Interview, error) {
+	query, args, err := sq.Select(interviewColumns...).
+		From("interviews").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	interview, err := scanInterview(r.pool.QueryRow(ctx, query, args...))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return interview, nil
+}
+
+func (r *interviewRepository) Delete(ctx context.Context, id string) error {
+	query, args, err := sq.Delete("interviews").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *interviewRepository) ListByJobApplication(ctx context.Context, jobApplicationID string) ([]*kiseki.Interview, error) {
+	query, args, err := sq.Select(interviewColumns...).
+		From("interviews").
+		Where(sq.Eq{"job_application_id": jobApplicationID}).
+		OrderBy("scheduled_at ASC", "id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.list(ctx, query, args)
+}
+
+func (r *interviewRepository) ListUpcoming(ctx context.Context, params kiseki.ListUpcomingInterviewsParams) ([]*kiseki.Interview, error) {
+	builder := sq.Select(interviewColumns...).
+		From("interviews").
+		Join("job_applications ON job_applications.id = interviews.job_application_id").
+		Where(sq.Eq{"interviews.user_id": params.UserID}).
+		Where(sq.Eq{"job_applications.deleted_at": nil}).
+		Where(sq.NotEq{"interviews.outcome": kiseki.InterviewOutcomeToDB(kiseki.InterviewOutcomeCancelled)}).
+		Where(sq.GtOrEq{"interviews.scheduled_at": params.From}).
+		OrderBy("interviews.scheduled_at ASC", "interviews.id ASC")
+
+	if params.After != nil {
+		builder = builder.Where(sq.Expr("(interviews.scheduled_at, interviews.id) > (?, ?)", params.After.ScheduledAt, params.After.ID))
+	}
+
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.list(ctx, query, args)
+}
+
+func (r *interviewRepository) list(ctx context.Context, query string, args []interface{}) ([]*kiseki.Interview, error) {
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var interviews []*kiseki.Interview
+	for rows.Next() {
+		interview, err := scanInterview(rows)
+		if err != nil {
+			return nil, err
+		}
+		interviews = append(interviews, interview)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return interviews, nil
+}
+
+// scanInterview reads an interview selected with interviewColumns.
+func scanInterview(row pgx.Row) (*kiseki.Interview, error) {
+	var interview kiseki.Interview
+	var durationSeconds int64
+	var formatStr, outcomeStr string
+	err := row.Scan(
+		&interview.ID,
+		&interview.UserID,
+		&interview.JobApplicationID,
+		&interview.Round,
+		&interview.ScheduledAt,
+		&interview.TimeZone,
+		&durationSeconds,
+		&interview.Interviewers,
+		&formatStr,
+		&interview.Location,
+		&outcomeStr,
+		&interview.CreatedAt,
+		&interview.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	interview.Duration = time.Duration(durationSeconds) * time.Second
+	interview.Format = kiseki.InterviewFormatFromDB(formatStr)
+	interview.Outcome = kiseki.InterviewOutcomeFromDB(outcomeStr)
+
+	return &interview, nil
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func NewJobApplicationEventRepository(pool *pgxpool.Pool) kiseki.JobApplicationEventRepository {
+	return &jobApplicationEventRepository{pool: pool}
+}
+
+type jobApplicationEventRepository struct {
+	pool *pgxpool.Pool
+}
+
+// fieldChange is the JSON representation of a kiseki.FieldChange stored in
+// the changes column.
+type fieldChange struct {
+	Field    string  `json:"field"`
+	OldValue *string `json:"old_value"`
+	NewValue *string `json:"new_value"`
+}
+
+// eventColumns are the columns scanned by scanJobApplicationEvents.
+var eventColumns = []string{
+	"id",
+	"txid::TEXT::BIGINT",
+	"seq",
+	"job_application_id",
+	"user_id",
+	"type",
+	"changes",
+	"created_at",
+}
+
+// settledEvent matches events stored by transactions older than every one
+// still running. No event from a running transaction can be ordered before
+// them, so readers only see these. Transactions of any user count, so an
+// unrelated one can hold events back; HasUnsettled tells readers to look
+// again soon.
+var settledEvent = sq.Expr("txid < pg_snapshot_xmin(pg_current_snapshot())")
+
+// insertEvent builds the query that appends event, returning its sequence
+// number.
+func insertEvent(event *kiseki.JobApplicationEvent) (string, []interface{}, error) {
+	changes := make([]fieldChange, 0, len(event.Changes))
+	for _, c := range event.Changes {
+		changes = append(changes, fieldChange{
+			Field:    string(c.Field),
+			OldValue: c.OldValue,
+			NewValue: c.NewValue,
+		})
+	}
+
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return sq.Insert("job_application_events").
+		Columns(
+			"id",
+			"job_application_id",
+			"user_id",
+			"type",
+			"changes",
+			"created_at",
+		).
+		Values(
+			event.ID,
+			event.JobApplicationID,
+			event.UserID,
+			kiseki.EventTypeToDB(event.Type),
+			string(changesJSON),
+			event.CreatedAt,
+		).
+		Suffix("RETURNING seq").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+}
+
+func (r *jobApplicationEventRepository) ListByJobApplication(ctx context.Context, jobApplicationID string) ([]*kiseki.JobApplicationEvent, error) {
+	query, args, err := sq.Select(eventColumns...).
+		From("job_application_events").
+		Where(sq.Eq{"job_application_id": jobApplicationID}).
+		OrderBy("created_at ASC, seq ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobApplicationEvents(rows)
+}
+
+func (r *jobApplicationEventRepository) ListByUser(ctx context.Context, params kiseki.ListJobApplicationEventsParams) ([]*kiseki.JobApplicationEvent, error) {
+	qb := sq.Select(eventColumns...).
+		From("job_application_events").
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(settledEvent).
+		OrderBy("txid ASC", "seq ASC").
+		PlaceholderFormat(sq.Dollar)
+
+	if params.After.TxID == 0 {
+		qb = qb.Where(sq.Gt{"seq": params.After.Seq})
+	} else {
+		qb = qb.Where("(txid, seq) > (?::TEXT::xid8, ?)", params.After.TxID, params.After.Seq)
+	}
+
+	if params.Limit > 0 {
+		qb = qb.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := qb.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobApplicationEvents(rows)
+}
+
+func (r *jobApplicationEventRepository) LatestPosition(ctx context.Context, userID string) (kiseki.EventPosition, error) {
+	query, args, err := sq.Select("txid::TEXT::BIGINT", "seq").
+		From("job_application_events").
+		Where(sq.Eq{"user_id": userID}).
+		Where(settledEvent).
+		OrderBy("txid DESC", "seq DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return kiseki.EventPosition{}, err
+	}
+
+	var pos kiseki.EventPosition
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&pos.TxID, &pos.Seq)
+	if err == pgx.ErrNoRows {
+		return kiseki.EventPosition{}, nil
+	}
+	if err != nil {
+		return kiseki.EventPosition{}, err
+	}
+	return pos, nil
+}
+
+func (r *jobApplicationEventRepository) HasUnsettled(ctx context.Context, userID string) (bool, error) {
+	query, args, err := sq.Select("1").
+		From("job_application_events").
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.Expr("txid >= pg_snapshot_xmin(pg_current_snapshot())")).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var unsettled bool
+	if err := r.pool.QueryRow(ctx, query, args...).Scan(&unsettled); err != nil {
+		return false, err
+	}
+	return unsettled, nil
+}
+
+// scanJobApplicationEvents scans rows selected with eventColumns and closes
+// them.
+func scanJobApplicationEvents(rows pgx.Rows) ([]*kiseki.JobApplicationEvent, error) {
+	defer rows.Close()
+
+	var events []*kiseki.JobApplicationEvent
+	for rows.Next() {
+		var e kiseki.JobApplicationEvent
+		var typeStr string
+		var changesJSON []byte
+		err := rows.Scan(
+			&e.ID,
+			&e.TxID,
+			&e.Seq,
+			&e.JobApplicationID,
+			&e.UserID,
+			&typeStr,
+			&changesJSON,
+			&e.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		var changes []fieldChange
+		if err := json.Unmarshal(changesJSON, &changes); err != nil {
+			return nil, err
+		}
+		for _, c := range changes {
+			e.Changes = append(e.Changes, kiseki.FieldChange{
+				Field:    kiseki.JobApplicationField(c.Field),
+				OldValue: c.OldValue,
+				NewValue: c.NewValue,
+			})
+		}
+
+		e.Type = kiseki.EventTypeFromDB(typeStr)
+		events = append(events, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -2,15 +2,75 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"kiseki"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// jobApplicationColumns are the job application columns every query selects,
+// in the order jobApplicationDest scans them.
+var jobApplicationColumns = []string{
+	"id",
+	"user_id",
+	"company",
+	"title",
+	"description",
+	"notes",
+	"cv",
+	"cover_letter",
+	"created_at",
+	"updated_at",
+	"deleted_at",
+	"applied_on",
+	"status",
+	"status_changed_at",
+	"position",
+	"version",
+	"cv_document_id",
+	"cover_letter_document_id",
+	"match_score",
+	"external_id",
+	"follow_up_on",
+	"company_id",
+}
+
+// jobApplicationDest returns the scan destinations for jobApplicationColumns.
+// The status label is scanned into status for the caller to convert.
+func jobApplicationDest(ja *kiseki.JobApplication, status *string) []any {
+	return []any{
+		&ja.ID,
+		&ja.UserID,
+		&ja.Company,
+		&ja.Title,
+		&ja.Description,
+		&ja.Notes,
+		&ja.CV,
+		&ja.CoverLetter,
+		&ja.CreatedAt,
+		&ja.UpdatedAt,
+		&ja.DeletedAt,
+		&ja.AppliedOn,
+		status,
+		&ja.StatusChangedAt,
+		&ja.Position,
+		&ja.Version,
+		&ja.CVDocumentID,
+		&ja.CoverLetterDocumentID,
+		&ja.MatchScore,
+		&ja.ExternalID,
+		&ja.FollowUpOn,
+		&ja.CompanyID,
+	}
+}
+
 func NewJobApplicationRepository(pool *pgxpool.Pool) kiseki.JobApplicationRepository {
 	return &jobApplicationRepository{pool: pool}
 }
@@ -19,63 +79,74 @@ type jobApplicationRepository struct {
 	pool *pgxpool.Pool
 }
 
-func (r *jobApplicationRepository) Save(ctx context.Context, jobApplication *kiseki.JobApplication) error {
-	// Check if a record with this ID already exists
-	existing, err := r.Find(ctx, jobApplication.ID)
+// positionConstraint keeps positions unique within a board column.
+const positionConstraint = "job_applications_position_key"
+
+func (r *jobApplicationRepository) Save(ctx context.Context, jobApplication *kiseki.JobApplication, event *kiseki.JobApplicationEvent) error {
+	tx, err := r.pool.Begin(ctx)
 	if err != nil {
 		return err
 	}
+	// Rolling back after a commit does nothing.
+	defer tx.Rollback(context.WithoutCancel(ctx))
+
+	updated, err := saveJobApplication(ctx, tx, jobApplication)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.ConstraintName == positionConstraint {
+		return kiseki.ErrPositionTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	if event != nil {
+		query, args, err := insertEvent(event)
+		if err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, query, args...).Scan(&event.Seq); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	if updated {
+		jobApplication.Version++
+	}
+	return nil
+}
+
+// saveJobApplication inserts the job application, or updates it if it exists.
+// It reports whether it updated an existing one, whose version the caller
+// increments once the transaction commits.
+func saveJobApplication(ctx context.Context, tx pgx.Tx, jobApplication *kiseki.JobApplication) (bool, error) {
+	// Check if a record with this ID already exists, including in the trash
+	var exists bool
+	err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM job_applications WHERE id = $1)", jobApplication.ID).Scan(&exists)
+	if err != nil {
+		return false, err
+	}
 
 	// If no existing record, INSERT
-	if existing == nil {
+	if !exists {
 		now := time.Now()
 		jobApplication.CreatedAt = now
 		jobApplication.UpdatedAt = now
+		jobApplication.Version = 1
 
-		query, args, err := sq.Insert("job_applications").
-			Columns(
-				"id",
-				"user_id",
-				"company",
-				"title",
-				"description",
-				"notes",
-				"cv",
-				"cover_letter",
-				"created_at",
-				"updated_at",
-				"deleted_at",
-				"applied_on",
-				"status",
-				"position",
-			).
-			Values(
-				jobApplication.ID,
-				jobApplication.UserID,
-				jobApplication.Company,
-				jobApplication.Title,
-				jobApplication.Description,
-				jobApplication.Notes,
-				jobApplication.CV,
-				jobApplication.CoverLetter,
-				jobApplication.CreatedAt,
-				jobApplication.UpdatedAt,
-				jobApplication.DeletedAt,
-				jobApplication.AppliedOn,
-				kiseki.StatusToDB(jobApplication.Status),
-				jobApplication.Position,
-			).
-			PlaceholderFormat(sq.Dollar).
-			ToSql()
+		query, args, err := insertJobApplication(jobApplication).ToSql()
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		_, err = r.pool.Exec(ctx, query, args...)
-		return err
+		_, err = tx.Exec(ctx, query, args...)
+		return false, err
 	}
 
-	// Otherwise, UPDATE existing record
+	// Otherwise, UPDATE existing record if nobody changed it since it was read
 	query, args, err := sq.Update("job_applications").
 		Set("company", jobApplication.Company).
 		Set("title", jobApplication.Title).
@@ -87,38 +158,103 @@ func (r *jobApplicationRepository) Save(ctx context.Context, jobApplication *kis
 		Set("deleted_at", jobApplication.DeletedAt).
 		Set("applied_on", jobApplication.AppliedOn).
 		Set("status", kiseki.StatusToDB(jobApplication.Status)).
+		Set("status_changed_at", jobApplication.StatusChangedAt).
 		Set("position", jobApplication.Position).
+		Set("cv_document_id", jobApplication.CVDocumentID).
+		Set("cover_letter_document_id", jobApplication.CoverLetterDocumentID).
+		Set("match_score", jobApplication.MatchScore).
+		Set("follow_up_on", jobApplication.FollowUpOn).
+		Set("company_id", jobApplication.CompanyID).
+		Set("version", sq.Expr("version + 1")).
 		Where(sq.Eq{"id": jobApplication.ID}).
+		Where(sq.Eq{"version": jobApplication.Version}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return false, err
 	}
 
-	_, err = r.pool.Exec(ctx, query, args...)
-	return err
+	if tag.RowsAffected() == 0 {
+		return false, kiseki.ErrVersionConflict
+	}
+
+	return true, nil
+}
+
+// insertJobApplication builds the query that inserts a new job application.
+func insertJobApplication(jobApplication *kiseki.JobApplication) sq.InsertBuilder {
+	return sq.Insert("job_applications").
+		Columns(
+			"id",
+			"user_id",
+			"company",
+			"title",
+			"description",
+			"notes",
+			"cv",
+			"cover_letter",
+			"created_at",
+			"updated_at",
+			"deleted_at",
+			"applied_on",
+			"status",
+			"status_changed_at",
+			"position",
+			"version",
+			"cv_document_id",
+			"cover_letter_document_id",
+			"match_score",
+			"external_id",
+			"follow_up_on",
+			"company_id",
+		).
+		Values(
+			jobApplication.ID,
+			jobApplication.UserID,
+			jobApplication.Company,
+			jobApplication.Title,
+			jobApplication.Description,
+			jobApplication.Notes,
+			jobApplication.CV,
+			jobApplication.CoverLetter,
+			jobApplication.CreatedAt,
+			jobApplication.UpdatedAt,
+			jobApplication.DeletedAt,
+			jobApplication.AppliedOn,
+			kiseki.StatusToDB(jobApplication.Status),
+			jobApplication.StatusChangedAt,
+			jobApplication.Position,
+			jobApplication.Version,
+			jobApplication.CVDocumentID,
+			jobApplication.CoverLetterDocumentID,
+			jobApplication.MatchScore,
+			jobApplication.ExternalID,
+			jobApplication.FollowUpOn,
+			jobApplication.CompanyID,
+		).
+		PlaceholderFormat(sq.Dollar)
 }
 
 func (r *jobApplicationRepository) Find(ctx context.Context, id string) (*kiseki.JobApplication, error) {
-	query, args, err := sq.Select(
-		"id",
-		"user_id",
-		"company",
-		"title",
-		"description",
-		"notes",
-		"cv",
-		"cover_letter",
-		"created_at",
-		"updated_at",
-		"deleted_at",
-		"applied_on",
-		"status",
-		"position",
-	).
+	return r.find(ctx, id, sq.Eq{"deleted_at": nil})
+}
+
+func (r *jobApplicationRepository) FindDeleted(ctx context.Context, id string) (*kiseki.JobApplication, error) {
+	return r.find(ctx, id, sq.NotEq{"deleted_at": nil})
+}
+
+// find finds the job application id if it also matches deleted, which picks
+// between live job applications and the trash.
+func (r *jobApplicationRepository) find(ctx context.Context, id string, deleted sq.Sqlizer) (*kiseki.JobApplication, error) {
+	query, args, err := sq.Select(jobApplicationColumns...).
 		From("job_applications").
 		Where(sq.Eq{"id": id}).
-		Where(sq.Eq{"deleted_at": nil}).
+		Where(deleted).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -127,22 +263,7 @@ func (r *jobApplicationRepository) Find(ctx context.Context, id string) (*kiseki
 
 	var ja kiseki.JobApplication
 	var statusStr string
-	err = r.pool.QueryRow(ctx, query, args...).Scan(
-		&ja.ID,
-		&ja.UserID,
-		&ja.Company,
-		&ja.Title,
-		&ja.Description,
-		&ja.Notes,
-		&ja.CV,
-		&ja.CoverLetter,
-		&ja.CreatedAt,
-		&ja.UpdatedAt,
-		&ja.DeletedAt,
-		&ja.AppliedOn,
-		&statusStr,
-		&ja.Position,
-	)
+	err = r.pool.QueryRow(ctx, query, args...).Scan(jobApplicationDest(&ja, &statusStr)...)
 
 	if err == pgx.ErrNoRows {
 		return nil, nil
@@ -157,27 +278,39 @@ func (r *jobApplicationRepository) Find(ctx context.Context, id string) (*kiseki
 	return &ja, nil
 }
 
-func (r *jobApplicationRepository) List(ctx context.Context, userID string) ([]*kiseki.JobApplication, error) {
-	query, args, err := sq.Select(
-		"id",
-		"user_id",
-		"company",
-		"title",
-		"description",
-		"notes",
-		"cv",
-		"cover_letter",
-		"created_at",
-		"updated_at",
-		"deleted_at",
-		"applied_on",
-		"status",
-		"position",
-	).
+func (r *jobApplicationRepository) List(ctx context.Context, params kiseki.ListJobApplicationsParams) ([]*kiseki.JobApplication, error) {
+	builder := sq.Select(jobApplicationColumns...).
 		From("job_applications").
-		Where(sq.Eq{"user_id": userID}).
-		Where(sq.Eq{"deleted_at": nil}).
-		OrderBy("status ASC, position ASC").
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(sq.Eq{"deleted_at": nil})
+
+	builder = applyJobApplicationFilter(builder, params.Filter)
+
+	order, cursorValues := jobApplicationSortOrder(params.SortKey, params.After)
+
+	direction, comparison := "ASC", ">"
+	if params.Descending {
+		direction, comparison = "DESC", "<"
+	}
+
+	if params.After != nil {
+		builder = builder.Where(sq.Expr(
+			fmt.Sprintf("(%s) %s (%s)", strings.Join(order.columns, ", "), comparison, strings.Join(order.placeholders, ", ")),
+			cursorValues...,
+		))
+	}
+
+	orderBy := make([]string, 0, len(order.columns))
+	for _, c := range order.columns {
+		orderBy = append(orderBy, c+" "+direction)
+	}
+	builder = builder.OrderBy(orderBy...)
+
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
@@ -188,28 +321,20 @@ func (r *jobApplicationRepository) List(ctx context.Context, userID string) ([]*
 	if err != nil {
 		return nil, err
 	}
+
+	return scanJobApplications(rows)
+}
+
+// scanJobApplications reads every row of rows, which must select
+// jobApplicationColumns, and closes it.
+func scanJobApplications(rows pgx.Rows) ([]*kiseki.JobApplication, error) {
 	defer rows.Close()
 
 	var jobApplications []*kiseki.JobApplication
 	for rows.Next() {
 		var ja kiseki.JobApplication
 		var statusStr string
-		err := rows.Scan(
-			&ja.ID,
-			&ja.UserID,
-			&ja.Company,
-			&ja.Title,
-			&ja.Description,
-			&ja.Notes,
-			&ja.CV,
-			&ja.CoverLetter,
-			&ja.CreatedAt,
-			&ja.UpdatedAt,
-			&ja.DeletedAt,
-			&ja.AppliedOn,
-			&statusStr,
-			&ja.Position,
-		)
+		err := rows.Scan(jobApplicationDest(&ja, &statusStr)...)
 		if err != nil {
 			return nil, err
 		}
@@ -217,9 +342,213 @@ func (r *jobApplicationRepository) List(ctx context.Context, userID string) ([]*
 		jobApplications = append(jobApplications, &ja)
 	}
 
-	if err = rows.Err(); err != nil {
+	if err := rows.Err(); err != nil {
 		return nil, err
 	}
 
 	return jobApplications, nil
 }
+
+// applyJobApplicationFilter adds the WHERE clauses for filter to builder.
+func applyJobApplicationFilter(builder sq.SelectBuilder, filter kiseki.JobApplicationFilter) sq.SelectBuilder {
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, s := range filter.Statuses {
+			statuses = append(statuses, kiseki.StatusToDB(s))
+		}
+		builder = builder.Where(sq.Eq{"status": statuses})
+	}
+
+	if filter.Company != "" {
+		builder = builder.Where(sq.ILike{"company": "%" + escapeLike(filter.Company) + "%"})
+	}
+
+	if filter.AppliedOnFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"applied_on": *filter.AppliedOnFrom})
+	}
+
+	if filter.AppliedOnTo != nil {
+		builder = builder.Where(sq.LtOrEq{"applied_on": *filter.AppliedOnTo})
+	}
+
+	if filter.HasCV != nil {
+		builder = builder.Where(presenceCondition("cv", *filter.HasCV))
+	}
+
+	if filter.HasCoverLetter != nil {
+		builder = builder.Where(presenceCondition("cover_letter", *filter.HasCoverLetter))
+	}
+
+	if filter.CVDocumentID != "" {
+		builder = builder.Where(sq.Eq{"cv_document_id": filter.CVDocumentID})
+	}
+
+	if filter.CoverLetterDocumentID != "" {
+		builder = builder.Where(sq.Eq{"cover_letter_document_id": filter.CoverLetterDocumentID})
+	}
+
+	if filter.CVQuery != "" {
+		builder = builder.Where(sq.Expr(
+			"cv_document_id IN (SELECT id FROM documents WHERE search_vector @@ websearch_to_tsquery('english', ?))",
+			filter.CVQuery,
+		))
+	}
+
+	return builder
+}
+
+// presenceCondition matches rows where column is set to a non-empty value, or
+// the opposite when present is false.
+func presenceCondition(column string, present bool) sq.Sqlizer {
+	if present {
+		return sq.And{sq.NotEq{column: nil}, sq.NotEq{column: ""}}
+	}
+	return sq.Or{sq.Eq{column: nil}, sq.Eq{column: ""}}
+}
+
+// escapeLike escapes the LIKE wildcards in s so it is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
+type sortOrder struct {
+	columns      []string
+	placeholders []string
+}
+
+// jobApplicationSortOrder returns the columns to order by for key, ending
+// with id so the order is total, along with the values of after for the same
+// columns. The columns match the indexes on job_applications.
+func jobApplicationSortOrder(key kiseki.JobApplicationSortKey, after *kiseki.JobApplicationCursor) (sortOrder, []interface{}) {
+	if after == nil {
+		after = &kiseki.JobApplicationCursor{}
+	}
+
+	switch key {
+	case kiseki.JobApplicationSortKeyAppliedOn:
+		return sortOrder{
+			columns:      []string{"applied_on", "id"},
+			placeholders: []string{"?::date", "?"},
+		}, []interface{}{after.AppliedOn, after.ID}
+	case kiseki.JobApplicationSortKeyCreatedAt:
+		return sortOrder{
+			columns:      []string{"created_at", "id"},
+			placeholders: []string{"?", "?"},
+		}, []interface{}{after.CreatedAt, after.ID}
+	case kiseki.JobApplicationSortKeyUpdatedAt:
+		return sortOrder{
+			columns:      []string{"updated_at", "id"},
+			placeholders: []string{"?", "?"},
+		}, []interface{}{after.UpdatedAt, after.ID}
+	case kiseki.JobApplicationSortKeyCompany:
+		return sortOrder{
+			columns:      []string{"lower(company)", "id"},
+			placeholders: []string{"lower(?)", "?"},
+		}, []interface{}{after.Company, after.ID}
+	case kiseki.JobApplicationSortKeyMatchScore:
+		return sortOrder{
+			columns:      []string{"coalesce(match_score, -1)", "id"},
+			placeholders: []string{"?::smallint", "?"},
+		}, []interface{}{after.MatchScore, after.ID}
+	default:
+		return sortOrder{
+			columns:      []string{"status", "position", "id"},
+			placeholders: []string{"?::job_application_status", "?", "?"},
+		}, []interface{}{kiseki.StatusToDB(after.Status), after.Position, after.ID}
+	}
+}
+
+// escapeHTML returns the SQL expression for the text of expr escaped as
+// HTML. ts_headline copies its source text into the snippet as is, so the
+// text is escaped beforehand to leave <mark> as the only markup.
+func escapeHTML(expr string) string {
+	return fmt.Sprintf("replace(replace(replace(coalesce(%s, ''), '&', '&amp;'), '<', '&lt;'), '>', '&gt;')", expr)
+}
+
+// searchHeadlineOptions configures ts_headline for search snippets.
+const searchHeadlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxWords=35, MinWords=15, MaxFragments=2"
+
+// searchableFields are the columns that make up search_vector, in the order
+// their snippets are returned.
+var searchableFields = []kiseki.JobApplicationField{
+	kiseki.JobApplicationFieldCompany,
+	kiseki.JobApplicationFieldTitle,
+	kiseki.JobApplicationFieldDescription,
+	kiseki.JobApplicationFieldNotes,
+}
+
+func (r *jobApplicationRepository) Search(ctx context.Context, params kiseki.SearchJobApplicationsParams) ([]*kiseki.JobApplicationSearchResult, error) {
+	builder := sq.Select(jobApplicationColumns...).
+		Column("ts_rank(search_vector, query) AS rank")
+
+	// A snippet is only produced for the fields that match the query.
+	for _, field := range searchableFields {
+		builder = builder.Column(fmt.Sprintf(
+			"CASE WHEN to_tsvector('english', coalesce(%s, '')) @@ query THEN ts_headline('english', %s, query, '%s') END",
+			field, escapeHTML(string(field)), searchHeadlineOptions,
+		))
+	}
+
+	builder = builder.
+		From("job_applications").
+		JoinClause("CROSS JOIN websearch_to_tsquery('english', ?) AS query", params.Query).
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(sq.Eq{"deleted_at": nil}).
+		Where("search_vector @@ query").
+		OrderBy("rank DESC", "id ASC").
+		Offset(uint64(params.Offset))
+
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*kiseki.JobApplicationSearchResult
+	for rows.Next() {
+		var ja kiseki.JobApplication
+		var statusStr string
+		var rank float32
+		snippets := make([]*string, len(searchableFields))
+		dest := append(jobApplicationDest(&ja, &statusStr), &rank)
+		for i := range snippets {
+			dest = append(dest, &snippets[i])
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		ja.Status = kiseki.StatusFromDB(statusStr)
+
+		result := &kiseki.JobApplicationSearchResult{
+			JobApplication: &ja,
+			Rank:           float64(rank),
+		}
+		for i, snippet := range snippets {
+			if snippet != nil {
+				result.Snippets = append(result.Snippets, kiseki.SearchSnippet{
+					Field: searchableFields[i],
+					Text:  *snippet,
+				})
+			}
+		}
+		results = append(results, result)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
@@ -0,0 +1,206 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func NewPositionRepository(pool *pgxpool.Pool) kiseki.PositionRepository {
+	return &positionRepository{pool: pool}
+}
+
+type positionRepository struct {
+	pool *pgxpool.Pool
+}
+
+// groupQuery selects from the live job applications in group, leaving out
+// excludeID when it is set.
+func groupQuery(columns string, group kiseki.PositionGroup, excludeID string) sq.SelectBuilder {
+	builder := sq.Select(columns).
+		From("job_applications").
+		Where(sq.Eq{"user_id": group.UserID}).
+		Where(sq.Eq{"status": kiseki.StatusToDB(group.Status)}).
+		Where(sq.Eq{"deleted_at": nil})
+
+	if excludeID != "" {
+		builder = builder.Where(sq.NotEq{"id": excludeID})
+	}
+
+	return builder
+}
+
+func (r *positionRepository) Previous(ctx context.Context, group kiseki.PositionGroup, position string, excludeID string) (string, error) {
+	builder := groupQuery("position", group, excludeID).
+		OrderBy("position DESC").
+		Limit(1)
+
+	if position != "" {
+		builder = builder.Where(sq.Lt{"position": position})
+	}
+
+	return r.position(ctx, builder)
+}
+
+func (r *positionRepository) Next(ctx context.Context, group kiseki.PositionGroup, position string, excludeID string) (string, error) {
+	builder := groupQuery("position", group, excludeID).
+		Where(sq.Gt{"position": position}).
+		OrderBy("position ASC").
+		Limit(1)
+
+	return r.position(ctx, builder)
+}
+
+// position runs a query selecting a single position, returning an empty
+// string when there is no row.
+func (r *positionRepository) position(ctx context.Context, builder sq.SelectBuilder) (string, error) {
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return "", err
+	}
+
+	var position string
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&position)
+	if err == pgx.ErrNoRows {
+		return "", nil
+	}
+
+	return position, err
+}
+
+func (r *positionRepository) Taken(ctx context.Context, group kiseki.PositionGroup, position string, excludeID string) (bool, error) {
+	query, args, err := groupQuery("1", group, excludeID).
+		Where(sq.Eq{"position": position}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	var taken bool
+	err = r.pool.QueryRow(ctx, query, args...).Scan(&taken)
+	return taken, err
+}
+
+func (r *positionRepository) ListGroupsToRebalance(ctx context.Context, maxLength int) ([]kiseki.PositionGroup, error) {
+	query, args, err := sq.Select("user_id", "status").
+		From("job_applications").
+		Where(sq.Eq{"deleted_at": nil}).
+		GroupBy("user_id", "status").
+		Having(sq.Or{
+			sq.Expr("max(length(position)) > ?", maxLength),
+			sq.Expr("count(*) > count(DISTINCT position)"),
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var groups []kiseki.PositionGroup
+	for rows.Next() {
+		var group kiseki.PositionGroup
+		var statusStr string
+		if err := rows.Scan(&group.UserID, &statusStr); err != nil {
+			return nil, err
+		}
+		group.Status = kiseki.StatusFromDB(statusStr)
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func (r *positionRepository) Rebalance(ctx context.Context, group kiseki.PositionGroup, keys func(n int) ([]string, error)) error {
+	return pgx.BeginFunc(ctx, r.pool, func(tx pgx.Tx) error {
+		// Lock the group so moves made meanwhile wait for the new positions.
+		query, args, err := groupQuery(strings.Join(jobApplicationColumns, ", "), group, "").
+			OrderBy("position ASC", "id ASC").
+			Suffix("FOR UPDATE").
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+
+		jobApplications, err := scanJobApplications(rows)
+		if err != nil {
+			return err
+		}
+
+		positions, err := keys(len(jobApplications))
+		if err != nil {
+			return err
+		}
+		if len(positions) != len(jobApplications) {
+			return fmt.Errorf("rebalance: got %d positions for %d job applications", len(positions), len(jobApplications))
+		}
+
+		// Keys may pass between job applications on the way.
+		if _, err := tx.Exec(ctx, "SET CONSTRAINTS "+positionConstraint+" DEFERRED"); err != nil {
+			return err
+		}
+
+		// Bump the versions and record the moves like any other change, so
+		// clients reload the new keys instead of writing their old ones back.
+		batch := &pgx.Batch{}
+		for i, ja := range jobApplications {
+			if ja.Position == positions[i] {
+				continue
+			}
+
+			before := *ja
+			ja.Position = positions[i]
+
+			query, args, err := sq.Update("job_applications").
+				Set("position", ja.Position).
+				Set("version", sq.Expr("version + 1")).
+				Where(sq.Eq{"id": ja.ID}).
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			if err != nil {
+				return err
+			}
+			batch.Queue(query, args...)
+
+			event := kiseki.NewJobApplicationEvent(kiseki.NewJobApplicationEventParams{
+				JobApplicationID: ja.ID,
+				UserID:           ja.UserID,
+				Type:             kiseki.JobApplicationEventTypeUpdated,
+				Changes:          kiseki.DiffJobApplications(&before, ja),
+			})
+			query, args, err = insertEvent(&event)
+			if err != nil {
+				return err
+			}
+			batch.Queue(query, args...)
+		}
+
+		return tx.SendBatch(ctx, batch).Close()
+	})
+}
@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+func NewReminderRepository(pool *pgxpool.Pool) kiseki.ReminderRepository {
+	return &reminderRepository{pool: pool}
+}
+
+// reminderRepository keys reminders by when the follow-up fell due, so a job
+// application that falls due again, after a new follow-up date or status
+// change, is reminded of again.
+type reminderRepository struct {
+	pool *pgxpool.Pool
+}
+
+// reminderReasons maps follow-up reasons to the labels stored for them.
+var reminderReasons = map[kiseki.FollowUpReason]string{
+	kiseki.FollowUpReasonScheduled: "SCHEDULED",
+	kiseki.FollowUpReasonGhosted:   "GHOSTED",
+}
+
+func (r *reminderRepository) Record(ctx context.Context, followUp *kiseki.FollowUp) (bool, error) {
+	query, args, err := sq.Insert("follow_up_reminders").
+		Columns("job_application_id", "user_id", "reason", "due_at").
+		Values(
+			followUp.JobApplication.ID,
+			followUp.JobApplication.UserID,
+			reminderReasons[followUp.Reason],
+			followUp.DueAt,
+		).
+		Suffix("ON CONFLICT DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	tag, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *reminderRepository) Forget(ctx context.Context, followUp *kiseki.FollowUp) error {
+	query, args, err := sq.Delete("follow_up_reminders").
+		Where(sq.Eq{
+			"job_application_id": followUp.JobApplication.ID,
+			"reason":             reminderReasons[followUp.Reason],
+			"due_at":             followUp.DueAt,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// funnelRank returns an SQL expression ranking the status in column by how
+// far along kiseki.FunnelStages it is, from 1 for applied; statuses outside
+// the funnel rank 0.
+func funnelRank(column string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CASE %s", column)
+	for i, status := range kiseki.FunnelStages {
+		fmt.Fprintf(&b, " WHEN '%s' THEN %d", kiseki.StatusToDB(status), i+1)
+	}
+	b.WriteString(" ELSE 0 END")
+	return b.String()
+}
+
+// unresponsiveStatuses are the statuses that do not mean the employer
+// responded to a job application.
+var unresponsiveStatuses = []string{
+	kiseki.StatusToDB(kiseki.JobApplicationStatusUnspecified),
+	kiseki.StatusToDB(kiseki.JobApplicationStatusApplied),
+	kiseki.StatusToDB(kiseki.JobApplicationStatusWithdrawn),
+}
+
+// pipelineApplications selects from the job applications, as ja, that params
+// computes statistics over.
+func pipelineApplications(columns []string, params kiseki.PipelineStatsParams) sq.SelectBuilder {
+	builder := sq.Select(columns...).
+		From("job_applications ja").
+		Where(sq.Eq{"ja.user_id": params.UserID}).
+		Where(sq.Eq{"ja.deleted_at": nil})
+
+	if params.AppliedOnFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"ja.applied_on": *params.AppliedOnFrom})
+	}
+	if params.AppliedOnTo != nil {
+		builder = builder.Where(sq.LtOrEq{"ja.applied_on": *params.AppliedOnTo})
+	}
+
+	return builder
+}
+
+func (r *jobApplicationRepository) PipelineStats(ctx context.Context, params kiseki.PipelineStatsParams) (*kiseki.PipelineStats, error) {
+	stats := &kiseki.PipelineStats{
+		StatusCounts: map[kiseki.JobApplicationStatus]int{},
+		Reached:      map[kiseki.JobApplicationStatus]int{},
+	}
+
+	if err := r.countStatuses(ctx, params, stats); err != nil {
+		return nil, err
+	}
+	if err := r.countReached(ctx, params, stats); err != nil {
+		return nil, err
+	}
+	if err := r.measureTimeInStage(ctx, params, stats); err != nil {
+		return nil, err
+	}
+	if err := r.countWeeklyResponses(ctx, params, stats); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// countStatuses fills in stats.StatusCounts.
+func (r *jobApplicationRepository) countStatuses(ctx context.Context, params kiseki.PipelineStatsParams, stats *kiseki.PipelineStats) error {
+	query, args, err := pipelineApplications([]string{"ja.status", "count(*)"}, params).
+		GroupBy("ja.status").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return err
+		}
+		stats.StatusCounts[kiseki.StatusFromDB(status)] = count
+	}
+	return rows.Err()
+}
+
+// countReached fills in stats.Reached from the furthest funnel stage each job
+// application ever entered. Every job application has at least been applied
+// to, even one recorded as rejected straight away.
+func (r *jobApplicationRepository) countReached(ctx context.Context, params kiseki.PipelineStatsParams, stats *kiseki.PipelineStats) error {
+	furthest := fmt.Sprintf("greatest(1, max(%s), %s) AS stage", funnelRank("s.to_status"), funnelRank("ja.status"))
+	inner := pipelineApplications([]string{furthest}, params).
+		LeftJoin("job_application_status_changes s ON s.job_application_id = ja.id").
+		GroupBy("ja.id")
+
+	query, args, err := sq.Select("stage", "count(*)").
+		FromSelect(inner, "furthest").
+		GroupBy("stage").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var stage, count int
+		if err := rows.Scan(&stage, &count); err != nil {
+			return err
+		}
+		// Reaching a stage means passing through every stage before it.
+		for _, status := range kiseki.FunnelStages[:stage] {
+			stats.Reached[status] += count
+		}
+	}
+	return rows.Err()
+}
+
+// measureTimeInStage fills in stats.TimeInStage from the time between each
+// status change and the next. A job application's first status is taken to
+// start on the day it was applied on, if that is earlier than when it was
+// recorded.
+func (r *jobApplicationRepository) measureTimeInStage(ctx context.Context, params kiseki.PipelineStatsParams, stats *kiseki.PipelineStats) error {
+	stays := pipelineApplications([]string{
+		"s.to_status AS status",
+		`extract(epoch FROM lead(s.changed_at) OVER (PARTITION BY s.job_application_id ORDER BY s.changed_at, s.id)
+			- CASE WHEN s.from_status IS NULL THEN least(s.changed_at, ja.applied_on::timestamp) ELSE s.changed_at END)::float8 / 86400 AS days`,
+	}, params).
+		Join("job_application_status_changes s ON s.job_application_id = ja.id")
+
+	query, args, err := sq.Select("status", "percentile_cont(0.5) WITHIN GROUP (ORDER BY days)", "count(*)").
+		FromSelect(stays, "stays").
+		Where("days IS NOT NULL").
+		GroupBy("status").
+		OrderBy("status").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var status string
+		var d kiseki.StageDuration
+		if err := rows.Scan(&status, &d.MedianDays, &d.Count); err != nil {
+			return err
+		}
+		d.Status = kiseki.StatusFromDB(status)
+		stats.TimeInStage = append(stats.TimeInStage, d)
+	}
+	return rows.Err()
+}
+
+// countWeeklyResponses fills in stats.Weeks.
+func (r *jobApplicationRepository) countWeeklyResponses(ctx context.Context, params kiseki.PipelineStatsParams, stats *kiseki.PipelineStats) error {
+	responded := sq.Or{
+		sq.NotEq{"ja.status": unresponsiveStatuses},
+		sq.Expr("EXISTS (SELECT 1 FROM job_application_status_changes s WHERE s.job_application_id = ja.id AND s.to_status::text <> ALL (?))", unresponsiveStatuses),
+	}
+	respondedSQL, respondedArgs, err := responded.ToSql()
+	if err != nil {
+		return err
+	}
+
+	query, args, err := pipelineApplications([]string{
+		"date_trunc('week', ja.applied_on::timestamp)::date AS week",
+		"count(*)",
+	}, params).
+		Column(sq.Expr("count(*) FILTER (WHERE "+respondedSQL+")", respondedArgs...)).
+		GroupBy("week").
+		OrderBy("week").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var w kiseki.WeeklyResponses
+		if err := rows.Scan(&w.WeekStart, &w.Applied, &w.Responded); err != nil {
+			return err
+		}
+		stats.Weeks = append(stats.Weeks, w)
+	}
+	return rows.Err()
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+func (r *jobApplicationRepository) ListDeleted(ctx context.Context, params kiseki.ListDeletedJobApplicationsParams) ([]*kiseki.JobApplication, error) {
+	builder := sq.Select(jobApplicationColumns...).
+		From("job_applications").
+		Where(sq.Eq{"user_id": params.UserID}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		OrderBy("deleted_at DESC", "id DESC")
+
+	if params.After != nil {
+		builder = builder.Where(sq.Expr("(deleted_at, id) < (?, ?)", params.After.DeletedAt, params.After.ID))
+	}
+
+	if params.Limit > 0 {
+		builder = builder.Limit(uint64(params.Limit))
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobApplications(rows)
+}
+
+func (r *jobApplicationRepository) ListExpired(ctx context.Context, deletedBefore time.Time, limit int) ([]kiseki.ExpiredJobApplication, error) {
+	// A CV is kept while a job application that is not being purged still
+	// refers to it. The path comes from the client, so only CVs in the
+	// owner's own folder are returned; Purge checks again with IsUserFile.
+	query, args, err := sq.Select("id", "user_id").
+		Column(sq.Expr(`CASE WHEN split_part(cv, '/', 1) = user_id::TEXT AND NOT EXISTS (
+			SELECT 1 FROM job_applications other
+			WHERE other.cv = job_applications.cv
+			AND (other.deleted_at IS NULL OR other.deleted_at >= ?)
+		) THEN cv END`, deletedBefore)).
+		From("job_applications").
+		Where(sq.Lt{"deleted_at": deletedBefore}).
+		OrderBy("deleted_at ASC", "id ASC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var expired []kiseki.ExpiredJobApplication
+	for rows.Next() {
+		var e kiseki.ExpiredJobApplication
+		if err := rows.Scan(&e.ID, &e.UserID, &e.CV); err != nil {
+			return nil, err
+		}
+		if e.CV != nil && *e.CV == "" {
+			e.CV = nil
+		}
+		expired = append(expired, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return expired, nil
+}
+
+func (r *jobApplicationRepository) Purge(ctx context.Context, ids []string) error {
+	// History rows go with the job application through ON DELETE CASCADE.
+	query, args, err := sq.Delete("job_applications").
+		Where(sq.Eq{"id": ids}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,239 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"kiseki"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/samber/lo"
+)
+
+func NewUserSettingsRepository(pool *pgxpool.Pool) kiseki.UserSettingsRepository {
+	return &userSettingsRepository{pool: pool}
+}
+
+type userSettingsRepository struct {
+	pool *pgxpool.Pool
+}
+
+// userSettingsColumns are the columns scanUserSettings reads, in order.
+var userSettingsColumns = []string{
+	"user_id",
+	"strict_status_transitions",
+	"ghosting_rules",
+	"auto_reject_after_days",
+	"email",
+	"email_digest",
+	"email_reminders",
+	"unsubscribe_token",
+	"digest_sent_at",
+	"created_at",
+	"updated_at",
+}
+
+// ghostingRule is the JSON representation of a kiseki.GhostingRule stored in
+// the ghosting_rules column.
+type ghostingRule struct {
+	Status    string `json:"status"`
+	AfterDays int    `json:"after_days"`
+}
+
+func (r *userSettingsRepository) Save(ctx context.Context, settings *kiseki.UserSettings) error {
+	rules := make([]ghostingRule, 0, len(settings.GhostingRules))
+	for _, rule := range settings.GhostingRules {
+		rules = append(rules, ghostingRule{Status: kiseki.StatusToDB(rule.Status), AfterDays: rule.AfterDays})
+	}
+	rulesJSON, err := json.Marshal(rules)
+	if err != nil {
+		return err
+	}
+
+	query, args, err := sq.Insert("user_settings").
+		Columns(userSettingsColumns...).
+		Values(
+			settings.UserID,
+			settings.StrictStatusTransitions,
+			string(rulesJSON),
+			settings.AutoRejectAfterDays,
+			lo.EmptyableToPtr(settings.Email),
+			settings.EmailDigest,
+			settings.EmailReminders,
+			lo.EmptyableToPtr(settings.UnsubscribeToken),
+			settings.DigestSentAt,
+			settings.CreatedAt,
+			settings.UpdatedAt,
+		).
+		Suffix(`ON CONFLICT (user_id) DO UPDATE SET
+			strict_status_transitions = EXCLUDED.strict_status_transitions,
+			ghosting_rules = EXCLUDED.ghosting_rules,
+			auto_reject_after_days = EXCLUDED.auto_reject_after_days,
+			email = EXCLUDED.email,
+			email_digest = EXCLUDED.email_digest,
+			email_reminders = EXCLUDED.email_reminders,
+			unsubscribe_token = EXCLUDED.unsubscribe_token,
+			updated_at = EXCLUDED.updated_at`).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+func (r *userSettingsRepository) Find(ctx context.Context, userID string) (*kiseki.UserSettings, error) {
+	query, args, err := sq.Select(userSettingsColumns...).
+		From("user_settings").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := scanUserSettings(r.pool.QueryRow(ctx, query, args...))
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (r *userSettingsRepository) FindByUnsubscribeToken(ctx context.Context, token string) (*kiseki.UserSettings, error) {
+	query, args, err := sq.Select(userSettingsColumns...).
+		From("user_settings").
+		Where(sq.Eq{"unsubscribe_token": token}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := scanUserSettings(r.pool.QueryRow(ctx, query, args...))
+
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (r *userSettingsRepository) ListAutoRejecting(ctx context.Context) ([]*kiseki.UserSettings, error) {
+	return r.list(ctx, sq.Gt{"auto_reject_after_days": 0})
+}
+
+func (r *userSettingsRepository) ListNotifiable(ctx context.Context) ([]*kiseki.UserSettings, error) {
+	return r.list(ctx, sq.And{
+		sq.Or{sq.Eq{"email_digest": true}, sq.Eq{"email_reminders": true}},
+		sq.NotEq{"email": nil},
+		sq.NotEq{"unsubscribe_token": nil},
+	})
+}
+
+func (r *userSettingsRepository) MarkDigestSent(ctx context.Context, userID string, at time.Time) error {
+	query, args, err := sq.Update("user_settings").
+		Set("digest_sent_at", at).
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.pool.Exec(ctx, query, args...)
+	return err
+}
+
+// list lists the settings matching pred, ordered by user.
+func (r *userSettingsRepository) list(ctx context.Context, pred sq.Sqlizer) ([]*kiseki.UserSettings, error) {
+	query, args, err := sq.Select(userSettingsColumns...).
+		From("user_settings").
+		Where(pred).
+		OrderBy("user_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var settings []*kiseki.UserSettings
+	for rows.Next() {
+		s, err := scanUserSettings(rows)
+		if err != nil {
+			return nil, err
+		}
+		settings = append(settings, s)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return settings, nil
+}
+
+// scanUserSettings reads settings selected with userSettingsColumns. Users
+// who never chose ghosting rules get the default ones.
+func scanUserSettings(row pgx.Row) (*kiseki.UserSettings, error) {
+	var s kiseki.UserSettings
+	var rulesJSON []byte
+	var email, unsubscribeToken *string
+	err := row.Scan(
+		&s.UserID,
+		&s.StrictStatusTransitions,
+		&rulesJSON,
+		&s.AutoRejectAfterDays,
+		&email,
+		&s.EmailDigest,
+		&s.EmailReminders,
+		&unsubscribeToken,
+		&s.DigestSentAt,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	s.Email = lo.FromPtr(email)
+	s.UnsubscribeToken = lo.FromPtr(unsubscribeToken)
+
+	if rulesJSON == nil {
+		s.GhostingRules = kiseki.DefaultGhostingRules()
+		return &s, nil
+	}
+
+	var rules []ghostingRule
+	if err := json.Unmarshal(rulesJSON, &rules); err != nil {
+		return nil, err
+	}
+	s.GhostingRules = make([]kiseki.GhostingRule, 0, len(rules))
+	for _, rule := range rules {
+		s.GhostingRules = append(s.GhostingRules, kiseki.GhostingRule{
+			Status:    kiseki.StatusFromDB(rule.Status),
+			AfterDays: rule.AfterDays,
+		})
+	}
+
+	return &s, nil
+}
@@ -1,9 +1,158 @@
 package kiseki
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type JobApplicationRepository interface {
-	Save(ctx context.Context, jobApplication *JobApplication) error
+	// Save saves the job application and appends event, unless nil, in one
+	// transaction, so neither is kept without the other. It returns
+	// ErrVersionConflict if the job application was changed since it was
+	// read.
+	Save(ctx context.Context, jobApplication *JobApplication, event *JobApplicationEvent) error
 	Find(ctx context.Context, id string) (*JobApplication, error)
-	List(ctx context.Context, userID string) ([]*JobApplication, error)
+	List(ctx context.Context, params ListJobApplicationsParams) ([]*JobApplication, error)
+	Search(ctx context.Context, params SearchJobApplicationsParams) ([]*JobApplicationSearchResult, error)
+	// FindDeleted finds a job application that is in the trash.
+	FindDeleted(ctx context.Context, id string) (*JobApplication, error)
+	ListDeleted(ctx context.Context, params ListDeletedJobApplicationsParams) ([]*JobApplication, error)
+	// ListExpired returns up to limit job applications deleted before
+	// deletedBefore.
+	ListExpired(ctx context.Context, deletedBefore time.Time, limit int) ([]ExpiredJobApplication, error)
+	// Purge permanently deletes job applications along with their history.
+	Purge(ctx context.Context, ids []string) error
+	// Import saves new job applications along with the events recording
+	// their creation, all in one transaction. Job applications whose external
+	// ID the user already has are skipped. The others are linked to the
+	// user's companies by name, creating those they lack in the same
+	// transaction. It reports for each job application whether it was saved;
+	// with dryRun nothing is committed.
+	Import(ctx context.Context, jobApplications []ImportedJobApplication, dryRun bool) ([]bool, error)
+	// Export calls fn with each of the user's job applications outside the
+	// trash, oldest applied first. Rows are read from the database as fn
+	// consumes them, so exports of any size use little memory. It stops at
+	// the first error fn returns and returns it.
+	Export(ctx context.Context, params ExportJobApplicationsParams, fn func(*ExportedJobApplication) error) error
+	// PipelineStats computes statistics about how the selected job
+	// applications progressed through their statuses.
+	PipelineStats(ctx context.Context, params PipelineStatsParams) (*PipelineStats, error)
+	// ListDueFollowUps lists the job applications due a follow-up, which
+	// the caller checks with JobApplication.DueFollowUp.
+	ListDueFollowUps(ctx context.Context, params ListDueFollowUpsParams) ([]*JobApplication, error)
+	// Activity lists the job applications added and the status changes made
+	// over a period.
+	Activity(ctx context.Context, params ActivityParams) (*Activity, error)
+}
+
+// PositionRepository looks up and maintains the board positions of job
+// applications. Positions compare byte-wise, the same as fractional keys.
+type PositionRepository interface {
+	// Previous returns the greatest position in group below position, or the
+	// last position in group when position is empty. It returns an empty
+	// string when there is none.
+	Previous(ctx context.Context, group PositionGroup, position string, excludeID string) (string, error)
+	// Next returns the smallest position in group above position, or an empty
+	// string when there is none.
+	Next(ctx context.Context, group PositionGroup, position string, excludeID string) (string, error)
+	// Taken reports whether a job application other than excludeID already
+	// has position in group.
+	Taken(ctx context.Context, group PositionGroup, position string, excludeID string) (bool, error)
+	// ListGroupsToRebalance returns the groups with a position longer than
+	// maxLength or with duplicate positions.
+	ListGroupsToRebalance(ctx context.Context, maxLength int) ([]PositionGroup, error)
+	// Rebalance replaces every position in group, keeping the current order.
+	// keys is called with the number of job applications in the group and
+	// must return that many ascending positions. Each job application moved
+	// gets a new version and a history event, like any other change.
+	Rebalance(ctx context.Context, group PositionGroup, keys func(n int) ([]string, error)) error
+}
+
+type InterviewRepository interface {
+	Save(ctx context.Context, interview *Interview) error
+	Find(ctx context.Context, id string) (*Interview, error)
+	Delete(ctx context.Context, id string) error
+	ListByJobApplication(ctx context.Context, jobApplicationID string) ([]*Interview, error)
+	ListUpcoming(ctx context.Context, params ListUpcomingInterviewsParams) ([]*Interview, error)
+}
+
+type JobApplicationEventRepository interface {
+	ListByJobApplication(ctx context.Context, jobApplicationID string) ([]*JobApplicationEvent, error)
+	// ListByUser lists a user's events after a position, in position order.
+	// It leaves out events from transactions that started before one still
+	// running, so an event that commits later cannot land behind the last
+	// one listed.
+	ListByUser(ctx context.Context, params ListJobApplicationEventsParams) ([]*JobApplicationEvent, error)
+	// LatestPosition returns the position of the user's latest event that
+	// ListByUser would list, or the zero position if there is none.
+	LatestPosition(ctx context.Context, userID string) (EventPosition, error)
+	// HasUnsettled reports whether the user has committed events that
+	// ListByUser leaves out for now, because a transaction that started
+	// before theirs is still running.
+	HasUnsettled(ctx context.Context, userID string) (bool, error)
+}
+
+type UserSettingsRepository interface {
+	Save(ctx context.Context, settings *UserSettings) error
+	Find(ctx context.Context, userID string) (*UserSettings, error)
+	// ListAutoRejecting lists the settings of the users who have turned on
+	// rejecting ghosted job applications.
+	ListAutoRejecting(ctx context.Context) ([]*UserSettings, error)
+	// FindByUnsubscribeToken finds the settings carrying token.
+	FindByUnsubscribeToken(ctx context.Context, token string) (*UserSettings, error)
+	// ListNotifiable lists the settings of the users who have opted in to
+	// any email and have an address to send it to.
+	ListNotifiable(ctx context.Context) ([]*UserSettings, error)
+	// MarkDigestSent records when the user's last digest was sent, leaving
+	// the rest of their settings as they are.
+	MarkDigestSent(ctx context.Context, userID string, at time.Time) error
+}
+
+// ReminderRepository remembers which follow-ups users were reminded of, so
+// each is only emailed once.
+type ReminderRepository interface {
+	// Record notes that the user is being reminded of the follow-up. It
+	// reports false, recording nothing, if they already were.
+	Record(ctx context.Context, followUp *FollowUp) (bool, error)
+	// Forget undoes Record, for a reminder that could not be sent.
+	Forget(ctx context.Context, followUp *FollowUp) error
+}
+
+type CompanyRepository interface {
+	// Save saves the company, or returns ErrCompanyExists if the user has
+	// another company by that name. Renaming a company renames the job
+	// applications linked to it.
+	Save(ctx context.Context, company *Company) error
+	Find(ctx context.Context, id string) (*Company, error)
+	// FindOrCreate returns the user's company by the name, creating it if
+	// there is none.
+	FindOrCreate(ctx context.Context, userID string, name string) (*Company, error)
+	List(ctx context.Context, params ListCompaniesParams) ([]*CompanySummary, error)
+}
+
+type ContactRepository interface {
+	Save(ctx context.Context, contact *Contact) error
+	Find(ctx context.Context, id string) (*Contact, error)
+	// Delete deletes the contact and its links to job applications.
+	Delete(ctx context.Context, id string) error
+	List(ctx context.Context, params ListContactsParams) ([]*Contact, error)
+	// Link links the contact to the job application. Linking them again does
+	// nothing.
+	Link(ctx context.Context, contactID string, jobApplicationID string) error
+	Unlink(ctx context.Context, contactID string, jobApplicationID string) error
+	// Timeline lists the job applications outside the trash the contact is
+	// linked to, by the day they were applied on.
+	Timeline(ctx context.Context, contactID string) ([]*ContactTimelineEntry, error)
+}
+
+type DocumentRepository interface {
+	// Save saves the document, or returns ErrDocumentInUse if its kind
+	// changes while a job application refers to it.
+	Save(ctx context.Context, document *Document) error
+	Find(ctx context.Context, id string) (*Document, error)
+	List(ctx context.Context, params ListDocumentsParams) ([]*DocumentSummary, error)
+	Search(ctx context.Context, params SearchDocumentsParams) ([]*DocumentSearchResult, error)
+	// Delete deletes the document, or returns ErrDocumentInUse if a job
+	// application refers to it.
+	Delete(ctx context.Context, id string) error
 }
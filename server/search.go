@@ -0,0 +1,27 @@
+package kiseki
+
+// SearchJobApplicationsParams describes a full-text search over a user's job
+// applications.
+type SearchJobApplicationsParams struct {
+	UserID string
+	// Query uses web search syntax: quoted phrases, "or" and a leading "-"
+	// to exclude a word.
+	Query  string
+	Offset int
+	Limit  int
+}
+
+// JobApplicationSearchResult is a job application matching a search, with
+// its relevance and highlighted snippets of the fields that matched.
+type JobApplicationSearchResult struct {
+	JobApplication *JobApplication
+	Rank           float64
+	Snippets       []SearchSnippet
+}
+
+// SearchSnippet is an HTML excerpt of a matching field: the field's text is
+// escaped and the matched terms are wrapped in <mark> tags.
+type SearchSnippet struct {
+	Field JobApplicationField
+	Text  string
+}
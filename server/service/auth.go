@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"errors"
-	"fmt"
+	"slices"
 
 	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type contextKey string
@@ -35,11 +37,90 @@ var (
 	// ErrUnexpectedSigningMethod denotes a token was signed with an unexpected
 	// signing method.
 	ErrUnexpectedSigningMethod = errors.New("unexpected signing method")
+
+	// ErrTokenMissing denotes a request that needs a token did not carry a
+	// Bearer token in its Authorization header.
+	ErrTokenMissing = errors.New("JWT is missing")
+
+	// ErrTokenWrongIssuer denotes a token's issuer (iss) is not the expected one.
+	ErrTokenWrongIssuer = errors.New("JWT was issued by an unexpected issuer")
+
+	// ErrTokenWrongAudience denotes a token's audience (aud) does not include the
+	// expected one.
+	ErrTokenWrongAudience = errors.New("JWT is not intended for this audience")
+
+	// ErrTokenWrongRole denotes a token's role is not one that may call the API.
+	ErrTokenWrongRole = errors.New("JWT role is not allowed")
 )
 
 // ClaimsFactory is a factory for jwt.Claims.
 type ClaimsFactory func() jwt.Claims
 
+// ClaimsPolicy lists the values a token's claims must have. Empty fields are
+// not checked.
+type ClaimsPolicy struct {
+	// Issuer is the expected iss claim, such as
+	// "https://<project>.supabase.co/auth/v1".
+	Issuer string
+	// Audience must be one of the token's aud claims.
+	Audience string
+	// Roles are the role claims that may call the API.
+	Roles []string
+}
+
+// Check returns an error if claims do not satisfy the policy.
+func (p ClaimsPolicy) Check(claims jwt.Claims) error {
+	registered, ok := claims.(interface {
+		VerifyIssuer(cmp string, req bool) bool
+		VerifyAudience(cmp string, req bool) bool
+	})
+	if !ok {
+		return ErrTokenInvalid
+	}
+
+	if p.Issuer != "" && !registered.VerifyIssuer(p.Issuer, true) {
+		return ErrTokenWrongIssuer
+	}
+	if p.Audience != "" && !registered.VerifyAudience(p.Audience, true) {
+		return ErrTokenWrongAudience
+	}
+	if len(p.Roles) > 0 && !slices.Contains(p.Roles, claimsRole(claims)) {
+		return ErrTokenWrongRole
+	}
+	return nil
+}
+
+// claimsRole returns the role claim, or an empty string if there is none.
+func claimsRole(claims jwt.Claims) string {
+	switch c := claims.(type) {
+	case *SupabaseClaims:
+		return c.Role
+	case jwt.MapClaims:
+		role, _ := c["role"].(string)
+		return role
+	}
+	return ""
+}
+
+// getUserEmail returns the caller's email address from the JWT claims in the
+// context, or an empty string if they carry none.
+func getUserEmail(ctx context.Context) string {
+	switch c := GetClaims(ctx).(type) {
+	case *SupabaseClaims:
+		return c.Email
+	case jwt.MapClaims:
+		email, _ := c["email"].(string)
+		return email
+	}
+	return ""
+}
+
+// ContextWithClaims returns a copy of ctx carrying the JWT claims of the
+// caller, for GetClaims and the service methods to read.
+func ContextWithClaims(ctx context.Context, claims jwt.Claims) context.Context {
+	return context.WithValue(ctx, JWTClaimsContextKey, claims)
+}
+
 // GetClaims retrieves the JWT claims from the context.
 // Returns nil if no claims are found.
 func GetClaims(ctx context.Context) jwt.Claims {
@@ -54,7 +135,7 @@ func GetClaims(ctx context.Context) jwt.Claims {
 func getUserID(ctx context.Context) (string, error) {
 	claims, ok := ctx.Value(JWTClaimsContextKey).(jwt.Claims)
 	if !ok {
-		return "", fmt.Errorf("no JWT claims found in context")
+		return "", status.Errorf(codes.Unauthenticated, "no JWT claims found in context")
 	}
 
 	// Try to get Supabase claims
@@ -72,7 +153,7 @@ func getUserID(ctx context.Context) (string, error) {
 		}
 	}
 
-	return "", fmt.Errorf("no user ID found in JWT claims")
+	return "", status.Errorf(codes.Unauthenticated, "no user ID found in JWT claims")
 }
 
 // SupabaseClaims represents the claims in a Supabase JWT
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateCompany implements Service.
+func (s *service) CreateCompany(ctx context.Context, req *api.CreateCompanyRequest) (*api.CreateCompanyResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	company := kiseki.NewCompany(kiseki.NewCompanyParams{
+		UserID:   userID,
+		Name:     req.Name,
+		Website:  stringPtrFromValue(req.Website),
+		Location: stringPtrFromValue(req.Location),
+		Size:     kiseki.CompanySize(req.Size),
+		Industry: stringPtrFromValue(req.Industry),
+		Notes:    stringPtrFromValue(req.Notes),
+	})
+
+	if err := s.companyRepository.Save(ctx, &company); err != nil {
+		return nil, domainError(err)
+	}
+
+	return &api.CreateCompanyResponse{
+		Company: companyToAPI(&company),
+	}, nil
+}
+
+// UpdateCompany implements Service.
+func (s *service) UpdateCompany(ctx context.Context, req *api.UpdateCompanyRequest) (*api.UpdateCompanyResponse, error) {
+	company, err := s.companyRepository.Find(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if company == nil {
+		return nil, status.Errorf(codes.NotFound, "company not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if company.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to update this company")
+	}
+
+	company.Update(kiseki.UpdateCompanyParams{
+		Name:     req.Name,
+		Website:  stringPtrFromValue(req.Website),
+		Location: stringPtrFromValue(req.Location),
+		Size:     kiseki.CompanySize(req.Size),
+		Industry: stringPtrFromValue(req.Industry),
+		Notes:    stringPtrFromValue(req.Notes),
+	})
+
+	if err := s.companyRepository.Save(ctx, company); err != nil {
+		return nil, domainError(err)
+	}
+
+	return &api.UpdateCompanyResponse{
+		Company: companyToAPI(company),
+	}, nil
+}
+
+// ListCompanies implements Service.
+func (s *service) ListCompanies(ctx context.Context, req *api.ListCompaniesRequest) (*api.ListCompaniesResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries, err := s.companyRepository.List(ctx, kiseki.ListCompaniesParams{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListCompaniesResponse{
+		Companies: lo.Map(summaries, func(summary *kiseki.CompanySummary, _ int) *api.CompanySummary {
+			// Only the statuses the company has job applications in are
+			// listed.
+			var statusCounts []*api.StatusCount
+			for st := kiseki.JobApplicationStatusApplied; st <= kiseki.JobApplicationStatusAccepted; st++ {
+				if n := summary.StatusCounts[st]; n > 0 {
+					statusCounts = append(statusCounts, &api.StatusCount{
+						Status: api.JobApplicationStatus(st),
+						Count:  int32(n),
+					})
+				}
+			}
+
+			return &api.CompanySummary{
+				Company:          companyToAPI(summary.Company),
+				ApplicationCount: int32(summary.Applications()),
+				StatusCounts:     statusCounts,
+				LastAppliedOn:    timestampPtr(summary.LastAppliedOn),
+			}
+		}),
+	}, nil
+}
+
+// linkCompany links the job application to a company of the user: the one
+// its CompanyID names if byID and it has one, otherwise the one matching its
+// company name, created if the user has none by that name.
+func (s *service) linkCompany(ctx context.Context, userID string, ja *kiseki.JobApplication, byID bool) error {
+	if !byID || ja.CompanyID == nil {
+		company, err := s.companyRepository.FindOrCreate(ctx, userID, ja.Company)
+		if err != nil {
+			return err
+		}
+		ja.LinkCompany(company)
+		return nil
+	}
+
+	company, err := s.companyRepository.Find(ctx, *ja.CompanyID)
+	if err != nil {
+		return err
+	}
+
+	// Another user's company is reported as missing, so IDs cannot be probed.
+	if company == nil || company.UserID != userID {
+		return status.Errorf(codes.NotFound, "company not found")
+	}
+
+	ja.LinkCompany(company)
+	return nil
+}
+
+// companyToAPI converts a domain company to its API representation.
+func companyToAPI(c *kiseki.Company) *api.Company {
+	return &api.Company{
+		Id:        c.ID,
+		Name:      c.Name,
+		Website:   stringPtr(c.Website),
+		Location:  stringPtr(c.Location),
+		Size:      api.CompanySize(c.Size),
+		Industry:  stringPtr(c.Industry),
+		Notes:     stringPtr(c.Notes),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		UpdatedAt: timestamppb.New(c.UpdatedAt),
+	}
+}
+
+// checkCompanyRef checks that a contact of userID may work for the company
+// with the given ID. A nil ID refers to no company.
+func (s *service) checkCompanyRef(ctx context.Context, userID string, id *string) error {
+	if id == nil {
+		return nil
+	}
+
+	company, err := s.companyRepository.Find(ctx, *id)
+	if err != nil {
+		return err
+	}
+
+	// Report other users' companies as missing so IDs cannot be probed.
+	if company == nil || company.UserID != userID {
+		return status.Errorf(codes.InvalidArgument, "company %s not found", *id)
+	}
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"context"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateContact implements Service.
+func (s *service) CreateContact(ctx context.Context, req *api.CreateContactRequest) (*api.CreateContactResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	companyID := stringPtrFromValue(req.CompanyId)
+	if err := s.checkCompanyRef(ctx, userID, companyID); err != nil {
+		return nil, err
+	}
+
+	contact := kiseki.NewContact(kiseki.NewContactParams{
+		UserID:      userID,
+		Name:        req.Name,
+		Role:        stringPtrFromValue(req.Role),
+		Email:       stringPtrFromValue(req.Email),
+		Phone:       stringPtrFromValue(req.Phone),
+		LinkedInURL: stringPtrFromValue(req.LinkedinUrl),
+		CompanyID:   companyID,
+	})
+
+	if err := s.contactRepository.Save(ctx, &contact); err != nil {
+		return nil, err
+	}
+
+	return &api.CreateContactResponse{
+		Contact: contactToAPI(&contact),
+	}, nil
+}
+
+// GetContact implements Service.
+func (s *service) GetContact(ctx context.Context, req *api.GetContactRequest) (*api.GetContactResponse, error) {
+	contact, err := s.findContact(ctx, req.Id, "view")
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetContactResponse{
+		Contact: contactToAPI(contact),
+	}, nil
+}
+
+// UpdateContact implements Service.
+func (s *service) UpdateContact(ctx context.Context, req *api.UpdateContactRequest) (*api.UpdateContactResponse, error) {
+	contact, err := s.findContact(ctx, req.Id, "update")
+	if err != nil {
+		return nil, err
+	}
+
+	companyID := stringPtrFromValue(req.CompanyId)
+	if lo.FromPtr(companyID) != lo.FromPtr(contact.CompanyID) {
+		if err := s.checkCompanyRef(ctx, contact.UserID, companyID); err != nil {
+			return nil, err
+		}
+	}
+
+	contact.Update(kiseki.UpdateContactParams{
+		Name:        req.Name,
+		Role:        stringPtrFromValue(req.Role),
+		Email:       stringPtrFromValue(req.Email),
+		Phone:       stringPtrFromValue(req.Phone),
+		LinkedInURL: stringPtrFromValue(req.LinkedinUrl),
+		CompanyID:   companyID,
+	})
+
+	if err := s.contactRepository.Save(ctx, contact); err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateContactResponse{
+		Contact: contactToAPI(contact),
+	}, nil
+}
+
+// DeleteContact implements Service.
+func (s *service) DeleteContact(ctx context.Context, req *api.DeleteContactRequest) (*api.DeleteContactResponse, error) {
+	contact, err := s.findContact(ctx, req.Id, "delete")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.contactRepository.Delete(ctx, contact.ID); err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteContactResponse{}, nil
+}
+
+// ListContacts implements Service.
+func (s *service) ListContacts(ctx context.Context, req *api.ListContactsRequest) (*api.ListContactsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Contacts are listed per user, so filtering by another user's company
+	// or job application finds nothing.
+	contacts, err := s.contactRepository.List(ctx, kiseki.ListContactsParams{
+		UserID:           userID,
+		CompanyID:        stringPtrFromValue(req.CompanyId),
+		JobApplicationID: stringPtrFromValue(req.JobApplicationId),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListContactsResponse{
+		Contacts: lo.Map(contacts, func(c *kiseki.Contact, _ int) *api.Contact {
+			return contactToAPI(c)
+		}),
+	}, nil
+}
+
+// LinkContact implements Service.
+func (s *service) LinkContact(ctx context.Context, req *api.LinkContactRequest) (*api.LinkContactResponse, error) {
+	contact, err := s.findContact(ctx, req.ContactId, "link")
+	if err != nil {
+		return nil, err
+	}
+
+	ja, err := s.jobApplicationRepository.Find(ctx, req.JobApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja == nil {
+		return nil, status.Errorf(codes.NotFound, "job application not found")
+	}
+
+	if ja.UserID != contact.UserID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to link contacts to this job application")
+	}
+
+	if err := s.contactRepository.Link(ctx, contact.ID, ja.ID); err != nil {
+		return nil, err
+	}
+
+	return &api.LinkContactResponse{}, nil
+}
+
+// UnlinkContact implements Service.
+func (s *service) UnlinkContact(ctx context.Context, req *api.UnlinkContactRequest) (*api.UnlinkContactResponse, error) {
+	contact, err := s.findContact(ctx, req.ContactId, "unlink")
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the contact's own links can be removed, so the job application
+	// needs no check.
+	if err := s.contactRepository.Unlink(ctx, contact.ID, req.JobApplicationId); err != nil {
+		return nil, err
+	}
+
+	return &api.UnlinkContactResponse{}, nil
+}
+
+// GetContactTimeline implements Service.
+func (s *service) GetContactTimeline(ctx context.Context, req *api.GetContactTimelineRequest) (*api.GetContactTimelineResponse, error) {
+	contact, err := s.findContact(ctx, req.Id, "view")
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.contactRepository.Timeline(ctx, contact.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetContactTimelineResponse{
+		Entries: lo.Map(entries, func(e *kiseki.ContactTimelineEntry, _ int) *api.ContactTimelineEntry {
+			return &api.ContactTimelineEntry{
+				JobApplication: jobApplicationToAPI(e.JobApplication),
+				LinkedAt:       timestamppb.New(e.LinkedAt),
+				Events: lo.Map(e.History, func(event *kiseki.JobApplicationEvent, _ int) *api.JobApplicationEvent {
+					return jobApplicationEventToAPI(event)
+				}),
+			}
+		}),
+	}, nil
+}
+
+// findContact returns the contact with the given ID if it belongs to the
+// caller. action completes the permission error message.
+func (s *service) findContact(ctx context.Context, id string, action string) (*kiseki.Contact, error) {
+	contact, err := s.contactRepository.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if contact == nil {
+		return nil, status.Errorf(codes.NotFound, "contact not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if contact.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to %s this contact", action)
+	}
+
+	return contact, nil
+}
+
+// contactToAPI converts a domain contact to its API representation.
+func contactToAPI(c *kiseki.Contact) *api.Contact {
+	return &api.Contact{
+		Id:          c.ID,
+		Name:        c.Name,
+		Role:        stringPtr(c.Role),
+		Email:       stringPtr(c.Email),
+		Phone:       stringPtr(c.Phone),
+		LinkedinUrl: stringPtr(c.LinkedInURL),
+		CompanyId:   stringPtr(c.CompanyID),
+		CreatedAt:   timestamppb.New(c.CreatedAt),
+		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+	}
+}
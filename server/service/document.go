@@ -0,0 +1,416 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"strings"
+
+	"kiseki"
+	"kiseki/extract"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// UploadDocument implements Service.
+func (s *service) UploadDocument(ctx context.Context, req *api.UploadDocumentRequest) (*api.UploadDocumentResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Content) > kiseki.MaxDocumentSize {
+		return nil, domainError(kiseki.ErrDocumentTooLarge)
+	}
+
+	contentType, err := kiseki.DetectDocumentType(req.ContentType, req.Content)
+	if err != nil {
+		return nil, domainError(err)
+	}
+
+	// A document whose text cannot be read is still worth keeping; it just
+	// cannot be searched.
+	text, err := extract.Text(contentType, req.Content)
+	if err != nil {
+		log.Printf("Failed to extract text from %q: %v", req.Name, err)
+	}
+
+	document := kiseki.NewDocument(kiseki.NewDocumentParams{
+		UserID:      userID,
+		Kind:        kiseki.DocumentKind(req.Kind),
+		Name:        req.Name,
+		Label:       req.Label,
+		ContentType: contentType,
+		Size:        int64(len(req.Content)),
+		Text:        text,
+	})
+
+	// Store the content first, so a saved document always has content.
+	if err := s.blobStore.Put(ctx, document.StorageKey, bytes.NewReader(req.Content), document.Size, document.ContentType); err != nil {
+		return nil, err
+	}
+
+	if err := s.documentRepository.Save(ctx, &document); err != nil {
+		if deleteErr := s.blobStore.Delete(context.WithoutCancel(ctx), []string{document.StorageKey}); deleteErr != nil {
+			log.Printf("Failed to delete content of unsaved document %s: %v", document.ID, deleteErr)
+		}
+		return nil, err
+	}
+
+	return &api.UploadDocumentResponse{
+		Document: documentToAPI(&document),
+	}, nil
+}
+
+// GetDocument implements Service.
+func (s *service) GetDocument(ctx context.Context, req *api.GetDocumentRequest) (*api.GetDocumentResponse, error) {
+	document, err := s.findDocument(ctx, req.Id, "view")
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetDocumentResponse{
+		Document: documentToAPI(document),
+	}, nil
+}
+
+// DownloadDocument implements Service.
+func (s *service) DownloadDocument(ctx context.Context, req *api.DownloadDocumentRequest) (*api.DownloadDocumentResponse, error) {
+	document, err := s.findDocument(ctx, req.Id, "download")
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.blobStore.Get(ctx, document.StorageKey)
+	if errors.Is(err, kiseki.ErrBlobNotFound) {
+		return nil, status.Errorf(codes.DataLoss, "document content is missing")
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	content, err := io.ReadAll(io.LimitReader(r, kiseki.MaxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.DownloadDocumentResponse{
+		Document: documentToAPI(document),
+		Content:  content,
+	}, nil
+}
+
+// DeleteDocument implements Service.
+func (s *service) DeleteDocument(ctx context.Context, req *api.DeleteDocumentRequest) (*api.DeleteDocumentResponse, error) {
+	document, err := s.findDocument(ctx, req.Id, "delete")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.documentRepository.Delete(ctx, document.ID); err != nil {
+		return nil, domainError(err)
+	}
+
+	// The document is gone for the user either way; leftover content only
+	// costs storage.
+	if err := s.blobStore.Delete(ctx, []string{document.StorageKey}); err != nil {
+		log.Printf("Failed to delete content of document %s: %v", document.ID, err)
+	}
+
+	return &api.DeleteDocumentResponse{}, nil
+}
+
+// UpdateDocument implements Service.
+func (s *service) UpdateDocument(ctx context.Context, req *api.UpdateDocumentRequest) (*api.UpdateDocumentResponse, error) {
+	document, err := s.findDocument(ctx, req.Id, "update")
+	if err != nil {
+		return nil, err
+	}
+
+	document.Update(kiseki.UpdateDocumentParams{
+		Kind:  kiseki.DocumentKind(req.Kind),
+		Name:  req.Name,
+		Label: req.Label,
+	})
+
+	if err := s.documentRepository.Save(ctx, document); err != nil {
+		return nil, domainError(err)
+	}
+
+	return &api.UpdateDocumentResponse{
+		Document: documentToAPI(document),
+	}, nil
+}
+
+// ListDocuments implements Service.
+func (s *service) ListDocuments(ctx context.Context, req *api.ListDocumentsRequest) (*api.ListDocumentsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	params := kiseki.ListDocumentsParams{
+		UserID: userID,
+		Kind:   kiseki.DocumentKind(req.Kind),
+	}
+
+	if req.PageToken != "" {
+		token, err := decodeDocumentPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if token.Kind != params.Kind {
+			return nil, status.Errorf(codes.InvalidArgument, "page token does not match the requested kind")
+		}
+		params.After = &token.After
+	}
+
+	// Fetch one extra row to find out whether there is another page.
+	if limit > 0 {
+		params.Limit = limit + 1
+	}
+
+	summaries, err := s.documentRepository.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if limit > 0 && len(summaries) > limit {
+		summaries = summaries[:limit]
+		last := summaries[len(summaries)-1].Document
+		nextPageToken, err = encodeDocumentPageToken(documentPageToken{
+			Kind:  params.Kind,
+			After: kiseki.DocumentCursor{CreatedAt: last.CreatedAt, ID: last.ID},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.ListDocumentsResponse{
+		Documents: lo.Map(summaries, func(summary *kiseki.DocumentSummary, _ int) *api.DocumentSummary {
+			return &api.DocumentSummary{
+				Document:         documentToAPI(summary.Document),
+				ApplicationCount: int32(summary.ApplicationCount),
+			}
+		}),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// SearchDocuments implements Service.
+func (s *service) SearchDocuments(ctx context.Context, req *api.SearchDocumentsRequest) (*api.SearchDocumentsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "search query must not be empty")
+	}
+
+	limit, err := pageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultSearchPageSize
+	}
+
+	kind := kiseki.DocumentKind(req.Kind)
+
+	var offset int
+	if req.PageToken != "" {
+		token, err := decodeDocumentSearchPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if token.Query != query || token.Kind != kind {
+			return nil, status.Errorf(codes.InvalidArgument, "page token does not match the search query")
+		}
+		offset = token.Offset
+	}
+
+	// Fetch one extra result to find out whether there is another page.
+	results, err := s.documentRepository.Search(ctx, kiseki.SearchDocumentsParams{
+		UserID: userID,
+		Query:  query,
+		Kind:   kind,
+		Offset: offset,
+		Limit:  limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if len(results) > limit {
+		results = results[:limit]
+		nextPageToken, err = encodeDocumentSearchPageToken(documentSearchPageToken{Query: query, Kind: kind, Offset: offset + limit})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.SearchDocumentsResponse{
+		Results: lo.Map(results, func(r *kiseki.DocumentSearchResult, _ int) *api.DocumentSearchResult {
+			return &api.DocumentSearchResult{
+				Document:         documentToAPI(r.Document),
+				ApplicationCount: int32(r.ApplicationCount),
+				Rank:             r.Rank,
+				Snippet:          r.Snippet,
+			}
+		}),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// findDocument returns the document with the given ID if it belongs to the
+// caller. action completes the permission error message.
+func (s *service) findDocument(ctx context.Context, id string, action string) (*kiseki.Document, error) {
+	document, err := s.documentRepository.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if document == nil {
+		return nil, status.Errorf(codes.NotFound, "document not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if document.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to %s this document", action)
+	}
+
+	return document, nil
+}
+
+// documentKindNames are the document kinds as written in error messages.
+var documentKindNames = map[kiseki.DocumentKind]string{
+	kiseki.DocumentKindCV:          "a CV",
+	kiseki.DocumentKindCoverLetter: "a cover letter",
+}
+
+// checkDocumentRef checks that a job application of userID may refer to the
+// document with the given ID as a document of kind. A nil ID refers to no
+// document.
+func (s *service) checkDocumentRef(ctx context.Context, userID string, id *string, kind kiseki.DocumentKind) error {
+	if id == nil {
+		return nil
+	}
+
+	document, err := s.documentRepository.Find(ctx, *id)
+	if err != nil {
+		return err
+	}
+
+	// Report other users' documents as missing so IDs cannot be probed.
+	if document == nil || document.UserID != userID {
+		return status.Errorf(codes.InvalidArgument, "document %s not found", *id)
+	}
+
+	if document.Kind != kind {
+		return status.Errorf(codes.InvalidArgument, "document %s is not %s", *id, documentKindNames[kind])
+	}
+
+	return nil
+}
+
+// documentRefFromValue converts a document reference from the API, where an
+// empty ID clears the reference.
+func documentRefFromValue(v *wrapperspb.StringValue) *string {
+	if v.GetValue() == "" {
+		return nil
+	}
+	return &v.Value
+}
+
+// documentToAPI converts a domain document to its API representation.
+func documentToAPI(d *kiseki.Document) *api.Document {
+	return &api.Document{
+		Id:          d.ID,
+		Kind:        api.DocumentKind(d.Kind),
+		Name:        d.Name,
+		Label:       d.Label,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		CreatedAt:   timestamppb.New(d.CreatedAt),
+		TextPreview: d.TextPreview(),
+	}
+}
+
+// documentPageToken is the decoded form of a documents page token.
+type documentPageToken struct {
+	Kind  kiseki.DocumentKind   `json:"k,omitempty"`
+	After kiseki.DocumentCursor `json:"a"`
+}
+
+func encodeDocumentPageToken(token documentPageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeDocumentPageToken(s string) (documentPageToken, error) {
+	var token documentPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	if err := json.Unmarshal(b, &token); err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	return token, nil
+}
+
+// documentSearchPageToken is the decoded form of a document search page
+// token, addressed by offset like searchPageToken.
+type documentSearchPageToken struct {
+	Query  string              `json:"q"`
+	Kind   kiseki.DocumentKind `json:"k,omitempty"`
+	Offset int                 `json:"o"`
+}
+
+func encodeDocumentSearchPageToken(token documentSearchPageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeDocumentSearchPageToken(s string) (documentSearchPageToken, error) {
+	var token documentSearchPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	if err := json.Unmarshal(b, &token); err != nil || token.Offset < 0 {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	return token, nil
+}
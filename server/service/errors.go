@@ -0,0 +1,108 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+
+	"kiseki"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain reported in errdetails.ErrorInfo.
+const errorDomain = "kiseki"
+
+// domainError converts errors returned by the kiseki domain into gRPC status
+// errors. Errors it does not recognise are returned unchanged.
+func domainError(err error) error {
+	var transitionErr *kiseki.TransitionError
+	if errors.As(err, &transitionErr) {
+		st, detailErr := status.New(codes.FailedPrecondition, transitionErr.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: "INVALID_STATUS_TRANSITION",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"from":   kiseki.StatusToDB(transitionErr.From),
+				"to":     kiseki.StatusToDB(transitionErr.To),
+				"reason": transitionErr.Reason,
+			},
+		})
+		if detailErr != nil {
+			return status.Error(codes.FailedPrecondition, transitionErr.Error())
+		}
+		return st.Err()
+	}
+
+	var unknownFieldErr *kiseki.UnknownFieldError
+	if errors.As(err, &unknownFieldErr) {
+		st, detailErr := status.New(codes.InvalidArgument, unknownFieldErr.Error()).WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       "update_mask",
+				Description: unknownFieldErr.Error(),
+			}},
+		})
+		if detailErr != nil {
+			return status.Error(codes.InvalidArgument, unknownFieldErr.Error())
+		}
+		return st.Err()
+	}
+
+	if errors.Is(err, kiseki.ErrDocumentTooLarge) || errors.Is(err, kiseki.ErrUnsupportedDocumentType) {
+		st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       "content",
+				Description: err.Error(),
+			}},
+		})
+		if detailErr != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return st.Err()
+	}
+
+	if errors.Is(err, kiseki.ErrDocumentInUse) {
+		st, detailErr := status.New(codes.FailedPrecondition, err.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: "DOCUMENT_IN_USE",
+			Domain: errorDomain,
+		})
+		if detailErr != nil {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return st.Err()
+	}
+
+	if errors.Is(err, kiseki.ErrCompanyExists) {
+		st, detailErr := status.New(codes.AlreadyExists, err.Error()).WithDetails(&errdetails.ErrorInfo{
+			Reason: "COMPANY_EXISTS",
+			Domain: errorDomain,
+		})
+		if detailErr != nil {
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return st.Err()
+	}
+
+	return err
+}
+
+// versionConflictError reports that the client's copy of a job application is
+// out of date. The current copy is attached as a detail so the client can
+// merge its changes and retry with the current version.
+func versionConflictError(current *kiseki.JobApplication) error {
+	msg := "job application was changed by someone else, reload it and try again"
+	st, err := status.New(codes.Aborted, msg).WithDetails(
+		&errdetails.ErrorInfo{
+			Reason: "VERSION_CONFLICT",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"version": strconv.FormatInt(current.Version, 10),
+			},
+		},
+		jobApplicationToAPI(current),
+	)
+	if err != nil {
+		return status.Error(codes.Aborted, msg)
+	}
+	return st.Err()
+}
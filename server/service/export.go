@@ -0,0 +1,87 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+
+	"kiseki"
+	"kiseki/export"
+
+	"kiseki/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// exportChunkSize is the most export data sent in one response.
+const exportChunkSize = 32 << 10
+
+// ExportJobApplications implements Service. It streams an export of the
+// caller's job applications in chunks as they are read from the database.
+// The first response tells the content type and file name of the export.
+func (s *service) ExportJobApplications(ctx context.Context, req *api.ExportJobApplicationsRequest, send func(*api.ExportJobApplicationsResponse) error) error {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	format := export.Format(req.Format)
+	sender := &exportSender{send: send, format: format}
+	buf := bufio.NewWriterSize(sender, exportChunkSize)
+	w, err := export.NewWriter(format, buf)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	err = s.jobApplicationRepository.Export(ctx, kiseki.ExportJobApplicationsParams{
+		UserID:            userID,
+		IncludeHistory:    format == export.FormatNDJSON,
+		IncludeInterviews: format == export.FormatICS,
+	}, w.Write)
+	if err != nil {
+		return err
+	}
+
+	if err := w.Close(); err != nil {
+		return err
+	}
+	if err := buf.Flush(); err != nil {
+		return err
+	}
+	return sender.finish()
+}
+
+// exportSender sends the data written to it as export responses.
+type exportSender struct {
+	send   func(*api.ExportJobApplicationsResponse) error
+	format export.Format
+	sent   bool
+}
+
+// Write implements io.Writer.
+func (e *exportSender) Write(p []byte) (int, error) {
+	if err := e.sendData(bytes.Clone(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// finish makes sure a response was sent, even for an empty export, so the
+// client learns the content type and file name.
+func (e *exportSender) finish() error {
+	if e.sent {
+		return nil
+	}
+	return e.sendData(nil)
+}
+
+func (e *exportSender) sendData(data []byte) error {
+	res := &api.ExportJobApplicationsResponse{Data: data}
+	if !e.sent {
+		res.ContentType = e.format.ContentType()
+		res.FileName = e.format.FileName()
+		e.sent = true
+	}
+	return e.send(res)
+}
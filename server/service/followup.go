@@ -0,0 +1,178 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListDueFollowUps implements Service.
+func (s *service) ListDueFollowUps(ctx context.Context, req *api.ListDueFollowUpsRequest) (*api.ListDueFollowUpsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.userSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	followUps, err := dueFollowUps(ctx, s.jobApplicationRepository, settings, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListDueFollowUpsResponse{
+		FollowUps: lo.Map(followUps, func(f *kiseki.FollowUp, _ int) *api.DueFollowUp {
+			return &api.DueFollowUp{
+				JobApplication: jobApplicationToAPI(f.JobApplication),
+				Reason:         api.FollowUpReason(f.Reason),
+				DueAt:          timestamppb.New(f.DueAt),
+			}
+		}),
+	}, nil
+}
+
+// dueFollowUps returns the follow-ups due to the user at now under their
+// settings, longest overdue first.
+func dueFollowUps(ctx context.Context, jobApplicationRepository kiseki.JobApplicationRepository, settings kiseki.UserSettings, now time.Time) ([]*kiseki.FollowUp, error) {
+	jas, err := jobApplicationRepository.ListDueFollowUps(ctx, kiseki.ListDueFollowUpsParams{
+		UserID: settings.UserID,
+		Rules:  settings.GhostingRules,
+		Now:    now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	followUps := lo.FilterMap(jas, func(ja *kiseki.JobApplication, _ int) (*kiseki.FollowUp, bool) {
+		f := ja.DueFollowUp(settings.GhostingRules, now)
+		return f, f != nil
+	})
+	slices.SortStableFunc(followUps, func(a, b *kiseki.FollowUp) int {
+		return a.DueAt.Compare(b.DueAt)
+	})
+
+	return followUps, nil
+}
+
+// FollowUpWorker moves job applications to rejected once they have stayed
+// ghosted past the grace period their user chose.
+type FollowUpWorker struct {
+	service *service
+}
+
+func NewFollowUpWorker(
+	jobApplicationRepository kiseki.JobApplicationRepository,
+	jobApplicationEventRepository kiseki.JobApplicationEventRepository,
+	userSettingsRepository kiseki.UserSettingsRepository,
+	positionRepository kiseki.PositionRepository,
+) *FollowUpWorker {
+	return &FollowUpWorker{
+		service: &service{
+			jobApplicationRepository:      jobApplicationRepository,
+			jobApplicationEventRepository: jobApplicationEventRepository,
+			userSettingsRepository:        userSettingsRepository,
+			positionRepository:            positionRepository,
+		},
+	}
+}
+
+// Run evaluates the ghosting rules every interval until ctx is done.
+// Failures are logged and retried on the next tick.
+func (w *FollowUpWorker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := w.Evaluate(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to evaluate follow-ups: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Evaluate rejects every job application that has stayed ghosted past its
+// user's grace period. Job applications changed meanwhile, or that the
+// user's transition policy does not let move to rejected, are left alone.
+func (w *FollowUpWorker) Evaluate(ctx context.Context) error {
+	settings, err := w.service.userSettingsRepository.ListAutoRejecting(ctx)
+	if err != nil {
+		return err
+	}
+
+	rejected := 0
+	for _, s := range settings {
+		n, err := w.autoReject(ctx, *s)
+		if err != nil {
+			return err
+		}
+		rejected += n
+	}
+
+	if rejected > 0 {
+		log.Printf("Auto-rejected %d ghosted job applications", rejected)
+	}
+
+	return nil
+}
+
+// autoReject rejects the user's job applications that settings say are
+// ghosted past the grace period, returning how many it rejected.
+func (w *FollowUpWorker) autoReject(ctx context.Context, settings kiseki.UserSettings) (int, error) {
+	now := time.Now()
+	jas, err := w.service.jobApplicationRepository.ListDueFollowUps(ctx, kiseki.ListDueFollowUpsParams{
+		UserID: settings.UserID,
+		Rules:  settings.GhostingRules,
+		Now:    now,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	rejected := 0
+	for _, ja := range jas {
+		if !settings.AutoRejects(ja, now) {
+			continue
+		}
+
+		before := *ja
+		var transitionErr *kiseki.TransitionError
+		if err := ja.ChangeStatus(kiseki.JobApplicationStatusRejected, settings.TransitionPolicy()); errors.As(err, &transitionErr) {
+			continue
+		} else if err != nil {
+			return rejected, err
+		}
+
+		err := w.service.placeAndSave(ctx, ja, placement{}, func() error {
+			return w.service.jobApplicationRepository.Save(ctx, ja, historyEvent(settings.UserID, &before, ja))
+		})
+		if errors.Is(err, kiseki.ErrVersionConflict) {
+			// The user changed it since it was listed; the next run sees
+			// the change.
+			continue
+		}
+		if err != nil {
+			return rejected, err
+		}
+
+		rejected++
+	}
+
+	return rejected, nil
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetJobApplicationHistory implements Service.
+func (s *service) GetJobApplicationHistory(ctx context.Context, req *api.GetJobApplicationHistoryRequest) (*api.GetJobApplicationHistoryResponse, error) {
+	ja, err := s.jobApplicationRepository.Find(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja == nil {
+		return nil, status.Errorf(codes.NotFound, "job application not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to view this job application")
+	}
+
+	events, err := s.jobApplicationEventRepository.ListByJobApplication(ctx, ja.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetJobApplicationHistoryResponse{
+		Events: lo.Map(events, func(e *kiseki.JobApplicationEvent, _ int) *api.JobApplicationEvent {
+			return jobApplicationEventToAPI(e)
+		}),
+	}, nil
+}
+
+// jobApplicationEventToAPI converts a domain event to its API representation.
+func jobApplicationEventToAPI(e *kiseki.JobApplicationEvent) *api.JobApplicationEvent {
+	return &api.JobApplicationEvent{
+		Id:               e.ID,
+		JobApplicationId: e.JobApplicationID,
+		UserId:           e.UserID,
+		Type:             api.JobApplicationEventType(e.Type),
+		Changes: lo.Map(e.Changes, func(c kiseki.FieldChange, _ int) *api.JobApplicationFieldChange {
+			return &api.JobApplicationFieldChange{
+				Field:    string(c.Field),
+				OldValue: stringPtr(c.OldValue),
+				NewValue: stringPtr(c.NewValue),
+			}
+		}),
+		CreatedAt: timestamppb.New(e.CreatedAt),
+	}
+}
+
+// historyEvent returns the history event describing the change from before
+// to after, to be saved along with after. A nil before describes a creation.
+// It returns nil when no field changed.
+func historyEvent(userID string, before, after *kiseki.JobApplication) *kiseki.JobApplicationEvent {
+	changes := kiseki.DiffJobApplications(before, after)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	eventType := kiseki.JobApplicationEventTypeUpdated
+	switch {
+	case before == nil:
+		eventType = kiseki.JobApplicationEventTypeCreated
+	case after.DeletedAt != nil:
+		eventType = kiseki.JobApplicationEventTypeDeleted
+	case before.DeletedAt != nil:
+		eventType = kiseki.JobApplicationEventTypeRestored
+	case kiseki.HasFieldChange(changes, kiseki.JobApplicationFieldStatus):
+		eventType = kiseki.JobApplicationEventTypeStatusChanged
+	}
+
+	event := kiseki.NewJobApplicationEvent(kiseki.NewJobApplicationEventParams{
+		JobApplicationID: after.ID,
+		UserID:           userID,
+		Type:             eventType,
+		Changes:          changes,
+	})
+
+	return &event
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"bytes"
+	"context"
+
+	"kiseki"
+	"kiseki/fractional"
+	"kiseki/importer"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Importer imports job applications read by the importer package. An import
+// is all or nothing: it is saved in one transaction, and only if every row
+// is valid.
+type Importer struct {
+	jobApplicationRepository kiseki.JobApplicationRepository
+	positionRepository       kiseki.PositionRepository
+}
+
+func NewImporter(
+	jobApplicationRepository kiseki.JobApplicationRepository,
+	positionRepository kiseki.PositionRepository,
+) *Importer {
+	return &Importer{
+		jobApplicationRepository: jobApplicationRepository,
+		positionRepository:       positionRepository,
+	}
+}
+
+// Import imports rows as job applications of userID. With dryRun, or if any
+// row is invalid, nothing is saved and the report tells what importing would
+// do.
+func (i *Importer) Import(ctx context.Context, userID string, rows []importer.Row, dryRun bool) (*importer.Report, error) {
+	report := &importer.Report{Results: make([]importer.Result, len(rows))}
+
+	var jobApplications []*kiseki.JobApplication
+	var importedRows []int
+	for n, row := range rows {
+		result := &report.Results[n]
+		result.Row = row.Number
+		result.ExternalID = row.ExternalID
+
+		if row.Err != nil {
+			result.Outcome = importer.OutcomeInvalid
+			result.Err = row.Err
+			report.Invalid++
+			continue
+		}
+
+		params := row.Params
+		params.UserID = userID
+		if row.ExternalID != "" {
+			params.ExternalID = &row.ExternalID
+		}
+		ja := kiseki.NewJobApplication(params)
+		jobApplications = append(jobApplications, &ja)
+		importedRows = append(importedRows, n)
+	}
+
+	if err := i.place(ctx, userID, jobApplications); err != nil {
+		return nil, err
+	}
+
+	commit := !dryRun && report.Invalid == 0
+
+	imported := lo.Map(jobApplications, func(ja *kiseki.JobApplication, _ int) kiseki.ImportedJobApplication {
+		event := kiseki.NewJobApplicationEvent(kiseki.NewJobApplicationEventParams{
+			JobApplicationID: ja.ID,
+			UserID:           userID,
+			Type:             kiseki.JobApplicationEventTypeCreated,
+			Changes:          kiseki.DiffJobApplications(nil, ja),
+		})
+		return kiseki.ImportedJobApplication{JobApplication: ja, Event: &event}
+	})
+
+	// Run the import even when it will not be committed, so the report
+	// tells which rows were imported before.
+	saved, err := i.jobApplicationRepository.Import(ctx, imported, !commit)
+	if err != nil {
+		return nil, err
+	}
+
+	for n, ok := range saved {
+		result := &report.Results[importedRows[n]]
+		if ok {
+			result.Outcome = importer.OutcomeCreated
+			result.JobApplicationID = imported[n].JobApplication.ID
+			report.Created++
+		} else {
+			result.Outcome = importer.OutcomeSkipped
+			report.Skipped++
+		}
+	}
+	report.Committed = commit
+
+	return report, nil
+}
+
+// place puts imported job applications at the end of their columns, in the
+// order they were imported.
+func (i *Importer) place(ctx context.Context, userID string, jobApplications []*kiseki.JobApplication) error {
+	groups := lo.GroupBy(jobApplications, func(ja *kiseki.JobApplication) kiseki.JobApplicationStatus {
+		return ja.Status
+	})
+
+	for status, jas := range groups {
+		group := kiseki.PositionGroup{UserID: userID, Status: status}
+		last, err := i.positionRepository.Previous(ctx, group, "", "")
+		if err != nil {
+			return err
+		}
+
+		positions, err := fractional.NKeysBetween(last, "", len(jas))
+		if err != nil {
+			return positionError(err)
+		}
+		for n, ja := range jas {
+			ja.Position = positions[n]
+		}
+	}
+
+	return nil
+}
+
+// ImportJobApplications implements Service.
+func (s *service) ImportJobApplications(ctx context.Context, req *api.ImportJobApplicationsRequest) (*api.ImportJobApplicationsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := importer.Mapping{}
+	for _, m := range req.Mapping {
+		mapping[m.Field] = m.Column
+	}
+
+	rows, err := importer.Read(bytes.NewReader(req.Content), importer.Options{
+		Format:   importer.Format(req.Format),
+		Mapping:  mapping,
+		DayFirst: req.DayFirst,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	report, err := s.jobApplicationImporter.Import(ctx, userID, rows, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ImportJobApplicationsResponse{
+		Results: lo.Map(report.Results, func(r importer.Result, _ int) *api.ImportRowResult {
+			result := &api.ImportRowResult{
+				Row:              int32(r.Row),
+				ExternalId:       r.ExternalID,
+				Outcome:          api.ImportRowOutcome(r.Outcome),
+				JobApplicationId: r.JobApplicationID,
+			}
+			if r.Err != nil {
+				result.Field = r.Err.Field
+				result.Error = r.Err.Message
+			}
+			return result
+		}),
+		CreatedCount: int32(report.Created),
+		SkippedCount: int32(report.Skipped),
+		InvalidCount: int32(report.Invalid),
+		Committed:    report.Committed,
+	}, nil
+}
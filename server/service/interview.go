@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultTimeZone is used for interviews scheduled without a time zone.
+const defaultTimeZone = "UTC"
+
+// CreateInterview implements Service.
+func (s *service) CreateInterview(ctx context.Context, req *api.CreateInterviewRequest) (*api.CreateInterviewResponse, error) {
+	ja, err := s.jobApplicationRepository.Find(ctx, req.JobApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja == nil {
+		return nil, status.Errorf(codes.NotFound, "job application not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to schedule interviews for this job application")
+	}
+
+	interview := kiseki.NewInterview(kiseki.NewInterviewParams{
+		UserID:           userID,
+		JobApplicationID: ja.ID,
+		Round:            req.Round,
+		ScheduledAt:      req.ScheduledAt.AsTime(),
+		TimeZone:         timeZoneOrDefault(req.TimeZone),
+		Duration:         req.Duration.AsDuration(),
+		Interviewers:     req.Interviewers,
+		Format:           kiseki.InterviewFormat(req.Format),
+		Location:         req.Location,
+	})
+
+	if err := s.interviewRepository.Save(ctx, &interview); err != nil {
+		return nil, err
+	}
+
+	return &api.CreateInterviewResponse{
+		Interview: interviewToAPI(&interview),
+	}, nil
+}
+
+// ListInterviews implements Service.
+func (s *service) ListInterviews(ctx context.Context, req *api.ListInterviewsRequest) (*api.ListInterviewsResponse, error) {
+	ja, err := s.jobApplicationRepository.Find(ctx, req.JobApplicationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja == nil {
+		return nil, status.Errorf(codes.NotFound, "job application not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to view this job application")
+	}
+
+	interviews, err := s.interviewRepository.ListByJobApplication(ctx, ja.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ListInterviewsResponse{
+		Interviews: lo.Map(interviews, func(i *kiseki.Interview, _ int) *api.Interview {
+			return interviewToAPI(i)
+		}),
+	}, nil
+}
+
+// UpdateInterview implements Service.
+func (s *service) UpdateInterview(ctx context.Context, req *api.UpdateInterviewRequest) (*api.UpdateInterviewResponse, error) {
+	interview, err := s.interviewRepository.Find(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if interview == nil {
+		return nil, status.Errorf(codes.NotFound, "interview not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if interview.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to update this interview")
+	}
+
+	interview.Update(kiseki.UpdateInterviewParams{
+		Round:        req.Round,
+		ScheduledAt:  req.ScheduledAt.AsTime(),
+		TimeZone:     timeZoneOrDefault(req.TimeZone),
+		Duration:     req.Duration.AsDuration(),
+		Interviewers: req.Interviewers,
+		Format:       kiseki.InterviewFormat(req.Format),
+		Location:     req.Location,
+		Outcome:      kiseki.InterviewOutcome(req.Outcome),
+	})
+
+	if err := s.interviewRepository.Save(ctx, interview); err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateInterviewResponse{
+		Interview: interviewToAPI(interview),
+	}, nil
+}
+
+// DeleteInterview implements Service.
+func (s *service) DeleteInterview(ctx context.Context, req *api.DeleteInterviewRequest) (*api.DeleteInterviewResponse, error) {
+	interview, err := s.interviewRepository.Find(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if interview == nil {
+		return nil, status.Errorf(codes.NotFound, "interview not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if interview.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to delete this interview")
+	}
+
+	if err := s.interviewRepository.Delete(ctx, interview.ID); err != nil {
+		return nil, err
+	}
+
+	return &api.DeleteInterviewResponse{}, nil
+}
+
+// ListUpcomingInterviews implements Service.
+func (s *service) ListUpcomingInterviews(ctx context.Context, req *api.ListUpcomingInterviewsRequest) (*api.ListUpcomingInterviewsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	params := kiseki.ListUpcomingInterviewsParams{
+		UserID: userID,
+		From:   time.Now(),
+	}
+
+	if req.PageToken != "" {
+		token, err := decodeInterviewPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		// Keep paging from where the first page started, even as time moves on.
+		params.From = token.From
+		params.After = &token.After
+	}
+
+	// Fetch one extra row to find out whether there is another page.
+	if limit > 0 {
+		params.Limit = limit + 1
+	}
+
+	interviews, err := s.interviewRepository.ListUpcoming(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if limit > 0 && len(interviews) > limit {
+		interviews = interviews[:limit]
+		last := interviews[len(interviews)-1]
+		nextPageToken, err = encodeInterviewPageToken(interviewPageToken{
+			From:  params.From,
+			After: kiseki.InterviewCursor{ScheduledAt: last.ScheduledAt, ID: last.ID},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	upcoming := make([]*api.UpcomingInterview, 0, len(interviews))
+	for _, interview := range interviews {
+		ja, err := s.jobApplicationRepository.Find(ctx, interview.JobApplicationID)
+		if err != nil {
+			return nil, err
+		}
+
+		u := &api.UpcomingInterview{Interview: interviewToAPI(interview)}
+		if ja != nil {
+			u.JobApplication = jobApplicationToAPI(ja)
+		}
+		upcoming = append(upcoming, u)
+	}
+
+	return &api.ListUpcomingInterviewsResponse{
+		Interviews:    upcoming,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// interviewToAPI converts a domain interview to its API representation.
+func interviewToAPI(i *kiseki.Interview) *api.Interview {
+	return &api.Interview{
+		Id:               i.ID,
+		JobApplicationId: i.JobApplicationID,
+		Round:            i.Round,
+		ScheduledAt:      timestamppb.New(i.ScheduledAt),
+		TimeZone:         i.TimeZone,
+		Duration:         durationpb.New(i.Duration),
+		Interviewers:     i.Interviewers,
+		Format:           api.InterviewFormat(i.Format),
+		Location:         i.Location,
+		Outcome:          api.InterviewOutcome(i.Outcome),
+		CreatedAt:        timestamppb.New(i.CreatedAt),
+		UpdatedAt:        timestamppb.New(i.UpdatedAt),
+	}
+}
+
+func timeZoneOrDefault(tz string) string {
+	if tz == "" {
+		return defaultTimeZone
+	}
+	return tz
+}
+
+// interviewPageToken is the decoded form of an upcoming interviews page token.
+type interviewPageToken struct {
+	From  time.Time              `json:"f"`
+	After kiseki.InterviewCursor `json:"a"`
+}
+
+func encodeInterviewPageToken(token interviewPageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeInterviewPageToken(s string) (interviewPageToken, error) {
+	var token interviewPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	if err := json.Unmarshal(b, &token); err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	return token, nil
+}
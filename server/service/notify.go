@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"time"
+
+	"kiseki"
+	"kiseki/notify"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reminderWindow is how long after a follow-up falls due a reminder is still
+// sent for it. Older ones, such as those already due when the user opts in,
+// are left to the digest.
+const reminderWindow = 7 * 24 * time.Hour
+
+// Unsubscribe implements Service. It needs no sign-in: the token is the
+// proof the user received an email.
+func (s *service) Unsubscribe(ctx context.Context, req *api.UnsubscribeRequest) (*api.UnsubscribeResponse, error) {
+	settings, err := s.userSettingsRepository.FindByUnsubscribeToken(ctx, req.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	if settings == nil {
+		return nil, status.Errorf(codes.NotFound, "unsubscribe link not recognised")
+	}
+
+	settings.Unsubscribe()
+	if err := s.userSettingsRepository.Save(ctx, settings); err != nil {
+		return nil, err
+	}
+
+	return &api.UnsubscribeResponse{}, nil
+}
+
+// Mailer sends users the weekly digest and follow-up reminders they opted in
+// to.
+type Mailer struct {
+	jobApplicationRepository kiseki.JobApplicationRepository
+	interviewRepository      kiseki.InterviewRepository
+	userSettingsRepository   kiseki.UserSettingsRepository
+	reminderRepository       kiseki.ReminderRepository
+	notifier                 kiseki.Notifier
+	unsubscribeURL           *url.URL
+}
+
+// NewMailer returns a Mailer whose emails link to unsubscribeURL, with the
+// user's token added as the token query parameter.
+func NewMailer(
+	jobApplicationRepository kiseki.JobApplicationRepository,
+	interviewRepository kiseki.InterviewRepository,
+	userSettingsRepository kiseki.UserSettingsRepository,
+	reminderRepository kiseki.ReminderRepository,
+	notifier kiseki.Notifier,
+	unsubscribeURL *url.URL,
+) *Mailer {
+	return &Mailer{
+		jobApplicationRepository: jobApplicationRepository,
+		interviewRepository:      interviewRepository,
+		userSettingsRepository:   userSettingsRepository,
+		reminderRepository:       reminderRepository,
+		notifier:                 notifier,
+		unsubscribeURL:           unsubscribeURL,
+	}
+}
+
+// Run sends the emails due every interval until ctx is done. Failures are
+// logged and retried on the next tick.
+func (m *Mailer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Send(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to send emails: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Send sends every user the digest and reminders due to them. A failure for
+// one user is logged and does not hold up the others.
+func (m *Mailer) Send(ctx context.Context) error {
+	settings, err := m.userSettingsRepository.ListNotifiable(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range settings {
+		if err := m.sendTo(ctx, *s); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Failed to send emails to user %s: %v", s.UserID, err)
+		}
+	}
+
+	return nil
+}
+
+// sendTo sends the user the digest and reminders due to them.
+func (m *Mailer) sendTo(ctx context.Context, settings kiseki.UserSettings) error {
+	now := time.Now()
+
+	if settings.DigestDue(now) {
+		if err := m.sendDigest(ctx, settings, now); err != nil {
+			return err
+		}
+	}
+
+	if settings.EmailReminders {
+		if err := m.sendReminders(ctx, settings, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sendDigest sends the user the digest for the period up to now, unless
+// nothing happened in it.
+func (m *Mailer) sendDigest(ctx context.Context, settings kiseki.UserSettings, now time.Time) error {
+	digest, err := m.digest(ctx, settings, now)
+	if err != nil {
+		return err
+	}
+
+	if !digest.Empty() {
+		email, err := notify.Digest(settings.Email, digest, m.unsubscribeLink(settings))
+		if err != nil {
+			return err
+		}
+		if err := m.notifier.Send(ctx, email); err != nil {
+			return err
+		}
+	}
+
+	return m.userSettingsRepository.MarkDigestSent(ctx, settings.UserID, now)
+}
+
+// digest gathers what the user's digest up to now tells. It covers the time
+// since the last digest, or a period for the first one.
+func (m *Mailer) digest(ctx context.Context, settings kiseki.UserSettings, now time.Time) (*kiseki.Digest, error) {
+	since := now.Add(-kiseki.DigestPeriod)
+	if settings.DigestSentAt != nil && settings.DigestSentAt.After(since) {
+		since = *settings.DigestSentAt
+	}
+
+	activity, err := m.jobApplicationRepository.Activity(ctx, kiseki.ActivityParams{
+		UserID: settings.UserID,
+		Since:  since,
+		Until:  now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	digest := &kiseki.Digest{Since: since, Until: now, Activity: *activity}
+
+	interviews, err := m.interviewRepository.ListUpcoming(ctx, kiseki.ListUpcomingInterviewsParams{
+		UserID: settings.UserID,
+		From:   now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, interview := range interviews {
+		if interview.ScheduledAt.Sub(now) >= kiseki.DigestPeriod {
+			break
+		}
+		ja, err := m.jobApplicationRepository.Find(ctx, interview.JobApplicationID)
+		if err != nil {
+			return nil, err
+		}
+		digest.UpcomingInterviews = append(digest.UpcomingInterviews, kiseki.ScheduledInterview{
+			Interview:      interview,
+			JobApplication: ja,
+		})
+	}
+
+	followUps, err := dueFollowUps(ctx, m.jobApplicationRepository, settings, now)
+	if err != nil {
+		return nil, err
+	}
+	digest.Stale = lo.Filter(followUps, func(f *kiseki.FollowUp, _ int) bool {
+		return f.Reason == kiseki.FollowUpReasonGhosted
+	})
+
+	return digest, nil
+}
+
+// sendReminders emails the user about each follow-up that fell due within
+// reminderWindow and they were not reminded of yet.
+func (m *Mailer) sendReminders(ctx context.Context, settings kiseki.UserSettings, now time.Time) error {
+	followUps, err := dueFollowUps(ctx, m.jobApplicationRepository, settings, now)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range followUps {
+		if now.Sub(f.DueAt) > reminderWindow {
+			continue
+		}
+
+		email, err := notify.Reminder(settings.Email, f, m.unsubscribeLink(settings))
+		if err != nil {
+			return err
+		}
+
+		recorded, err := m.reminderRepository.Record(ctx, f)
+		if err != nil {
+			return err
+		}
+		if !recorded {
+			continue
+		}
+
+		if err := m.notifier.Send(ctx, email); err != nil {
+			// Leave it to be sent on the next run.
+			if forgetErr := m.reminderRepository.Forget(context.WithoutCancel(ctx), f); forgetErr != nil {
+				log.Printf("Failed to forget unsent reminder for job application %s: %v", f.JobApplication.ID, forgetErr)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unsubscribeLink returns the link that unsubscribes the user.
+func (m *Mailer) unsubscribeLink(settings kiseki.UserSettings) string {
+	u := *m.unsubscribeURL
+	q := u.Query()
+	q.Set("token", settings.UnsubscribeToken)
+	u.RawQuery = q.Encode()
+	return u.String()
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"kiseki"
+	"kiseki/notify"
+	"kiseki/notify/smtptest"
+
+	"kiseki/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mailerJobApplications serves the job applications the Mailer reads. Only
+// the methods it calls are implemented.
+type mailerJobApplications struct {
+	kiseki.JobApplicationRepository
+	byID     map[string]*kiseki.JobApplication
+	activity kiseki.Activity
+	due      []*kiseki.JobApplication
+}
+
+func (r *mailerJobApplications) Find(ctx context.Context, id string) (*kiseki.JobApplication, error) {
+	return r.byID[id], nil
+}
+
+func (r *mailerJobApplications) Activity(ctx context.Context, params kiseki.ActivityParams) (*kiseki.Activity, error) {
+	activity := r.activity
+	return &activity, nil
+}
+
+func (r *mailerJobApplications) ListDueFollowUps(ctx context.Context, params kiseki.ListDueFollowUpsParams) ([]*kiseki.JobApplication, error) {
+	return r.due, nil
+}
+
+type mailerInterviews struct {
+	kiseki.InterviewRepository
+	upcoming []*kiseki.Interview
+}
+
+func (r *mailerInterviews) ListUpcoming(ctx context.Context, params kiseki.ListUpcomingInterviewsParams) ([]*kiseki.Interview, error) {
+	return r.upcoming, nil
+}
+
+// memoryUserSettings keeps user settings in memory.
+type memoryUserSettings struct {
+	kiseki.UserSettingsRepository
+	settings map[string]*kiseki.UserSettings
+}
+
+func (r *memoryUserSettings) Find(ctx context.Context, userID string) (*kiseki.UserSettings, error) {
+	s, ok := r.settings[userID]
+	if !ok {
+		return nil, nil
+	}
+	found := *s
+	return &found, nil
+}
+
+func (r *memoryUserSettings) Save(ctx context.Context, settings *kiseki.UserSettings) error {
+	s := *settings
+	r.settings[s.UserID] = &s
+	return nil
+}
+
+func (r *memoryUserSettings) FindByUnsubscribeToken(ctx context.Context, token string) (*kiseki.UserSettings, error) {
+	for _, s := range r.settings {
+		if s.UnsubscribeToken == token {
+			found := *s
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *memoryUserSettings) ListNotifiable(ctx context.Context) ([]*kiseki.UserSettings, error) {
+	var notifiable []*kiseki.UserSettings
+	for _, s := range r.settings {
+		if (s.EmailDigest || s.EmailReminders) && s.Email != "" {
+			found := *s
+			notifiable = append(notifiable, &found)
+		}
+	}
+	return notifiable, nil
+}
+
+func (r *memoryUserSettings) MarkDigestSent(ctx context.Context, userID string, at time.Time) error {
+	r.settings[userID].DigestSentAt = &at
+	return nil
+}
+
+// memoryReminders records reminders by job application.
+type memoryReminders map[string]bool
+
+func (r memoryReminders) Record(ctx context.Context, followUp *kiseki.FollowUp) (bool, error) {
+	if r[followUp.JobApplication.ID] {
+		return false, nil
+	}
+	r[followUp.JobApplication.ID] = true
+	return true, nil
+}
+
+func (r memoryReminders) Forget(ctx context.Context, followUp *kiseki.FollowUp) error {
+	delete(r, followUp.JobApplication.ID)
+	return nil
+}
+
+// sentEmail is a message the SMTP server received, decoded.
+type sentEmail struct {
+	subject        string
+	text           string
+	html           string
+	unsubscribeURL string
+}
+
+func sentEmails(t *testing.T, server *smtptest.Server) []sentEmail {
+	t.Helper()
+	var emails []sentEmail
+	for _, msg := range server.Messages() {
+		header, parts, err := msg.Parts()
+		if err != nil {
+			t.Fatal(err)
+		}
+		emails = append(emails, sentEmail{
+			subject:        header.Get("Subject"),
+			text:           parts["text/plain"],
+			html:           parts["text/html"],
+			unsubscribeURL: strings.Trim(header.Get("List-Unsubscribe"), "<>"),
+		})
+	}
+	return emails
+}
+
+func assertContains(t *testing.T, name, s string, want ...string) {
+	t.Helper()
+	for _, w := range want {
+		if !strings.Contains(s, w) {
+			t.Errorf("%s does not contain %q:\n%s", name, w, s)
+		}
+	}
+}
+
+func TestMailerSendsDigestAndReminderUntilUnsubscribed(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	followUpOn := now.Add(-24 * time.Hour)
+
+	created := &kiseki.JobApplication{ID: "ja-created", UserID: "user-1", Title: "Data Engineer", Company: "Globex", Status: kiseki.JobApplicationStatusApplied, StatusChangedAt: now}
+	moved := &kiseki.JobApplication{ID: "ja-moved", UserID: "user-1", Title: "Platform Engineer", Company: "Initech", Status: kiseki.JobApplicationStatusInterview, StatusChangedAt: now}
+	scheduled := &kiseki.JobApplication{ID: "ja-scheduled", UserID: "user-1", Title: "Backend Engineer", Company: "Acme & Sons", Status: kiseki.JobApplicationStatusScreening, StatusChangedAt: now.AddDate(0, 0, -3), FollowUpOn: &followUpOn}
+	// Ghosted long enough ago to be left to the digest.
+	ghosted := &kiseki.JobApplication{ID: "ja-ghosted", UserID: "user-1", Title: "QA Engineer", Company: "Umbrella", Status: kiseki.JobApplicationStatusApplied, StatusChangedAt: now.AddDate(0, 0, -60)}
+
+	jobApplications := &mailerJobApplications{
+		byID: map[string]*kiseki.JobApplication{
+			created.ID: created, moved.ID: moved, scheduled.ID: scheduled, ghosted.ID: ghosted,
+		},
+		activity: kiseki.Activity{
+			Created: []*kiseki.JobApplication{created},
+			StageMoves: []kiseki.StageMove{{
+				JobApplication: moved,
+				From:           kiseki.JobApplicationStatusApplied,
+				To:             kiseki.JobApplicationStatusInterview,
+				At:             now,
+			}},
+		},
+		due: []*kiseki.JobApplication{scheduled, ghosted},
+	}
+	interviews := &mailerInterviews{upcoming: []*kiseki.Interview{{
+		ID:               "interview-1",
+		JobApplicationID: moved.ID,
+		Round:            "Technical screen",
+		ScheduledAt:      now.Add(48 * time.Hour),
+		TimeZone:         "Europe/London",
+	}}}
+	// The token has characters that must be escaped in a URL.
+	settings := &memoryUserSettings{settings: map[string]*kiseki.UserSettings{
+		"user-1": {
+			UserID:           "user-1",
+			GhostingRules:    kiseki.DefaultGhostingRules(),
+			Email:            "jo@example.com",
+			EmailDigest:      true,
+			EmailReminders:   true,
+			UnsubscribeToken: "tok+en/with=chars&more",
+		},
+	}}
+	reminders := memoryReminders{}
+
+	server, err := smtptest.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	notifier, err := notify.NewSMTPNotifier(notify.SMTPConfig{
+		Host: server.Host,
+		Port: server.Port,
+		From: "Kiseki <noreply@kiseki.example>",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unsubscribeURL, err := url.Parse("https://kiseki.example/unsubscribe?source=email")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mailer := NewMailer(jobApplications, interviews, settings, reminders, notifier, unsubscribeURL)
+
+	if err := mailer.Send(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	emails := sentEmails(t, server)
+	if len(emails) != 2 {
+		t.Fatalf("sent %d emails, want a digest and a reminder", len(emails))
+	}
+	digest, reminder := emails[0], emails[1]
+
+	if digest.subject != "Your week in job applications" {
+		t.Errorf("digest subject = %q", digest.subject)
+	}
+	assertContains(t, "digest text", digest.text,
+		"New applications\n- Data Engineer at Globex",
+		"Stage moves\n- Platform Engineer at Initech: applied to interviewing",
+		"Upcoming interviews\n- "+now.Add(48*time.Hour).In(mustLoadLocation(t, "Europe/London")).Format("Mon 2 Jan 2006 15:04 MST")+": Technical screen for Platform Engineer at Initech",
+		"Waiting on a reply\n- QA Engineer at Umbrella, applied since "+ghosted.StatusChangedAt.Format("Mon 2 Jan 2006"),
+		"Unsubscribe from these emails: "+digest.unsubscribeURL,
+	)
+	assertContains(t, "digest html", digest.html,
+		"<li>Data Engineer at Globex</li>",
+		"<li>QA Engineer at Umbrella, applied since ",
+	)
+	if strings.Contains(digest.text, "Backend Engineer") {
+		t.Errorf("digest lists a scheduled follow-up as waiting on a reply:\n%s", digest.text)
+	}
+
+	if reminder.subject != "Follow up: Backend Engineer at Acme & Sons" {
+		t.Errorf("reminder subject = %q", reminder.subject)
+	}
+	assertContains(t, "reminder text", reminder.text,
+		"Time to follow up\nBackend Engineer at Acme & Sons",
+		"You planned to follow up on this application on "+followUpOn.Format("Mon 2 Jan 2006")+".",
+		"Unsubscribe from these emails: "+reminder.unsubscribeURL,
+	)
+	assertContains(t, "reminder html", reminder.html,
+		"Backend Engineer at Acme &amp; Sons",
+		`<a href="`+strings.ReplaceAll(reminder.unsubscribeURL, "&", "&amp;")+`">Unsubscribe</a>`,
+	)
+
+	if settings.settings["user-1"].DigestSentAt == nil {
+		t.Error("digest not marked as sent")
+	}
+	if !reminders[scheduled.ID] || reminders[ghosted.ID] {
+		t.Errorf("recorded reminders = %v, want only %s", reminders, scheduled.ID)
+	}
+
+	// Nothing more is due on the next run.
+	if err := mailer.Send(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(server.Messages()); n != 2 {
+		t.Fatalf("sent %d emails after a second run, want 2", n)
+	}
+
+	// The token in the link unsubscribes the user.
+	link, err := url.Parse(reminder.unsubscribeURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if link.Query().Get("source") != "email" {
+		t.Errorf("unsubscribe link %s lost the configured query", link)
+	}
+	token := link.Query().Get("token")
+	if token != "tok+en/with=chars&more" {
+		t.Errorf("token in link = %q", token)
+	}
+
+	svc := &service{userSettingsRepository: settings}
+	if _, err := svc.Unsubscribe(ctx, &api.UnsubscribeRequest{Token: token}); err != nil {
+		t.Fatal(err)
+	}
+	if s := settings.settings["user-1"]; s.EmailDigest || s.EmailReminders {
+		t.Errorf("after unsubscribing: digest %v, reminders %v", s.EmailDigest, s.EmailReminders)
+	}
+
+	// No more emails once unsubscribed, even with a new follow-up due.
+	delete(reminders, scheduled.ID)
+	settings.settings["user-1"].DigestSentAt = nil
+	if err := mailer.Send(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(server.Messages()); n != 2 {
+		t.Errorf("sent %d emails after unsubscribing, want 2", n)
+	}
+}
+
+func TestUnsubscribeUnknownToken(t *testing.T) {
+	svc := &service{userSettingsRepository: &memoryUserSettings{settings: map[string]*kiseki.UserSettings{}}}
+
+	_, err := svc.Unsubscribe(context.Background(), &api.UnsubscribeRequest{Token: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Unsubscribe() error = %v, want NotFound", err)
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("time zone %s not available: %v", name, err)
+	}
+	return loc
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxPageSize caps the page size a client may request.
+	maxPageSize = 500
+)
+
+// pageToken is the decoded form of the opaque page tokens handed to clients.
+// It records the sort order it was issued for so a token cannot be replayed
+// against a differently sorted list.
+type pageToken struct {
+	SortKey    kiseki.JobApplicationSortKey `json:"k"`
+	Descending bool                         `json:"d"`
+	After      kiseki.JobApplicationCursor  `json:"a"`
+}
+
+func encodePageToken(token pageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var token pageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	if err := json.Unmarshal(b, &token); err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	return token, nil
+}
+
+// pageSize validates the requested page size. Zero means no limit, which
+// keeps the board able to load every job application in one request.
+func pageSize(requested int32) (int, error) {
+	if requested < 0 {
+		return 0, status.Errorf(codes.InvalidArgument, "page size must not be negative")
+	}
+	if requested > maxPageSize {
+		return maxPageSize, nil
+	}
+	return int(requested), nil
+}
+
+// jobApplicationFilterFromAPI converts an API filter to the domain filter.
+func jobApplicationFilterFromAPI(filter *api.JobApplicationFilter) kiseki.JobApplicationFilter {
+	if filter == nil {
+		return kiseki.JobApplicationFilter{}
+	}
+
+	f := kiseki.JobApplicationFilter{
+		Company:        filter.Company,
+		HasCV:          boolPtrFromValue(filter.HasCv),
+		HasCoverLetter: boolPtrFromValue(filter.HasCoverLetter),
+
+		CVDocumentID:          filter.CvDocumentId,
+		CoverLetterDocumentID: filter.CoverLetterDocumentId,
+		CVQuery:               strings.TrimSpace(filter.CvQuery),
+	}
+
+	for _, s := range filter.Statuses {
+		f.Statuses = append(f.Statuses, kiseki.JobApplicationStatus(s))
+	}
+
+	if filter.AppliedOnFrom != nil {
+		t := filter.AppliedOnFrom.AsTime()
+		f.AppliedOnFrom = &t
+	}
+
+	if filter.AppliedOnTo != nil {
+		t := filter.AppliedOnTo.AsTime()
+		f.AppliedOnTo = &t
+	}
+
+	return f
+}
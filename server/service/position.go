@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"kiseki"
+	"kiseki/fractional"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxPositionAttempts bounds how often a position is moved along when it is
+// already taken by another job application in the same column.
+const maxPositionAttempts = 10
+
+// placement describes where a job application should go within its column.
+// AfterID and BeforeID reference job applications already in the column;
+// Position is a key chosen by the client. When none are set the job
+// application goes to the end of the column.
+type placement struct {
+	AfterID  string
+	BeforeID string
+	Position string
+}
+
+// placeJobApplication works out the position of ja from p and sets it. The
+// position is unique within the job application's column.
+func (s *service) placeJobApplication(ctx context.Context, ja *kiseki.JobApplication, p placement) error {
+	group := kiseki.PositionGroupFor(ja)
+
+	position, err := s.positionFor(ctx, ja, group, p)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		taken, err := s.positionRepository.Taken(ctx, group, position, ja.ID)
+		if err != nil {
+			return err
+		}
+		if !taken {
+			break
+		}
+		if attempt == maxPositionAttempts {
+			return status.Errorf(codes.Aborted, "could not find a free position, try again")
+		}
+
+		// Move into the gap between the colliding key and the one after it.
+		next, err := s.positionRepository.Next(ctx, group, position, ja.ID)
+		if err != nil {
+			return err
+		}
+		position, err = fractional.KeyBetween(position, next)
+		if err != nil {
+			return positionError(err)
+		}
+	}
+
+	ja.Position = position
+	return nil
+}
+
+// placeAndSave places ja as p asks and saves it with save. Placing checks
+// that the position is free, but a move made at the same time can take it
+// before the save; the save then fails with kiseki.ErrPositionTaken and ja is
+// placed again.
+func (s *service) placeAndSave(ctx context.Context, ja *kiseki.JobApplication, p placement, save func() error) error {
+	for attempt := 0; ; attempt++ {
+		if err := s.placeJobApplication(ctx, ja, p); err != nil {
+			return err
+		}
+
+		err := save()
+		if !errors.Is(err, kiseki.ErrPositionTaken) {
+			return err
+		}
+		if attempt == maxPositionAttempts {
+			return status.Errorf(codes.Aborted, "could not find a free position, try again")
+		}
+	}
+}
+
+// positionFor returns the key p asks for, before checking it is free.
+func (s *service) positionFor(ctx context.Context, ja *kiseki.JobApplication, group kiseki.PositionGroup, p placement) (string, error) {
+	if p.AfterID == "" && p.BeforeID == "" {
+		if p.Position != "" {
+			if err := fractional.Validate(p.Position); err != nil {
+				return "", status.Errorf(codes.InvalidArgument, "invalid position: %v", err)
+			}
+			return p.Position, nil
+		}
+
+		last, err := s.positionRepository.Previous(ctx, group, "", ja.ID)
+		if err != nil {
+			return "", err
+		}
+		key, err := fractional.KeyBetween(last, "")
+		if err != nil {
+			return "", positionError(err)
+		}
+		return key, nil
+	}
+
+	lower, err := s.referencePosition(ctx, ja, p.AfterID)
+	if err != nil {
+		return "", err
+	}
+	upper, err := s.referencePosition(ctx, ja, p.BeforeID)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case p.BeforeID == "":
+		upper, err = s.positionRepository.Next(ctx, group, lower, ja.ID)
+	case p.AfterID == "":
+		lower, err = s.positionRepository.Previous(ctx, group, upper, ja.ID)
+	case lower >= upper:
+		// The references share a position or the client's order is out of
+		// date. Trust the after reference and go straight after it.
+		upper, err = s.positionRepository.Next(ctx, group, lower, ja.ID)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	key, err := fractional.KeyBetween(lower, upper)
+	if err != nil {
+		return "", positionError(err)
+	}
+	return key, nil
+}
+
+// referencePosition returns the position of the job application id, which
+// must be another of the user's job applications in the same column as ja.
+// An empty id returns an empty position.
+func (s *service) referencePosition(ctx context.Context, ja *kiseki.JobApplication, id string) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	if id == ja.ID {
+		return "", status.Errorf(codes.InvalidArgument, "a job application cannot be placed relative to itself")
+	}
+
+	ref, err := s.jobApplicationRepository.Find(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	if ref == nil || ref.UserID != ja.UserID {
+		return "", status.Errorf(codes.NotFound, "referenced job application %s not found", id)
+	}
+
+	if ref.Status != ja.Status {
+		return "", status.Errorf(codes.InvalidArgument, "referenced job application %s is not in the target status", id)
+	}
+
+	return ref.Position, nil
+}
+
+// positionError converts an error from the fractional package. Invalid keys
+// can only come from stored positions, which the rebalancer repairs.
+func positionError(err error) error {
+	if errors.Is(err, fractional.ErrKeySpaceExhausted) {
+		return status.Errorf(codes.ResourceExhausted, "no position is left in this column")
+	}
+	return status.Errorf(codes.FailedPrecondition, "cannot position job application: %v", err)
+}
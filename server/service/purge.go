@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"kiseki"
+
+	"github.com/samber/lo"
+)
+
+// purgeBatchSize is how many job applications are purged at once.
+const purgeBatchSize = 100
+
+// TrashPurger permanently deletes job applications that have been in the
+// trash for longer than the retention period, along with their CV files.
+type TrashPurger struct {
+	jobApplicationRepository kiseki.JobApplicationRepository
+	fileStore                kiseki.FileStore
+	retention                time.Duration
+}
+
+func NewTrashPurger(
+	jobApplicationRepository kiseki.JobApplicationRepository,
+	fileStore kiseki.FileStore,
+	retention time.Duration,
+) *TrashPurger {
+	return &TrashPurger{
+		jobApplicationRepository: jobApplicationRepository,
+		fileStore:                fileStore,
+		retention:                retention,
+	}
+}
+
+// Run purges every interval until ctx is done. Failures are logged and
+// retried on the next tick.
+func (p *TrashPurger) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := p.Purge(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to purge deleted job applications: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Purge deletes every job application whose retention period has passed.
+// Files are deleted before the rows that refer to them, so a failure leaves
+// the rows to be retried rather than orphaning the files.
+func (p *TrashPurger) Purge(ctx context.Context) error {
+	deletedBefore := time.Now().Add(-p.retention)
+
+	purged := 0
+	for {
+		expired, err := p.jobApplicationRepository.ListExpired(ctx, deletedBefore, purgeBatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(expired) == 0 {
+			break
+		}
+
+		cvs := lo.FilterMap(expired, func(e kiseki.ExpiredJobApplication, _ int) (string, bool) {
+			if e.CV == nil {
+				return "", false
+			}
+			// Never delete another user's file, whatever the row says.
+			if !kiseki.IsUserFile(e.UserID, *e.CV) {
+				log.Printf("Not deleting CV %q of job application %s outside the user's folder", *e.CV, e.ID)
+				return "", false
+			}
+			return *e.CV, true
+		})
+		if err := p.fileStore.Delete(ctx, lo.Uniq(cvs)); err != nil {
+			return err
+		}
+
+		ids := lo.Map(expired, func(e kiseki.ExpiredJobApplication, _ int) string {
+			return e.ID
+		})
+		if err := p.jobApplicationRepository.Purge(ctx, ids); err != nil {
+			return err
+		}
+
+		purged += len(expired)
+		if len(expired) < purgeBatchSize {
+			break
+		}
+	}
+
+	if purged > 0 {
+		log.Printf("Purged %d deleted job applications", purged)
+	}
+
+	return nil
+}
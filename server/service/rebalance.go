@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"kiseki"
+	"kiseki/fractional"
+)
+
+// PositionRebalancer gives board columns fresh, evenly spaced positions once
+// their keys grow longer than maxLength or collide. Keys grow each time a
+// card is dropped between two neighbours, so a column that is reordered often
+// slowly accumulates long keys.
+type PositionRebalancer struct {
+	positionRepository kiseki.PositionRepository
+	maxLength          int
+}
+
+func NewPositionRebalancer(positionRepository kiseki.PositionRepository, maxLength int) *PositionRebalancer {
+	return &PositionRebalancer{
+		positionRepository: positionRepository,
+		maxLength:          maxLength,
+	}
+}
+
+// Run rebalances every interval until ctx is done. Failures are logged and
+// retried on the next tick.
+func (r *PositionRebalancer) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Rebalance(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to rebalance positions: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Rebalance rewrites the positions of every column that needs it.
+func (r *PositionRebalancer) Rebalance(ctx context.Context) error {
+	groups, err := r.positionRepository.ListGroupsToRebalance(ctx, r.maxLength)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		err := r.positionRepository.Rebalance(ctx, group, func(n int) ([]string, error) {
+			return fractional.NKeysBetween("", "", n)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(groups) > 0 {
+		log.Printf("Rebalanced positions in %d columns", len(groups))
+	}
+
+	return nil
+}
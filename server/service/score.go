@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"kiseki/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScoreJobApplication implements Service.
+func (s *service) ScoreJobApplication(ctx context.Context, req *api.ScoreJobApplicationRequest) (*api.ScoreJobApplicationResponse, error) {
+	ja, err := s.jobApplicationRepository.Find(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja == nil {
+		return nil, status.Errorf(codes.NotFound, "job application not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to score this job application")
+	}
+
+	if ja.Description == nil || *ja.Description == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "job application has no description to score the CV against")
+	}
+
+	if ja.CVDocumentID == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "job application has no CV document to score")
+	}
+
+	cv, err := s.documentRepository.Find(ctx, *ja.CVDocumentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cv == nil || cv.Text == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "no text could be read from the CV")
+	}
+
+	match := s.skillDictionary.Score(*ja.Description, cv.Text)
+
+	before := *ja
+	ja.SetMatchScore(match.Score)
+
+	// Scoring again without changes leaves the job application as it is.
+	if before.MatchScore == nil || *before.MatchScore != *ja.MatchScore {
+		if err := s.saveJobApplication(ctx, userID, &before, ja); err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.ScoreJobApplicationResponse{
+		JobApplication: jobApplicationToAPI(ja),
+		Score:          int32(match.Score),
+		MatchedSkills:  match.MatchedSkills,
+		MissingSkills:  match.MissingSkills,
+		KeywordOverlap: match.KeywordOverlap,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultSearchPageSize is used when a search does not ask for a page size.
+	defaultSearchPageSize = 20
+)
+
+// searchPageToken is the decoded form of a search page token. Search results
+// are ranked, so pages are addressed by offset rather than by cursor.
+type searchPageToken struct {
+	Query  string `json:"q"`
+	Offset int    `json:"o"`
+}
+
+// SearchJobApplications implements Service.
+func (s *service) SearchJobApplications(ctx context.Context, req *api.SearchJobApplicationsRequest) (*api.SearchJobApplicationsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := strings.TrimSpace(req.Query)
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "search query must not be empty")
+	}
+
+	limit, err := pageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	if limit == 0 {
+		limit = defaultSearchPageSize
+	}
+
+	var offset int
+	if req.PageToken != "" {
+		token, err := decodeSearchPageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if token.Query != query {
+			return nil, status.Errorf(codes.InvalidArgument, "page token does not match the search query")
+		}
+		offset = token.Offset
+	}
+
+	// Fetch one extra result to find out whether there is another page.
+	results, err := s.jobApplicationRepository.Search(ctx, kiseki.SearchJobApplicationsParams{
+		UserID: userID,
+		Query:  query,
+		Offset: offset,
+		Limit:  limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if len(results) > limit {
+		results = results[:limit]
+		nextPageToken, err = encodeSearchPageToken(searchPageToken{Query: query, Offset: offset + limit})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.SearchJobApplicationsResponse{
+		Results: lo.Map(results, func(r *kiseki.JobApplicationSearchResult, _ int) *api.JobApplicationSearchResult {
+			return &api.JobApplicationSearchResult{
+				JobApplication: jobApplicationToAPI(r.JobApplication),
+				Rank:           r.Rank,
+				Snippets: lo.Map(r.Snippets, func(snippet kiseki.SearchSnippet, _ int) *api.JobApplicationSearchSnippet {
+					return &api.JobApplicationSearchSnippet{
+						Field: string(snippet.Field),
+						Text:  snippet.Text,
+					}
+				}),
+			}
+		}),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func encodeSearchPageToken(token searchPageToken) (string, error) {
+	b, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeSearchPageToken(s string) (searchPageToken, error) {
+	var token searchPageToken
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	if err := json.Unmarshal(b, &token); err != nil || token.Offset < 0 {
+		return token, status.Errorf(codes.InvalidArgument, "invalid page token")
+	}
+	return token, nil
+}
@@ -2,10 +2,13 @@ package service
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"kiseki"
 
 	"kiseki/api/v1"
+	"kiseki/skills"
 
 	"github.com/samber/lo"
 	"google.golang.org/grpc/codes"
@@ -20,15 +23,85 @@ type Service interface {
 	UpdateJobApplication(ctx context.Context, req *api.UpdateJobApplicationRequest) (*api.UpdateJobApplicationResponse, error)
 	DeleteJobApplication(ctx context.Context, req *api.DeleteJobApplicationRequest) (*api.DeleteJobApplicationResponse, error)
 	UpdateJobApplicationStatus(ctx context.Context, req *api.UpdateJobApplicationStatusRequest) (*api.UpdateJobApplicationStatusResponse, error)
+	GetJobApplicationHistory(ctx context.Context, req *api.GetJobApplicationHistoryRequest) (*api.GetJobApplicationHistoryResponse, error)
+	SearchJobApplications(ctx context.Context, req *api.SearchJobApplicationsRequest) (*api.SearchJobApplicationsResponse, error)
+	GetUserSettings(ctx context.Context, req *api.GetUserSettingsRequest) (*api.GetUserSettingsResponse, error)
+	UpdateUserSettings(ctx context.Context, req *api.UpdateUserSettingsRequest) (*api.UpdateUserSettingsResponse, error)
+	ListDeletedJobApplications(ctx context.Context, req *api.ListDeletedJobApplicationsRequest) (*api.ListDeletedJobApplicationsResponse, error)
+	RestoreJobApplication(ctx context.Context, req *api.RestoreJobApplicationRequest) (*api.RestoreJobApplicationResponse, error)
+	CreateInterview(ctx context.Context, req *api.CreateInterviewRequest) (*api.CreateInterviewResponse, error)
+	ListInterviews(ctx context.Context, req *api.ListInterviewsRequest) (*api.ListInterviewsResponse, error)
+	UpdateInterview(ctx context.Context, req *api.UpdateInterviewRequest) (*api.UpdateInterviewResponse, error)
+	DeleteInterview(ctx context.Context, req *api.DeleteInterviewRequest) (*api.DeleteInterviewResponse, error)
+	ListUpcomingInterviews(ctx context.Context, req *api.ListUpcomingInterviewsRequest) (*api.ListUpcomingInterviewsResponse, error)
+	WatchJobApplications(ctx context.Context, req *api.WatchJobApplicationsRequest, send func(*api.WatchJobApplicationsResponse) error) error
+	UploadDocument(ctx context.Context, req *api.UploadDocumentRequest) (*api.UploadDocumentResponse, error)
+	GetDocument(ctx context.Context, req *api.GetDocumentRequest) (*api.GetDocumentResponse, error)
+	DownloadDocument(ctx context.Context, req *api.DownloadDocumentRequest) (*api.DownloadDocumentResponse, error)
+	DeleteDocument(ctx context.Context, req *api.DeleteDocumentRequest) (*api.DeleteDocumentResponse, error)
+	UpdateDocument(ctx context.Context, req *api.UpdateDocumentRequest) (*api.UpdateDocumentResponse, error)
+	ListDocuments(ctx context.Context, req *api.ListDocumentsRequest) (*api.ListDocumentsResponse, error)
+	SearchDocuments(ctx context.Context, req *api.SearchDocumentsRequest) (*api.SearchDocumentsResponse, error)
+	ScoreJobApplication(ctx context.Context, req *api.ScoreJobApplicationRequest) (*api.ScoreJobApplicationResponse, error)
+	ImportJobApplications(ctx context.Context, req *api.ImportJobApplicationsRequest) (*api.ImportJobApplicationsResponse, error)
+	ExportJobApplications(ctx context.Context, req *api.ExportJobApplicationsRequest, send func(*api.ExportJobApplicationsResponse) error) error
+	GetPipelineStats(ctx context.Context, req *api.GetPipelineStatsRequest) (*api.GetPipelineStatsResponse, error)
+	ListDueFollowUps(ctx context.Context, req *api.ListDueFollowUpsRequest) (*api.ListDueFollowUpsResponse, error)
+	Unsubscribe(ctx context.Context, req *api.UnsubscribeRequest) (*api.UnsubscribeResponse, error)
+	CreateCompany(ctx context.Context, req *api.CreateCompanyRequest) (*api.CreateCompanyResponse, error)
+	UpdateCompany(ctx context.Context, req *api.UpdateCompanyRequest) (*api.UpdateCompanyResponse, error)
+	ListCompanies(ctx context.Context, req *api.ListCompaniesRequest) (*api.ListCompaniesResponse, error)
+	CreateContact(ctx context.Context, req *api.CreateContactRequest) (*api.CreateContactResponse, error)
+	GetContact(ctx context.Context, req *api.GetContactRequest) (*api.GetContactResponse, error)
+	UpdateContact(ctx context.Context, req *api.UpdateContactRequest) (*api.UpdateContactResponse, error)
+	DeleteContact(ctx context.Context, req *api.DeleteContactRequest) (*api.DeleteContactResponse, error)
+	ListContacts(ctx context.Context, req *api.ListContactsRequest) (*api.ListContactsResponse, error)
+	LinkContact(ctx context.Context, req *api.LinkContactRequest) (*api.LinkContactResponse, error)
+	UnlinkContact(ctx context.Context, req *api.UnlinkContactRequest) (*api.UnlinkContactResponse, error)
+	GetContactTimeline(ctx context.Context, req *api.GetContactTimelineRequest) (*api.GetContactTimelineResponse, error)
 }
 
 type service struct {
-	jobApplicationRepository kiseki.JobApplicationRepository
+	jobApplicationRepository      kiseki.JobApplicationRepository
+	jobApplicationEventRepository kiseki.JobApplicationEventRepository
+	userSettingsRepository        kiseki.UserSettingsRepository
+	positionRepository            kiseki.PositionRepository
+	interviewRepository           kiseki.InterviewRepository
+	eventSubscriber               kiseki.JobApplicationEventSubscriber
+	documentRepository            kiseki.DocumentRepository
+	blobStore                     kiseki.BlobStore
+	skillDictionary               *skills.Dictionary
+	jobApplicationImporter        *Importer
+	companyRepository             kiseki.CompanyRepository
+	contactRepository             kiseki.ContactRepository
 }
 
-func NewService(jobApplicationRepository kiseki.JobApplicationRepository) Service {
+func NewService(
+	jobApplicationRepository kiseki.JobApplicationRepository,
+	jobApplicationEventRepository kiseki.JobApplicationEventRepository,
+	userSettingsRepository kiseki.UserSettingsRepository,
+	positionRepository kiseki.PositionRepository,
+	interviewRepository kiseki.InterviewRepository,
+	eventSubscriber kiseki.JobApplicationEventSubscriber,
+	documentRepository kiseki.DocumentRepository,
+	blobStore kiseki.BlobStore,
+	skillDictionary *skills.Dictionary,
+	companyRepository kiseki.CompanyRepository,
+	contactRepository kiseki.ContactRepository,
+) Service {
 	return &service{
-		jobApplicationRepository: jobApplicationRepository,
+		jobApplicationRepository:      jobApplicationRepository,
+		jobApplicationEventRepository: jobApplicationEventRepository,
+		userSettingsRepository:        userSettingsRepository,
+		positionRepository:            positionRepository,
+		interviewRepository:           interviewRepository,
+		eventSubscriber:               eventSubscriber,
+		documentRepository:            documentRepository,
+		blobStore:                     blobStore,
+		skillDictionary:               skillDictionary,
+		jobApplicationImporter:        NewImporter(jobApplicationRepository, positionRepository),
+		companyRepository:             companyRepository,
+		contactRepository:             contactRepository,
 	}
 }
 
@@ -39,38 +112,45 @@ func (s *service) CreateJobApplication(ctx context.Context, req *api.CreateJobAp
 		return nil, err
 	}
 
+	cvDocumentID := documentRefFromValue(req.CvDocumentId)
+	if err := s.checkDocumentRef(ctx, userID, cvDocumentID, kiseki.DocumentKindCV); err != nil {
+		return nil, err
+	}
+	coverLetterDocumentID := documentRefFromValue(req.CoverLetterDocumentId)
+	if err := s.checkDocumentRef(ctx, userID, coverLetterDocumentID, kiseki.DocumentKindCoverLetter); err != nil {
+		return nil, err
+	}
+
 	jobApplication := kiseki.NewJobApplication(kiseki.NewJobApplicationParams{
-		UserID:      userID,
-		Company:     req.Company,
-		Title:       req.Title,
-		Description: stringPtrFromValue(req.Description),
-		Notes:       stringPtrFromValue(req.Notes),
-		CV:          stringPtrFromValue(req.Cv),
-		CoverLetter: stringPtrFromValue(req.CoverLetter),
-		AppliedOn:   req.AppliedOn.AsTime(),
-		Status:      kiseki.JobApplicationStatus(req.Status),
-		Position:    req.Position,
+		UserID:                userID,
+		Company:               req.Company,
+		Title:                 req.Title,
+		Description:           stringPtrFromValue(req.Description),
+		Notes:                 stringPtrFromValue(req.Notes),
+		CV:                    stringPtrFromValue(req.Cv),
+		CoverLetter:           stringPtrFromValue(req.CoverLetter),
+		AppliedOn:             req.AppliedOn.AsTime(),
+		Status:                kiseki.JobApplicationStatus(req.Status),
+		Position:              req.Position,
+		CVDocumentID:          cvDocumentID,
+		CoverLetterDocumentID: coverLetterDocumentID,
+		FollowUpOn:            timePtrFromTimestamp(req.FollowUpOn),
+		CompanyID:             stringPtrFromValue(req.CompanyId),
 	})
 
-	if err := s.jobApplicationRepository.Save(ctx, &jobApplication); err != nil {
+	if err := s.linkCompany(ctx, userID, &jobApplication, true); err != nil {
+		return nil, err
+	}
+
+	err = s.placeAndSave(ctx, &jobApplication, placement{Position: req.Position}, func() error {
+		return s.saveJobApplication(ctx, userID, nil, &jobApplication)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return &api.CreateJobApplicationResponse{
-		JobApplication: &api.JobApplication{
-			Id:          jobApplication.ID,
-			Company:     jobApplication.Company,
-			Title:       jobApplication.Title,
-			Description: stringPtr(jobApplication.Description),
-			Notes:       stringPtr(jobApplication.Notes),
-			Cv:          stringPtr(jobApplication.CV),
-			CoverLetter: stringPtr(jobApplication.CoverLetter),
-			CreatedAt:   timestamppb.New(jobApplication.CreatedAt),
-			UpdatedAt:   timestamppb.New(jobApplication.UpdatedAt),
-			AppliedOn:   timestamppb.New(jobApplication.AppliedOn),
-			Status:      api.JobApplicationStatus(jobApplication.Status),
-			Position:    jobApplication.Position,
-		},
+		JobApplication: jobApplicationToAPI(&jobApplication),
 	}, nil
 }
 
@@ -94,9 +174,14 @@ func (s *service) DeleteJobApplication(ctx context.Context, req *api.DeleteJobAp
 		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to delete this job application")
 	}
 
+	if err := ja.CheckVersion(req.Version); err != nil {
+		return nil, versionConflictError(ja)
+	}
+
+	before := *ja
 	ja.Delete()
 
-	if err := s.jobApplicationRepository.Save(ctx, ja); err != nil {
+	if err := s.saveJobApplication(ctx, userID, &before, ja); err != nil {
 		return nil, err
 	}
 	return &api.DeleteJobApplicationResponse{}, nil
@@ -109,33 +194,73 @@ func (s *service) ListJobApplications(ctx context.Context, req *api.ListJobAppli
 		return nil, err
 	}
 
-	jas, err := s.jobApplicationRepository.List(ctx, userID)
+	limit, err := pageSize(req.PageSize)
 	if err != nil {
 		return nil, err
 	}
 
+	params := kiseki.ListJobApplicationsParams{
+		UserID:     userID,
+		Filter:     jobApplicationFilterFromAPI(req.Filter),
+		SortKey:    kiseki.JobApplicationSortKey(req.SortKey),
+		Descending: req.Descending,
+	}
+
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		if token.SortKey != params.SortKey || token.Descending != params.Descending {
+			return nil, status.Errorf(codes.InvalidArgument, "page token does not match the requested sort order")
+		}
+		params.After = &token.After
+	}
+
+	// Fetch one extra row to find out whether there is another page.
+	if limit > 0 {
+		params.Limit = limit + 1
+	}
+
+	jas, err := s.jobApplicationRepository.List(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if limit > 0 && len(jas) > limit {
+		jas = jas[:limit]
+		nextPageToken, err = encodePageToken(pageToken{
+			SortKey:    params.SortKey,
+			Descending: params.Descending,
+			After:      kiseki.CursorFor(jas[len(jas)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &api.ListJobApplicationsResponse{
 		JobApplications: lo.Map(jas, func(ja *kiseki.JobApplication, _ int) *api.JobApplication {
-			return &api.JobApplication{
-				Id:          ja.ID,
-				Company:     ja.Company,
-				Title:       ja.Title,
-				Description: stringPtr(ja.Description),
-				Notes:       stringPtr(ja.Notes),
-				Cv:          stringPtr(ja.CV),
-				CoverLetter: stringPtr(ja.CoverLetter),
-				CreatedAt:   timestamppb.New(ja.CreatedAt),
-				UpdatedAt:   timestamppb.New(ja.UpdatedAt),
-				AppliedOn:   timestamppb.New(ja.AppliedOn),
-				Status:      api.JobApplicationStatus(ja.Status),
-				Position:    ja.Position,
-			}
+			return jobApplicationToAPI(ja)
 		}),
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
 // UpdateJobApplication implements Service.
 func (s *service) UpdateJobApplication(ctx context.Context, req *api.UpdateJobApplicationRequest) (*api.UpdateJobApplicationResponse, error) {
+	// Without a field mask every field is replaced, as older clients expect,
+	// except those added since that they leave unset.
+	fields := unmaskedUpdateFields(req)
+	if paths := req.UpdateMask.GetPaths(); len(paths) > 0 {
+		var err error
+		fields, err = kiseki.ParseUpdateFields(paths)
+		if err != nil {
+			return nil, domainError(err)
+		}
+	}
+
 	ja, err := s.jobApplicationRepository.Find(ctx, req.Id)
 	if err != nil {
 		return nil, err
@@ -154,37 +279,74 @@ func (s *service) UpdateJobApplication(ctx context.Context, req *api.UpdateJobAp
 		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to update this job application")
 	}
 
-	ja.Update(kiseki.UpdateJobApplicationParams{
-		Company:     req.Company,
-		Title:       req.Title,
-		Description: stringPtrFromValue(req.Description),
-		Notes:       stringPtrFromValue(req.Notes),
-		CV:          stringPtrFromValue(req.Cv),
-		CoverLetter: stringPtrFromValue(req.CoverLetter),
-		AppliedOn:   req.AppliedOn.AsTime(),
-		Status:      kiseki.JobApplicationStatus(req.Status),
-		Position:    req.Position,
-	})
+	if err := ja.CheckVersion(req.Version); err != nil {
+		return nil, versionConflictError(ja)
+	}
 
-	if err := s.jobApplicationRepository.Save(ctx, ja); err != nil {
+	settings, err := s.userSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *ja
+	err = ja.Update(kiseki.UpdateJobApplicationParams{
+		Company:               req.Company,
+		Title:                 req.Title,
+		Description:           stringPtrFromValue(req.Description),
+		Notes:                 stringPtrFromValue(req.Notes),
+		CV:                    stringPtrFromValue(req.Cv),
+		CoverLetter:           stringPtrFromValue(req.CoverLetter),
+		AppliedOn:             req.AppliedOn.AsTime(),
+		Status:                kiseki.JobApplicationStatus(req.Status),
+		Position:              req.Position,
+		CVDocumentID:          documentRefFromValue(req.CvDocumentId),
+		CoverLetterDocumentID: documentRefFromValue(req.CoverLetterDocumentId),
+		FollowUpOn:            timePtrFromTimestamp(req.FollowUpOn),
+		CompanyID:             stringPtrFromValue(req.CompanyId),
+		Fields:                fields,
+	}, settings.TransitionPolicy())
+	if err != nil {
+		return nil, domainError(err)
+	}
+
+	// Only check the documents the update starts referring to.
+	if lo.FromPtr(ja.CVDocumentID) != lo.FromPtr(before.CVDocumentID) {
+		if err := s.checkDocumentRef(ctx, userID, ja.CVDocumentID, kiseki.DocumentKindCV); err != nil {
+			return nil, err
+		}
+	}
+	if lo.FromPtr(ja.CoverLetterDocumentID) != lo.FromPtr(before.CoverLetterDocumentID) {
+		if err := s.checkDocumentRef(ctx, userID, ja.CoverLetterDocumentID, kiseki.DocumentKindCoverLetter); err != nil {
+			return nil, err
+		}
+	}
+
+	// A new company ID takes the company's name; otherwise a new name, or a
+	// job application not linked to a company yet, is matched by name.
+	if ja.CompanyID != nil && lo.FromPtr(ja.CompanyID) != lo.FromPtr(before.CompanyID) {
+		if err := s.linkCompany(ctx, userID, ja, true); err != nil {
+			return nil, err
+		}
+	} else if ja.CompanyID == nil || ja.Company != before.Company {
+		if err := s.linkCompany(ctx, userID, ja, false); err != nil {
+			return nil, err
+		}
+	}
+
+	save := func() error {
+		return s.saveJobApplication(ctx, userID, &before, ja)
+	}
+	if ja.Status != before.Status || ja.Position != before.Position {
+		err = s.placeAndSave(ctx, ja, placement{Position: ja.Position}, save)
+	} else {
+		err = save()
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	return &api.UpdateJobApplicationResponse{
-		JobApplication: &api.JobApplication{
-			Id:          ja.ID,
-			Company:     ja.Company,
-			Title:       ja.Title,
-			Description: stringPtr(ja.Description),
-			Notes:       stringPtr(ja.Notes),
-			Cv:          stringPtr(ja.CV),
-			CoverLetter: stringPtr(ja.CoverLetter),
-			CreatedAt:   timestamppb.New(ja.CreatedAt),
-			UpdatedAt:   timestamppb.New(ja.UpdatedAt),
-			AppliedOn:   timestamppb.New(ja.AppliedOn),
-			Status:      api.JobApplicationStatus(ja.Status),
-			Position:    ja.Position,
-		},
+		JobApplication: jobApplicationToAPI(ja),
 	}, nil
 }
 
@@ -206,34 +368,109 @@ func (s *service) UpdateJobApplicationStatus(ctx context.Context, req *api.Updat
 	if ja.UserID != userID {
 		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to update this job application")
 	}
-	ja.Status = kiseki.JobApplicationStatus(req.Status)
 
+	if err := ja.CheckVersion(req.Version); err != nil {
+		return nil, versionConflictError(ja)
+	}
+
+	settings, err := s.userSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *ja
+	if err := ja.ChangeStatus(kiseki.JobApplicationStatus(req.Status), settings.TransitionPolicy()); err != nil {
+		return nil, domainError(err)
+	}
+
+	p := placement{AfterID: req.AfterId, BeforeID: req.BeforeId}
 	if req.Position != nil {
-		ja.Position = req.Position.Value
+		p.Position = req.Position.Value
 	}
 
-	if err := s.jobApplicationRepository.Save(ctx, ja); err != nil {
+	save := func() error {
+		return s.saveJobApplication(ctx, userID, &before, ja)
+	}
+	// Keep the current position unless the card moved or a new place was asked for.
+	if ja.Status != before.Status || p != (placement{}) {
+		err = s.placeAndSave(ctx, ja, p, save)
+	} else {
+		err = save()
+	}
+	if err != nil {
 		return nil, err
 	}
 
 	return &api.UpdateJobApplicationStatusResponse{
-		JobApplication: &api.JobApplication{
-			Id:          ja.ID,
-			Company:     ja.Company,
-			Title:       ja.Title,
-			Description: stringPtr(ja.Description),
-			Notes:       stringPtr(ja.Notes),
-			Cv:          stringPtr(ja.CV),
-			CoverLetter: stringPtr(ja.CoverLetter),
-			CreatedAt:   timestamppb.New(ja.CreatedAt),
-			UpdatedAt:   timestamppb.New(ja.UpdatedAt),
-			AppliedOn:   timestamppb.New(ja.AppliedOn),
-			Status:      api.JobApplicationStatus(ja.Status),
-			Position:    ja.Position,
-		},
+		JobApplication: jobApplicationToAPI(ja),
 	}, nil
 }
 
+// unmaskedUpdateFields returns the fields an update without a field mask
+// replaces: all of them, except the optional fields that clients which
+// predate them leave unset. Those keep their values, so saving from such a
+// client does not unlink documents or drop follow-ups; to clear them, send
+// an empty ID or use a field mask.
+func unmaskedUpdateFields(req *api.UpdateJobApplicationRequest) []kiseki.JobApplicationField {
+	unset := map[kiseki.JobApplicationField]bool{
+		kiseki.JobApplicationFieldCVDocumentID:          req.CvDocumentId == nil,
+		kiseki.JobApplicationFieldCoverLetterDocumentID: req.CoverLetterDocumentId == nil,
+		kiseki.JobApplicationFieldFollowUpOn:            req.FollowUpOn == nil,
+		kiseki.JobApplicationFieldCompanyID:             req.CompanyId == nil,
+	}
+	return lo.Reject(kiseki.UpdatableFields, func(field kiseki.JobApplicationField, _ int) bool {
+		return unset[field]
+	})
+}
+
+// saveJobApplication saves a job application along with the history event
+// recording its changes from before, which is nil for a new one. If someone
+// else saved it first the error carries their copy.
+func (s *service) saveJobApplication(ctx context.Context, userID string, before, ja *kiseki.JobApplication) error {
+	err := s.jobApplicationRepository.Save(ctx, ja, historyEvent(userID, before, ja))
+	if !errors.Is(err, kiseki.ErrVersionConflict) {
+		return err
+	}
+
+	current, err := s.jobApplicationRepository.Find(ctx, ja.ID)
+	if err != nil {
+		return err
+	}
+
+	if current == nil {
+		return status.Errorf(codes.NotFound, "job application not found")
+	}
+
+	return versionConflictError(current)
+}
+
+// jobApplicationToAPI converts a domain job application to its API representation.
+func jobApplicationToAPI(ja *kiseki.JobApplication) *api.JobApplication {
+	return &api.JobApplication{
+		Id:                    ja.ID,
+		Company:               ja.Company,
+		Title:                 ja.Title,
+		Description:           stringPtr(ja.Description),
+		Notes:                 stringPtr(ja.Notes),
+		Cv:                    stringPtr(ja.CV),
+		CoverLetter:           stringPtr(ja.CoverLetter),
+		CreatedAt:             timestamppb.New(ja.CreatedAt),
+		UpdatedAt:             timestamppb.New(ja.UpdatedAt),
+		AppliedOn:             timestamppb.New(ja.AppliedOn),
+		Status:                api.JobApplicationStatus(ja.Status),
+		StatusChangedAt:       timestamppb.New(ja.StatusChangedAt),
+		Position:              ja.Position,
+		Version:               ja.Version,
+		DeletedAt:             timestampPtr(ja.DeletedAt),
+		CvDocumentId:          stringPtr(ja.CVDocumentID),
+		CoverLetterDocumentId: stringPtr(ja.CoverLetterDocumentID),
+		MatchScore:            int32Ptr(ja.MatchScore),
+		ExternalId:            stringPtr(ja.ExternalID),
+		FollowUpOn:            timestampPtr(ja.FollowUpOn),
+		CompanyId:             stringPtr(ja.CompanyID),
+	}
+}
+
 // stringPtrFromValue converts a *wrapperspb.StringValue to a *string.
 // Returns nil if the input is nil.
 func stringPtrFromValue(v *wrapperspb.StringValue) *string {
@@ -243,6 +480,34 @@ func stringPtrFromValue(v *wrapperspb.StringValue) *string {
 	return &v.Value
 }
 
+// timePtrFromTimestamp converts a *timestamppb.Timestamp to a *time.Time.
+// Returns nil if the input is nil.
+func timePtrFromTimestamp(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := t.AsTime()
+	return &v
+}
+
+// timestampPtr converts a *time.Time to a *timestamppb.Timestamp.
+// Returns nil if the input is nil.
+func timestampPtr(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// boolPtrFromValue converts a *wrapperspb.BoolValue to a *bool.
+// Returns nil if the input is nil.
+func boolPtrFromValue(v *wrapperspb.BoolValue) *bool {
+	if v == nil {
+		return nil
+	}
+	return &v.Value
+}
+
 // stringPtr converts a *string to a *wrapperspb.StringValue.
 // Returns nil if the input is nil.
 func stringPtr(s *string) *wrapperspb.StringValue {
@@ -251,3 +516,12 @@ func stringPtr(s *string) *wrapperspb.StringValue {
 	}
 	return wrapperspb.String(*s)
 }
+
+// int32Ptr converts a *int32 to a *wrapperspb.Int32Value.
+// Returns nil if the input is nil.
+func int32Ptr(i *int32) *wrapperspb.Int32Value {
+	if i == nil {
+		return nil
+	}
+	return wrapperspb.Int32(*i)
+}
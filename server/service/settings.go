@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetUserSettings implements Service.
+func (s *service) GetUserSettings(ctx context.Context, req *api.GetUserSettingsRequest) (*api.GetUserSettingsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.userSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.GetUserSettingsResponse{
+		Settings: userSettingsToAPI(settings),
+	}, nil
+}
+
+// UpdateUserSettings implements Service.
+func (s *service) UpdateUserSettings(ctx context.Context, req *api.UpdateUserSettingsRequest) (*api.UpdateUserSettingsResponse, error) {
+	// Without a field mask, settings added after strict status transitions
+	// are only replaced when set; to clear them, use a field mask.
+	fields := unmaskedUserSettingsFields(req)
+	if paths := req.UpdateMask.GetPaths(); len(paths) > 0 {
+		var err error
+		fields, err = kiseki.ParseUserSettingsFields(paths)
+		if err != nil {
+			return nil, domainError(err)
+		}
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.userSettings(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(fields, kiseki.UserSettingsFieldGhostingRules) {
+		if err := checkGhostingRules(req.Settings.GetGhostingRules()); err != nil {
+			return nil, err
+		}
+	}
+
+	err = settings.Update(kiseki.UpdateUserSettingsParams{
+		StrictStatusTransitions: req.Settings.GetStrictStatusTransitions(),
+		GhostingRules: lo.Map(req.Settings.GetGhostingRules(), func(r *api.GhostingRule, _ int) kiseki.GhostingRule {
+			return kiseki.GhostingRule{Status: kiseki.JobApplicationStatus(r.Status), AfterDays: int(r.AfterDays)}
+		}),
+		AutoRejectAfterDays: int(req.Settings.GetAutoRejectAfterDays()),
+		Email:               getUserEmail(ctx),
+		EmailDigest:         req.Settings.GetEmailDigest(),
+		EmailReminders:      req.Settings.GetEmailReminders(),
+		Fields:              fields,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if settings.Notifies() && settings.Email == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "emails cannot be turned on without an email address to send them to")
+	}
+
+	if err := s.userSettingsRepository.Save(ctx, &settings); err != nil {
+		return nil, err
+	}
+
+	return &api.UpdateUserSettingsResponse{
+		Settings: userSettingsToAPI(settings),
+	}, nil
+}
+
+// unmaskedUserSettingsFields returns the settings an update without a field
+// mask replaces. Settings left at their zero value are kept as they are,
+// except strict status transitions, so a client that only sends the settings
+// it knows about does not wipe the others.
+func unmaskedUserSettingsFields(req *api.UpdateUserSettingsRequest) []kiseki.UserSettingsField {
+	settings := req.Settings
+	fields := []kiseki.UserSettingsField{kiseki.UserSettingsFieldStrictStatusTransitions}
+	if len(settings.GetGhostingRules()) > 0 {
+		fields = append(fields, kiseki.UserSettingsFieldGhostingRules)
+	}
+	if settings.GetAutoRejectAfterDays() != 0 {
+		fields = append(fields, kiseki.UserSettingsFieldAutoRejectAfterDays)
+	}
+	if settings.GetEmailDigest() {
+		fields = append(fields, kiseki.UserSettingsFieldEmailDigest)
+	}
+	if settings.GetEmailReminders() {
+		fields = append(fields, kiseki.UserSettingsFieldEmailReminders)
+	}
+	return fields
+}
+
+// userSettings returns the user's saved settings, or the defaults if they
+// have not saved any.
+func (s *service) userSettings(ctx context.Context, userID string) (kiseki.UserSettings, error) {
+	settings, err := s.userSettingsRepository.Find(ctx, userID)
+	if err != nil {
+		return kiseki.UserSettings{}, err
+	}
+
+	if settings == nil {
+		return kiseki.DefaultUserSettings(userID), nil
+	}
+
+	return *settings, nil
+}
+
+// checkGhostingRules rejects rules that could never apply: those for a status
+// that is not defined or that ends an application, and a second rule for the
+// same status.
+func checkGhostingRules(rules []*api.GhostingRule) error {
+	seen := map[api.JobApplicationStatus]bool{}
+	for i, rule := range rules {
+		st := kiseki.JobApplicationStatus(rule.Status)
+		if st < kiseki.JobApplicationStatusApplied || st > kiseki.JobApplicationStatusAccepted || slices.Contains(kiseki.TerminalStatuses, st) {
+			return status.Errorf(codes.InvalidArgument, "settings.ghosting_rules[%d].status must be an open status", i)
+		}
+		if seen[rule.Status] {
+			return status.Errorf(codes.InvalidArgument, "settings.ghosting_rules[%d].status has more than one rule", i)
+		}
+		seen[rule.Status] = true
+		if rule.AfterDays < 0 {
+			return status.Errorf(codes.InvalidArgument, "settings.ghosting_rules[%d].after_days must not be negative", i)
+		}
+	}
+	return nil
+}
+
+func userSettingsToAPI(settings kiseki.UserSettings) *api.UserSettings {
+	return &api.UserSettings{
+		StrictStatusTransitions: settings.StrictStatusTransitions,
+		GhostingRules: lo.Map(settings.GhostingRules, func(r kiseki.GhostingRule, _ int) *api.GhostingRule {
+			return &api.GhostingRule{Status: api.JobApplicationStatus(r.Status), AfterDays: int32(r.AfterDays)}
+		}),
+		AutoRejectAfterDays: int32(settings.AutoRejectAfterDays),
+		EmailDigest:         settings.EmailDigest,
+		EmailReminders:      settings.EmailReminders,
+		Email:               settings.Email,
+	}
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestUpdateUserSettingsKeepsUnsentSettings(t *testing.T) {
+	saved := kiseki.DefaultUserSettings("user-1")
+	saved.Email = "jo@example.com"
+	saved.GhostingRules = []kiseki.GhostingRule{{Status: kiseki.JobApplicationStatusApplied, AfterDays: 30}}
+	saved.AutoRejectAfterDays = 14
+	saved.EmailDigest = true
+	saved.EmailReminders = true
+	saved.UnsubscribeToken = "token"
+	settings := &memoryUserSettings{settings: map[string]*kiseki.UserSettings{"user-1": &saved}}
+	svc := &service{userSettingsRepository: settings}
+
+	ctx := ContextWithClaims(context.Background(), &SupabaseClaims{
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1"},
+		Email:            "jo@example.com",
+	})
+
+	// A client that only knows about strict status transitions.
+	_, err := svc.UpdateUserSettings(ctx, &api.UpdateUserSettingsRequest{
+		Settings: &api.UserSettings{StrictStatusTransitions: true},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := settings.settings["user-1"]
+	if !got.StrictStatusTransitions {
+		t.Error("strict status transitions not updated")
+	}
+	if len(got.GhostingRules) != 1 || got.AutoRejectAfterDays != 14 || !got.EmailDigest || !got.EmailReminders {
+		t.Errorf("settings = %+v, want the unsent settings kept", got)
+	}
+
+	// Clearing a setting takes a field mask, and the ghosting rules sent
+	// along with it are not checked when the mask leaves them out.
+	_, err = svc.UpdateUserSettings(ctx, &api.UpdateUserSettingsRequest{
+		Settings: &api.UserSettings{
+			GhostingRules: []*api.GhostingRule{{Status: api.JobApplicationStatus_JOB_APPLICATION_STATUS_REJECTED, AfterDays: 1}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"email_digest", "auto_reject_after_days"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = settings.settings["user-1"]
+	if got.EmailDigest || got.AutoRejectAfterDays != 0 {
+		t.Errorf("settings = %+v, want the digest and auto-reject cleared", got)
+	}
+	if len(got.GhostingRules) != 1 || !got.EmailReminders {
+		t.Errorf("settings = %+v, want the settings outside the mask kept", got)
+	}
+
+	_, err = svc.UpdateUserSettings(ctx, &api.UpdateUserSettingsRequest{
+		Settings: &api.UserSettings{
+			GhostingRules: []*api.GhostingRule{{Status: api.JobApplicationStatus_JOB_APPLICATION_STATUS_REJECTED, AfterDays: 1}},
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"ghosting_rules"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("updating invalid ghosting rules = %v, want InvalidArgument", err)
+	}
+}
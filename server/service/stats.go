@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GetPipelineStats implements Service.
+func (s *service) GetPipelineStats(ctx context.Context, req *api.GetPipelineStatsRequest) (*api.GetPipelineStatsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	params := kiseki.PipelineStatsParams{UserID: userID}
+	if req.AppliedOnFrom != nil {
+		t := req.AppliedOnFrom.AsTime()
+		params.AppliedOnFrom = &t
+	}
+	if req.AppliedOnTo != nil {
+		t := req.AppliedOnTo.AsTime()
+		params.AppliedOnTo = &t
+	}
+	if params.AppliedOnFrom != nil && params.AppliedOnTo != nil && params.AppliedOnTo.Before(*params.AppliedOnFrom) {
+		return nil, status.Errorf(codes.InvalidArgument, "applied_on_to must not be before applied_on_from")
+	}
+
+	stats, err := s.jobApplicationRepository.PipelineStats(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every status is listed, so clients can chart them without filling in
+	// the ones with no job applications.
+	var statusCounts []*api.StatusCount
+	for st := kiseki.JobApplicationStatusApplied; st <= kiseki.JobApplicationStatusAccepted; st++ {
+		statusCounts = append(statusCounts, &api.StatusCount{
+			Status: api.JobApplicationStatus(st),
+			Count:  int32(stats.StatusCounts[st]),
+		})
+	}
+
+	return &api.GetPipelineStatsResponse{
+		StatusCounts: statusCounts,
+		Conversions: lo.Map(stats.Conversions(), func(c kiseki.StageConversion, _ int) *api.StageConversion {
+			return &api.StageConversion{
+				FromStatus: api.JobApplicationStatus(c.From),
+				ToStatus:   api.JobApplicationStatus(c.To),
+				FromCount:  int32(c.FromCount),
+				ToCount:    int32(c.ToCount),
+				Rate:       c.Rate(),
+			}
+		}),
+		TimeInStage: lo.Map(stats.TimeInStage, func(d kiseki.StageDuration, _ int) *api.StageDuration {
+			return &api.StageDuration{
+				Status:     api.JobApplicationStatus(d.Status),
+				MedianDays: d.MedianDays,
+				Count:      int32(d.Count),
+			}
+		}),
+		WeeklyResponseRates: lo.Map(stats.Weeks, func(w kiseki.WeeklyResponses, _ int) *api.WeeklyResponseRate {
+			return &api.WeeklyResponseRate{
+				WeekStart:      timestamppb.New(w.WeekStart),
+				AppliedCount:   int32(w.Applied),
+				RespondedCount: int32(w.Responded),
+				Rate:           w.Rate(),
+			}
+		}),
+	}, nil
+}
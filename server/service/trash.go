@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/samber/lo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListDeletedJobApplications implements Service.
+func (s *service) ListDeletedJobApplications(ctx context.Context, req *api.ListDeletedJobApplicationsRequest) (*api.ListDeletedJobApplicationsResponse, error) {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := pageSize(req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	params := kiseki.ListDeletedJobApplicationsParams{
+		UserID: userID,
+	}
+
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		params.After = &token.After
+	}
+
+	// Fetch one extra row to find out whether there is another page.
+	if limit > 0 {
+		params.Limit = limit + 1
+	}
+
+	jas, err := s.jobApplicationRepository.ListDeleted(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextPageToken string
+	if limit > 0 && len(jas) > limit {
+		jas = jas[:limit]
+		nextPageToken, err = encodePageToken(pageToken{
+			After: kiseki.CursorFor(jas[len(jas)-1]),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &api.ListDeletedJobApplicationsResponse{
+		JobApplications: lo.Map(jas, func(ja *kiseki.JobApplication, _ int) *api.JobApplication {
+			return jobApplicationToAPI(ja)
+		}),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// RestoreJobApplication implements Service.
+func (s *service) RestoreJobApplication(ctx context.Context, req *api.RestoreJobApplicationRequest) (*api.RestoreJobApplicationResponse, error) {
+	ja, err := s.jobApplicationRepository.FindDeleted(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja == nil {
+		return nil, status.Errorf(codes.NotFound, "deleted job application not found")
+	}
+
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if ja.UserID != userID {
+		return nil, status.Errorf(codes.PermissionDenied, "you are not allowed to restore this job application")
+	}
+
+	if err := ja.CheckVersion(req.Version); err != nil {
+		return nil, versionConflictError(ja)
+	}
+
+	before := *ja
+	ja.Restore()
+
+	// Another card may have taken its place on the board in the meantime.
+	err = s.placeAndSave(ctx, ja, placement{Position: ja.Position}, func() error {
+		return s.saveJobApplication(ctx, userID, &before, ja)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.RestoreJobApplicationResponse{
+		JobApplication: jobApplicationToAPI(ja),
+	}, nil
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// watchHeartbeatInterval is how often an idle watch stream sends a
+	// heartbeat, which keeps proxies from closing it and lets clients notice
+	// a dead connection.
+	watchHeartbeatInterval = 30 * time.Second
+
+	// watchBatchSize is how many events are read at a time while a watcher
+	// catches up.
+	watchBatchSize = 100
+
+	// minWatchRetry and maxWatchRetry bound the wait before a watcher reads
+	// again when events have committed but are held back behind an older
+	// transaction that is still running, possibly another user's.
+	minWatchRetry = 50 * time.Millisecond
+	maxWatchRetry = time.Second
+)
+
+// watchCursor is the decoded form of a watch cursor: the position of the last
+// event the client has seen. Cursors issued before events recorded their
+// transaction have no TxID.
+type watchCursor struct {
+	TxID int64 `json:"x,omitempty"`
+	Seq  int64 `json:"s"`
+}
+
+// WatchJobApplications implements Service. It streams the caller's job
+// application events as they are recorded, starting after req.Cursor, or
+// from now when no cursor is given.
+func (s *service) WatchJobApplications(ctx context.Context, req *api.WatchJobApplicationsRequest, send func(*api.WatchJobApplicationsResponse) error) error {
+	userID, err := getUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Subscribe before reading where to start, so no event recorded in
+	// between is missed.
+	notify, unsubscribe := s.eventSubscriber.Subscribe(userID)
+	defer unsubscribe()
+
+	var pos kiseki.EventPosition
+	if req.Cursor != "" {
+		cursor, err := decodeWatchCursor(req.Cursor)
+		if err != nil {
+			return err
+		}
+		pos = kiseki.EventPosition{TxID: cursor.TxID, Seq: cursor.Seq}
+	} else {
+		pos, err = s.jobApplicationEventRepository.LatestPosition(ctx, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// While committed events are held back, read again on a short backoff
+	// rather than waiting for the next notification or heartbeat.
+	var retry <-chan time.Time
+	retryAfter := minWatchRetry
+	catchUp := func() error {
+		pos, err = s.sendJobApplicationEvents(ctx, userID, pos, send)
+		if err != nil {
+			return err
+		}
+		unsettled, err := s.jobApplicationEventRepository.HasUnsettled(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if !unsettled {
+			retry, retryAfter = nil, minWatchRetry
+			return nil
+		}
+		retry = time.After(retryAfter)
+		retryAfter = min(2*retryAfter, maxWatchRetry)
+		return nil
+	}
+
+	// Catch up on what the client missed, then tell it where the stream
+	// stands so it can resume even if nothing changes.
+	if err := catchUp(); err != nil {
+		return err
+	}
+	if err := sendHeartbeat(pos, send); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+			if err := catchUp(); err != nil {
+				return err
+			}
+		case <-retry:
+			if err := catchUp(); err != nil {
+				return err
+			}
+		case <-heartbeat.C:
+			// Also catch up here, in case a notification was lost.
+			if err := catchUp(); err != nil {
+				return err
+			}
+			if err := sendHeartbeat(pos, send); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendJobApplicationEvents sends the user's events after pos, each with the
+// current state of its job application, and returns the position of the last
+// event sent.
+func (s *service) sendJobApplicationEvents(ctx context.Context, userID string, pos kiseki.EventPosition, send func(*api.WatchJobApplicationsResponse) error) (kiseki.EventPosition, error) {
+	for {
+		events, err := s.jobApplicationEventRepository.ListByUser(ctx, kiseki.ListJobApplicationEventsParams{
+			UserID: userID,
+			After:  pos,
+			Limit:  watchBatchSize,
+		})
+		if err != nil {
+			return pos, err
+		}
+
+		for _, e := range events {
+			ja, err := s.findAnyJobApplication(ctx, e.JobApplicationID)
+			if err != nil {
+				return pos, err
+			}
+
+			cursor, err := encodeWatchCursor(watchCursor{TxID: e.TxID, Seq: e.Seq})
+			if err != nil {
+				return pos, err
+			}
+
+			res := &api.WatchJobApplicationsResponse{
+				Event:  jobApplicationEventToAPI(e),
+				Cursor: cursor,
+			}
+			if ja != nil {
+				res.JobApplication = jobApplicationToAPI(ja)
+			}
+			if err := send(res); err != nil {
+				return pos, err
+			}
+			pos = e.Position()
+		}
+
+		if len(events) < watchBatchSize {
+			return pos, nil
+		}
+	}
+}
+
+// findAnyJobApplication finds a job application whether or not it is in the
+// trash. It returns nil once the job application has been purged.
+func (s *service) findAnyJobApplication(ctx context.Context, id string) (*kiseki.JobApplication, error) {
+	ja, err := s.jobApplicationRepository.Find(ctx, id)
+	if err != nil || ja != nil {
+		return ja, err
+	}
+	return s.jobApplicationRepository.FindDeleted(ctx, id)
+}
+
+// sendHeartbeat sends a heartbeat carrying the cursor for pos.
+func sendHeartbeat(pos kiseki.EventPosition, send func(*api.WatchJobApplicationsResponse) error) error {
+	cursor, err := encodeWatchCursor(watchCursor{TxID: pos.TxID, Seq: pos.Seq})
+	if err != nil {
+		return err
+	}
+	return send(&api.WatchJobApplicationsResponse{
+		Cursor:    cursor,
+		Heartbeat: true,
+	})
+}
+
+func encodeWatchCursor(cursor watchCursor) (string, error) {
+	b, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeWatchCursor(s string) (watchCursor, error) {
+	var cursor watchCursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return cursor, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+	if err := json.Unmarshal(b, &cursor); err != nil || cursor.TxID < 0 || cursor.Seq < 0 {
+		return cursor, status.Errorf(codes.InvalidArgument, "invalid cursor")
+	}
+	return cursor, nil
+}
@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"kiseki"
+
+	"kiseki/api/v1"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// settlingEvents holds events the way Postgres orders them for watchers:
+// events from transactions at or after xmin, the oldest transaction still
+// running, are committed but held back.
+type settlingEvents struct {
+	kiseki.JobApplicationEventRepository
+
+	mu     sync.Mutex
+	events []*kiseki.JobApplicationEvent
+	xmin   int64
+}
+
+func (r *settlingEvents) add(event *kiseki.JobApplicationEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *settlingEvents) settle(xmin int64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.xmin = xmin
+}
+
+func (r *settlingEvents) ListByUser(ctx context.Context, params kiseki.ListJobApplicationEventsParams) ([]*kiseki.JobApplicationEvent, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var events []*kiseki.JobApplicationEvent
+	for _, e := range r.events {
+		after := e.TxID > params.After.TxID || (e.TxID == params.After.TxID && e.Seq > params.After.Seq)
+		if e.UserID == params.UserID && e.TxID < r.xmin && after {
+			events = append(events, e)
+		}
+	}
+	slices.SortFunc(events, func(a, b *kiseki.JobApplicationEvent) int {
+		if a.TxID != b.TxID {
+			return int(a.TxID - b.TxID)
+		}
+		return int(a.Seq - b.Seq)
+	})
+	if params.Limit > 0 && len(events) > params.Limit {
+		events = events[:params.Limit]
+	}
+	return events, nil
+}
+
+func (r *settlingEvents) LatestPosition(ctx context.Context, userID string) (kiseki.EventPosition, error) {
+	events, err := r.ListByUser(ctx, kiseki.ListJobApplicationEventsParams{UserID: userID})
+	if err != nil || len(events) == 0 {
+		return kiseki.EventPosition{}, err
+	}
+	return events[len(events)-1].Position(), nil
+}
+
+func (r *settlingEvents) HasUnsettled(ctx context.Context, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.ContainsFunc(r.events, func(e *kiseki.JobApplicationEvent) bool {
+		return e.UserID == userID && e.TxID >= r.xmin
+	}), nil
+}
+
+// signalSubscriber hands every watcher the same channel.
+type signalSubscriber chan struct{}
+
+func (s signalSubscriber) Subscribe(userID string) (<-chan struct{}, func()) {
+	return s, func() {}
+}
+
+type watchedJobApplications struct {
+	kiseki.JobApplicationRepository
+}
+
+func (r watchedJobApplications) Find(ctx context.Context, id string) (*kiseki.JobApplication, error) {
+	return &kiseki.JobApplication{ID: id, UserID: "user-1"}, nil
+}
+
+func TestWatchDeliversEventHeldBackByAnOlderTransaction(t *testing.T) {
+	events := &settlingEvents{xmin: 10}
+	notify := make(signalSubscriber, 1)
+	svc := &service{
+		jobApplicationRepository:      watchedJobApplications{},
+		jobApplicationEventRepository: events,
+		eventSubscriber:               notify,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ctx = ContextWithClaims(ctx, &SupabaseClaims{RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1"}})
+
+	responses := make(chan *api.WatchJobApplicationsResponse, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- svc.WatchJobApplications(ctx, &api.WatchJobApplicationsRequest{}, func(res *api.WatchJobApplicationsResponse) error {
+			responses <- res
+			return nil
+		})
+	}()
+
+	select {
+	case res := <-responses:
+		if !res.Heartbeat {
+			t.Fatalf("first response = %v, want a heartbeat", res)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no heartbeat when the stream opened")
+	}
+
+	// Transaction 12 commits an event while transaction 11, which belongs to
+	// someone else and records nothing for this user, is still open.
+	events.add(&kiseki.JobApplicationEvent{
+		ID:               "event-1",
+		JobApplicationID: "ja-1",
+		UserID:           "user-1",
+		Type:             kiseki.JobApplicationEventTypeUpdated,
+		TxID:             12,
+		Seq:              1,
+	})
+	events.settle(11)
+	notify <- struct{}{}
+
+	select {
+	case res := <-responses:
+		t.Fatalf("got %v while the event was held back", res)
+	case <-time.After(300 * time.Millisecond):
+	}
+
+	// Transaction 11 ends without another notification.
+	events.settle(13)
+	settledAt := time.Now()
+
+	select {
+	case res := <-responses:
+		if res.Event.GetId() != "event-1" {
+			t.Fatalf("response = %v, want event-1", res)
+		}
+		if waited := time.Since(settledAt); waited > maxWatchRetry+200*time.Millisecond {
+			t.Errorf("event delivered %v after it settled, want within %v", waited, maxWatchRetry)
+		}
+	case <-time.After(maxWatchRetry + time.Second):
+		t.Fatal("event not delivered after the older transaction ended")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("WatchJobApplications() = %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package kiseki
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"slices"
+	"time"
+)
+
+// UserSettings holds per-user preferences.
+type UserSettings struct {
+	UserID                  string
+	StrictStatusTransitions bool
+	// GhostingRules decide when job applications are flagged for a
+	// follow-up because the company went quiet.
+	GhostingRules []GhostingRule
+	// AutoRejectAfterDays, if positive, is how many days a job application
+	// may stay ghosted before it is moved to rejected.
+	AutoRejectAfterDays int
+	// Email is the address notifications are sent to, taken from the
+	// user's sign-in when they last saved their settings.
+	Email string
+	// EmailDigest and EmailReminders opt in to the weekly digest and to
+	// an email for each follow-up that falls due.
+	EmailDigest    bool
+	EmailReminders bool
+	// UnsubscribeToken lets the user turn emails off from a link in them
+	// without signing in. It is set once they first opt in.
+	UnsubscribeToken string
+	// DigestSentAt is when the last digest was sent, if ever.
+	DigestSentAt *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// DefaultUserSettings returns the settings used for a user who has not saved
+// any.
+func DefaultUserSettings(userID string) UserSettings {
+	now := time.Now()
+	return UserSettings{
+		UserID:        userID,
+		GhostingRules: DefaultGhostingRules(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+// UserSettingsField names a user setting that can be updated on its own.
+type UserSettingsField string
+
+const (
+	UserSettingsFieldStrictStatusTransitions UserSettingsField = "strict_status_transitions"
+	UserSettingsFieldGhostingRules           UserSettingsField = "ghosting_rules"
+	UserSettingsFieldAutoRejectAfterDays     UserSettingsField = "auto_reject_after_days"
+	UserSettingsFieldEmailDigest             UserSettingsField = "email_digest"
+	UserSettingsFieldEmailReminders          UserSettingsField = "email_reminders"
+)
+
+// UpdatableUserSettingsFields are the fields UpdateUserSettingsParams.Fields
+// may list.
+var UpdatableUserSettingsFields = []UserSettingsField{
+	UserSettingsFieldStrictStatusTransitions,
+	UserSettingsFieldGhostingRules,
+	UserSettingsFieldAutoRejectAfterDays,
+	UserSettingsFieldEmailDigest,
+	UserSettingsFieldEmailReminders,
+}
+
+// ParseUserSettingsFields converts field paths, such as those of a field
+// mask, to the settings they name. It returns an *UnknownFieldError for the
+// first path that is not an updatable setting.
+func ParseUserSettingsFields(paths []string) ([]UserSettingsField, error) {
+	fields := make([]UserSettingsField, 0, len(paths))
+	for _, path := range paths {
+		field := UserSettingsField(path)
+		if !slices.Contains(UpdatableUserSettingsFields, field) {
+			return nil, &UnknownFieldError{Path: path}
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+type UpdateUserSettingsParams struct {
+	StrictStatusTransitions bool
+	GhostingRules           []GhostingRule
+	AutoRejectAfterDays     int
+	// Email, if set, replaces the address notifications are sent to.
+	Email          string
+	EmailDigest    bool
+	EmailReminders bool
+	// Fields limits the update to the listed settings, leaving the others
+	// as they are. Nil replaces every setting. Email is always updated.
+	Fields []UserSettingsField
+}
+
+// Update changes the user's settings, or only params.Fields when set.
+func (s *UserSettings) Update(params UpdateUserSettingsParams) error {
+	updates := func(field UserSettingsField) bool {
+		return params.Fields == nil || slices.Contains(params.Fields, field)
+	}
+
+	s.UpdatedAt = time.Now()
+	if updates(UserSettingsFieldStrictStatusTransitions) {
+		s.StrictStatusTransitions = params.StrictStatusTransitions
+	}
+	if updates(UserSettingsFieldGhostingRules) {
+		s.GhostingRules = params.GhostingRules
+	}
+	if updates(UserSettingsFieldAutoRejectAfterDays) {
+		s.AutoRejectAfterDays = params.AutoRejectAfterDays
+	}
+	if params.Email != "" {
+		s.Email = params.Email
+	}
+	if updates(UserSettingsFieldEmailDigest) {
+		s.EmailDigest = params.EmailDigest
+	}
+	if updates(UserSettingsFieldEmailReminders) {
+		s.EmailReminders = params.EmailReminders
+	}
+
+	if s.Notifies() && s.UnsubscribeToken == "" {
+		token, err := newUnsubscribeToken()
+		if err != nil {
+			return err
+		}
+		s.UnsubscribeToken = token
+	}
+
+	return nil
+}
+
+// Notifies reports whether the user has opted in to any email.
+func (s UserSettings) Notifies() bool {
+	return s.EmailDigest || s.EmailReminders
+}
+
+// Unsubscribe opts the user out of every email.
+func (s *UserSettings) Unsubscribe() {
+	s.UpdatedAt = time.Now()
+	s.EmailDigest = false
+	s.EmailReminders = false
+}
+
+// DigestDue reports whether a digest is to be sent to the user at now.
+func (s UserSettings) DigestDue(now time.Time) bool {
+	if !s.EmailDigest || s.Email == "" {
+		return false
+	}
+	return s.DigestSentAt == nil || now.Sub(*s.DigestSentAt) >= DigestPeriod
+}
+
+// newUnsubscribeToken returns a random token that cannot be guessed.
+func newUnsubscribeToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// TransitionPolicy returns the status transition policy the user has chosen.
+func (s UserSettings) TransitionPolicy() TransitionPolicy {
+	if s.StrictStatusTransitions {
+		return StrictTransitionPolicy()
+	}
+	return PermissiveTransitionPolicy()
+}
+
+// AutoRejects reports whether ja has stayed ghosted past the grace period at
+// now, and so is to be moved to rejected.
+func (s UserSettings) AutoRejects(ja *JobApplication, now time.Time) bool {
+	if s.AutoRejectAfterDays <= 0 {
+		return false
+	}
+	// A follow-up date still to come means the user is waiting on purpose.
+	if ja.FollowUpOn != nil && ja.FollowUpOn.After(now) {
+		return false
+	}
+	since, ok := ja.GhostedSince(s.GhostingRules)
+	return ok && !since.AddDate(0, 0, s.AutoRejectAfterDays).After(now)
+}
@@ -0,0 +1,108 @@
+// Package skills recognises skills in job descriptions and CVs and scores
+// how well a CV covers the skills a job asks for.
+package skills
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+//go:embed dictionary.txt
+var defaultDictionary string
+
+// Dictionary is a list of skills, each known by one or more terms.
+type Dictionary struct {
+	// terms maps the first token of every term to the terms starting with it.
+	terms map[string][]term
+}
+
+// term is a tokenised spelling of a skill.
+type term struct {
+	tokens []string
+	skill  string
+}
+
+// Default returns the dictionary shipped with the server.
+func Default() *Dictionary {
+	d, err := Parse(strings.NewReader(defaultDictionary))
+	if err != nil {
+		panic(fmt.Sprintf("skills: parse default dictionary: %v", err))
+	}
+	return d
+}
+
+// Load reads a dictionary from a file in the format Parse reads.
+func Load(path string) (*Dictionary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Parse reads a dictionary with one skill per line, given as comma-separated
+// terms. The first term names the skill and the others are alternative
+// spellings, e.g. "Kubernetes, k8s". Blank lines and lines starting with #
+// are ignored.
+func Parse(r io.Reader) (*Dictionary, error) {
+	d := &Dictionary{terms: map[string][]term{}}
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		spellings := strings.Split(line, ",")
+		skill := strings.TrimSpace(spellings[0])
+		for _, spelling := range spellings {
+			tokens := tokenize(spelling)
+			if len(tokens) == 0 {
+				return nil, fmt.Errorf("line %d: empty term", n)
+			}
+			d.terms[tokens[0]] = append(d.terms[tokens[0]], term{tokens: tokens, skill: skill})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Find returns the skills mentioned in text, in the order they first appear.
+func (d *Dictionary) Find(text string) []string {
+	tokens := tokenize(text)
+
+	var found []string
+	seen := map[string]bool{}
+	for i, token := range tokens {
+		for _, t := range d.terms[token] {
+			if seen[t.skill] || !hasPrefix(tokens[i:], t.tokens) {
+				continue
+			}
+			seen[t.skill] = true
+			found = append(found, t.skill)
+		}
+	}
+	return found
+}
+
+// hasPrefix reports whether tokens starts with prefix.
+func hasPrefix(tokens, prefix []string) bool {
+	if len(tokens) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if tokens[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
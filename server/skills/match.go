@@ -0,0 +1,123 @@
+package skills
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// skillWeight is the share of the score given to skill coverage when the job
+// description mentions skills from the dictionary. The rest comes from the
+// overlap of other keywords.
+const skillWeight = 0.8
+
+// Match is how well a CV fits a job description.
+type Match struct {
+	// Score rates the fit from 0 to 100.
+	Score int
+	// MatchedSkills are the skills the job asks for that the CV mentions.
+	MatchedSkills []string
+	// MissingSkills are the skills the job asks for that the CV does not
+	// mention.
+	MissingSkills []string
+	// KeywordOverlap is the share of the description's keywords, skills or
+	// not, that the CV also uses.
+	KeywordOverlap float64
+}
+
+// Score works out how well cv covers the skills and keywords of description.
+func (d *Dictionary) Score(description, cv string) Match {
+	var m Match
+
+	cvSkills := map[string]bool{}
+	for _, skill := range d.Find(cv) {
+		cvSkills[skill] = true
+	}
+	for _, skill := range d.Find(description) {
+		if cvSkills[skill] {
+			m.MatchedSkills = append(m.MatchedSkills, skill)
+		} else {
+			m.MissingSkills = append(m.MissingSkills, skill)
+		}
+	}
+
+	keywords := keywords(description)
+	if len(keywords) > 0 {
+		cvTokens := map[string]bool{}
+		for _, token := range tokenize(cv) {
+			cvTokens[token] = true
+		}
+		var overlap int
+		for _, keyword := range keywords {
+			if cvTokens[keyword] {
+				overlap++
+			}
+		}
+		m.KeywordOverlap = float64(overlap) / float64(len(keywords))
+	}
+
+	score := m.KeywordOverlap
+	if required := len(m.MatchedSkills) + len(m.MissingSkills); required > 0 {
+		coverage := float64(len(m.MatchedSkills)) / float64(required)
+		score = skillWeight*coverage + (1-skillWeight)*m.KeywordOverlap
+	}
+	m.Score = int(math.Round(100 * score))
+
+	return m
+}
+
+// keywords returns the distinct words of text that say something about the
+// job, leaving out short words, numbers and common filler.
+func keywords(text string) []string {
+	var words []string
+	seen := map[string]bool{}
+	for _, token := range tokenize(text) {
+		if seen[token] || len(token) < 3 || stopWords[token] || strings.IndexFunc(token, unicode.IsLetter) < 0 {
+			continue
+		}
+		seen[token] = true
+		words = append(words, token)
+	}
+	return words
+}
+
+// tokenize splits text into lowercase words. Characters that are part of
+// technology names, as in "C++", "C#" and "Node.js", are kept inside words.
+func tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#' && r != '.'
+	})
+
+	tokens := make([]string, 0, len(fields))
+	for _, field := range fields {
+		// Dots only join words, as in "node.js"; others end sentences.
+		if field = strings.Trim(field, "."); field != "" {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// stopWords are words too common in job descriptions to count as keywords.
+var stopWords = setOf(
+	"about", "above", "across", "after", "all", "also", "and", "any", "are",
+	"as", "at", "be", "been", "being", "both", "but", "by", "can", "candidate",
+	"company", "could", "day", "each", "etc", "every", "experience",
+	"for", "from", "get", "good", "great", "has", "have", "help", "her", "his",
+	"how", "ideal", "including", "into", "its", "join", "just", "know",
+	"like", "looking", "make", "more", "most", "must", "new", "not", "our",
+	"out", "over", "own", "part", "per", "plus", "position", "role", "should",
+	"such", "team", "teams", "than", "that", "the", "their", "them", "then",
+	"there", "these", "they", "this", "those", "through", "use", "using",
+	"very", "want", "was", "way", "well", "were", "what", "when", "where",
+	"which", "while", "who", "why", "will", "with", "within", "work",
+	"working", "would", "year", "years", "you", "your",
+)
+
+func setOf(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
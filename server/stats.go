@@ -0,0 +1,104 @@
+package kiseki
+
+import "time"
+
+// FunnelStages are the stages a job application passes through on the way to
+// an accepted offer, in order.
+var FunnelStages = []JobApplicationStatus{
+	JobApplicationStatusApplied,
+	JobApplicationStatusScreening,
+	JobApplicationStatusInterview,
+	JobApplicationStatusOffer,
+	JobApplicationStatusAccepted,
+}
+
+// PipelineStatsParams selects the job applications pipeline statistics are
+// computed over: a user's job applications outside the trash, applied on
+// within the range.
+type PipelineStatsParams struct {
+	UserID string
+	// AppliedOnFrom and AppliedOnTo, if set, bound the day applied on. Both
+	// are inclusive.
+	AppliedOnFrom *time.Time
+	AppliedOnTo   *time.Time
+}
+
+// PipelineStats summarises how a user's job applications progressed.
+type PipelineStats struct {
+	// StatusCounts counts the job applications in each status.
+	StatusCounts map[JobApplicationStatus]int
+	// Reached counts, for each of FunnelStages, the job applications that
+	// got at least that far, whatever their status now.
+	Reached map[JobApplicationStatus]int
+	// TimeInStage tells how long job applications stayed in each status
+	// before moving on. Job applications still in a status do not count
+	// towards it.
+	TimeInStage []StageDuration
+	// Weeks tells how many job applications got a response, by the week
+	// they were applied on, oldest first. Weeks without applications are
+	// left out.
+	Weeks []WeeklyResponses
+}
+
+// StageDuration is how long job applications stayed in a status.
+type StageDuration struct {
+	Status     JobApplicationStatus
+	MedianDays float64
+	// Count is the number of stays the median is taken over.
+	Count int
+}
+
+// WeeklyResponses counts the job applications applied on in a week and those
+// of them that got a response: any status past applied other than being
+// withdrawn.
+type WeeklyResponses struct {
+	// WeekStart is the Monday the week starts on.
+	WeekStart time.Time
+	Applied   int
+	Responded int
+}
+
+// Rate returns the share of the week's job applications that got a
+// response, from 0 to 1.
+func (w WeeklyResponses) Rate() float64 {
+	return rate(w.Responded, w.Applied)
+}
+
+// StageConversion is how many job applications that reached a funnel stage
+// went on to reach the next.
+type StageConversion struct {
+	From      JobApplicationStatus
+	To        JobApplicationStatus
+	FromCount int
+	ToCount   int
+}
+
+// Rate returns the share of job applications that made it from From to To,
+// from 0 to 1.
+func (c StageConversion) Rate() float64 {
+	return rate(c.ToCount, c.FromCount)
+}
+
+// Conversions returns the conversion between each pair of consecutive
+// funnel stages.
+func (s *PipelineStats) Conversions() []StageConversion {
+	conversions := make([]StageConversion, 0, len(FunnelStages)-1)
+	for i := 1; i < len(FunnelStages); i++ {
+		from, to := FunnelStages[i-1], FunnelStages[i]
+		conversions = append(conversions, StageConversion{
+			From:      from,
+			To:        to,
+			FromCount: s.Reached[from],
+			ToCount:   s.Reached[to],
+		})
+	}
+	return conversions
+}
+
+// rate returns n out of total, or 0 when total is 0.
+func rate(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
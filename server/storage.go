@@ -0,0 +1,29 @@
+package kiseki
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// FileStore holds the files uploaded by users, such as CVs. Paths are the
+// values stored on job applications.
+type FileStore interface {
+	// Delete removes the files at paths. Paths that do not exist are ignored.
+	Delete(ctx context.Context, paths []string) error
+}
+
+// ErrBlobNotFound is returned by BlobStore.Get for a key with no content.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore holds the content of documents under keys chosen by the server.
+type BlobStore interface {
+	// Put stores size bytes read from content under key, replacing any
+	// content already there.
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	// Get returns the content stored under key, or ErrBlobNotFound.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete removes the content stored under keys. Keys that do not exist
+	// are ignored.
+	Delete(ctx context.Context, keys []string) error
+}
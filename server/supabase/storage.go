@@ -0,0 +1,66 @@
+// Package supabase talks to the Supabase services the server uses besides
+// Postgres.
+package supabase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"kiseki"
+)
+
+// NewFileStore returns a kiseki.FileStore backed by a Supabase Storage bucket.
+// The service role key bypasses the bucket's row level security, which only
+// lets users manage their own files.
+func NewFileStore(url, serviceRoleKey, bucket string) kiseki.FileStore {
+	return &fileStore{
+		url:            strings.TrimSuffix(url, "/"),
+		serviceRoleKey: serviceRoleKey,
+		bucket:         bucket,
+		client:         http.DefaultClient,
+	}
+}
+
+type fileStore struct {
+	url            string
+	serviceRoleKey string
+	bucket         string
+	client         *http.Client
+}
+
+func (s *fileStore) Delete(ctx context.Context, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(map[string][]string{"prefixes": paths})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.url+"/storage/v1/object/"+s.bucket, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+s.serviceRoleKey)
+	req.Header.Set("apikey", s.serviceRoleKey)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+		return fmt.Errorf("delete files from bucket %s: %s: %s", s.bucket, res.Status, bytes.TrimSpace(msg))
+	}
+
+	return nil
+}
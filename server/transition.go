@@ -0,0 +1,105 @@
+package kiseki
+
+import (
+	"fmt"
+	"slices"
+)
+
+// TransitionPolicy decides which status changes a job application may make.
+type TransitionPolicy struct {
+	// Allowed lists the statuses each status may move to. A nil map allows
+	// any move, and a status missing from the map may move anywhere.
+	Allowed map[JobApplicationStatus][]JobApplicationStatus
+	// Terminal statuses end an application. Moving out of one reopens it.
+	Terminal []JobApplicationStatus
+	// AllowReopen permits moves out of a terminal status.
+	AllowReopen bool
+}
+
+// TerminalStatuses are the statuses that end an application.
+var TerminalStatuses = []JobApplicationStatus{
+	JobApplicationStatusAccepted,
+	JobApplicationStatusRejected,
+	JobApplicationStatusWithdrawn,
+}
+
+// PermissiveTransitionPolicy lets applications move freely between statuses,
+// including out of terminal ones.
+func PermissiveTransitionPolicy() TransitionPolicy {
+	return TransitionPolicy{
+		Terminal:    TerminalStatuses,
+		AllowReopen: true,
+	}
+}
+
+// StrictTransitionPolicy only allows applications to move forward through the
+// pipeline and does not allow terminal statuses to be reopened.
+func StrictTransitionPolicy() TransitionPolicy {
+	return TransitionPolicy{
+		Allowed: map[JobApplicationStatus][]JobApplicationStatus{
+			JobApplicationStatusApplied: {
+				JobApplicationStatusScreening,
+				JobApplicationStatusInterview,
+				JobApplicationStatusOffer,
+				JobApplicationStatusRejected,
+				JobApplicationStatusWithdrawn,
+			},
+			JobApplicationStatusScreening: {
+				JobApplicationStatusInterview,
+				JobApplicationStatusOffer,
+				JobApplicationStatusRejected,
+				JobApplicationStatusWithdrawn,
+			},
+			JobApplicationStatusInterview: {
+				JobApplicationStatusOffer,
+				JobApplicationStatusRejected,
+				JobApplicationStatusWithdrawn,
+			},
+			JobApplicationStatusOffer: {
+				JobApplicationStatusAccepted,
+				JobApplicationStatusRejected,
+				JobApplicationStatusWithdrawn,
+			},
+		},
+		Terminal:    TerminalStatuses,
+		AllowReopen: false,
+	}
+}
+
+// TransitionError describes a status change rejected by a TransitionPolicy.
+type TransitionError struct {
+	From   JobApplicationStatus
+	To     JobApplicationStatus
+	Reason string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("cannot move job application from %s to %s: %s", StatusToDB(e.From), StatusToDB(e.To), e.Reason)
+}
+
+// IsTerminal reports whether status ends an application under this policy.
+func (p TransitionPolicy) IsTerminal(status JobApplicationStatus) bool {
+	return slices.Contains(p.Terminal, status)
+}
+
+// Check returns a *TransitionError if moving from one status to another is
+// not allowed. Staying in the same status is always allowed.
+func (p TransitionPolicy) Check(from, to JobApplicationStatus) error {
+	if from == to {
+		return nil
+	}
+
+	if to == JobApplicationStatusUnspecified {
+		return &TransitionError{From: from, To: to, Reason: "a status must be specified"}
+	}
+
+	if p.IsTerminal(from) && !p.AllowReopen {
+		return &TransitionError{From: from, To: to, Reason: "reopening a closed job application is not allowed"}
+	}
+
+	if allowed, ok := p.Allowed[from]; ok && !slices.Contains(allowed, to) {
+		return &TransitionError{From: from, To: to, Reason: "this move is not allowed"}
+	}
+
+	return nil
+}
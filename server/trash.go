@@ -0,0 +1,41 @@
+package kiseki
+
+import (
+	"slices"
+	"strings"
+)
+
+// ListDeletedJobApplicationsParams lists a user's trash, most recently
+// deleted first.
+type ListDeletedJobApplicationsParams struct {
+	UserID string
+	// After, if set, skips job applications up to and including the cursor.
+	After *JobApplicationCursor
+	// Limit caps the number of job applications returned. Zero means no limit.
+	Limit int
+}
+
+// ExpiredJobApplication is a deleted job application that has been in the
+// trash for longer than the retention period and is due to be purged.
+type ExpiredJobApplication struct {
+	ID     string
+	UserID string
+	// CV is the stored CV file to delete along with the job application. It
+	// is nil when there is none, another job application still uses it, or
+	// it lies outside the user's folder.
+	CV *string
+}
+
+// IsUserFile reports whether path names a file in the user's folder of the
+// file store, "<userID>/...". The path is set by the client, and the file
+// store is accessed with a key that bypasses its row level security, so
+// files elsewhere must never be touched on the user's behalf.
+func IsUserFile(userID, path string) bool {
+	segments := strings.Split(path, "/")
+	if len(segments) < 2 || userID == "" || segments[0] != userID {
+		return false
+	}
+	return !slices.ContainsFunc(segments[1:], func(s string) bool {
+		return s == "" || s == "." || s == ".."
+	})
+}
@@ -0,0 +1,274 @@
+package validation
+
+import (
+	// Register the API messages the rules below refer to.
+	_ "kiseki/api/v1"
+)
+
+// Limits on the size of text fields.
+const (
+	maxNameLength  = 200
+	maxTextLength  = 20000
+	maxPathLength  = 1024
+	maxIDLength    = 64
+	maxTokenLength = 1024
+	maxQueryLength = 500
+)
+
+func init() {
+	register("api.v1.CreateJobApplicationRequest", MessageRules{
+		"company":      Field(Required(), MaxLength(maxNameLength)),
+		"title":        Field(Required(), MaxLength(maxNameLength)),
+		"description":  Field(MaxLength(maxTextLength)),
+		"notes":        Field(MaxLength(maxTextLength)),
+		"cv":           Field(MaxLength(maxPathLength)),
+		"cover_letter": Field(MaxLength(maxTextLength)),
+		"applied_on":   Field(Required(), ValidTimestamp()),
+		"status":       Field(Required(), DefinedEnum()),
+		"position":     Field(FractionalKey()),
+
+		"cv_document_id":           Field(MaxLength(maxIDLength)),
+		"cover_letter_document_id": Field(MaxLength(maxIDLength)),
+		"follow_up_on":             Field(ValidTimestamp()),
+		"company_id":               Field(MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.UpdateJobApplicationRequest", MessageRules{
+		"id":           Field(Required(), MaxLength(maxIDLength)),
+		"version":      Field(NonNegative()),
+		"company":      MaskedField(Required(), MaxLength(maxNameLength)),
+		"title":        MaskedField(Required(), MaxLength(maxNameLength)),
+		"description":  MaskedField(MaxLength(maxTextLength)),
+		"notes":        MaskedField(MaxLength(maxTextLength)),
+		"cv":           MaskedField(MaxLength(maxPathLength)),
+		"cover_letter": MaskedField(MaxLength(maxTextLength)),
+		"applied_on":   MaskedField(Required(), ValidTimestamp()),
+		"status":       MaskedField(Required(), DefinedEnum()),
+		"position":     MaskedField(FractionalKey()),
+
+		"cv_document_id":           MaskedField(MaxLength(maxIDLength)),
+		"cover_letter_document_id": MaskedField(MaxLength(maxIDLength)),
+		"follow_up_on":             MaskedField(ValidTimestamp()),
+		"company_id":               MaskedField(MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.UpdateJobApplicationStatusRequest", MessageRules{
+		"id":        Field(Required(), MaxLength(maxIDLength)),
+		"version":   Field(NonNegative()),
+		"status":    Field(Required(), DefinedEnum()),
+		"position":  Field(FractionalKey()),
+		"after_id":  Field(MaxLength(maxIDLength)),
+		"before_id": Field(MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.DeleteJobApplicationRequest", MessageRules{
+		"id":      Field(Required(), MaxLength(maxIDLength)),
+		"version": Field(NonNegative()),
+	})
+
+	register("api.v1.RestoreJobApplicationRequest", MessageRules{
+		"id":      Field(Required(), MaxLength(maxIDLength)),
+		"version": Field(NonNegative()),
+	})
+
+	register("api.v1.GetJobApplicationHistoryRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.ListJobApplicationsRequest", MessageRules{
+		"page_size":              Field(NonNegative()),
+		"page_token":             Field(MaxLength(maxTokenLength)),
+		"sort_key":               Field(DefinedEnum()),
+		"filter.statuses":        Field(Required(), DefinedEnum()),
+		"filter.company":         Field(MaxLength(maxNameLength)),
+		"filter.applied_on_from": Field(ValidTimestamp()),
+		"filter.applied_on_to":   Field(ValidTimestamp()),
+
+		"filter.cv_document_id":           Field(MaxLength(maxIDLength)),
+		"filter.cover_letter_document_id": Field(MaxLength(maxIDLength)),
+		"filter.cv_query":                 Field(MaxLength(maxQueryLength)),
+	})
+
+	register("api.v1.ListDeletedJobApplicationsRequest", MessageRules{
+		"page_size":  Field(NonNegative()),
+		"page_token": Field(MaxLength(maxTokenLength)),
+	})
+
+	register("api.v1.SearchJobApplicationsRequest", MessageRules{
+		"query":      Field(Required(), MaxLength(maxQueryLength)),
+		"page_size":  Field(NonNegative()),
+		"page_token": Field(MaxLength(maxTokenLength)),
+	})
+
+	register("api.v1.UpdateUserSettingsRequest", MessageRules{
+		"settings":                        Field(Required()),
+		"settings.auto_reject_after_days": Field(NonNegative()),
+	})
+
+	register("api.v1.UnsubscribeRequest", MessageRules{
+		"token": Field(Required(), MaxLength(maxTokenLength)),
+	})
+
+	register("api.v1.CreateInterviewRequest", MessageRules{
+		"job_application_id": Field(Required(), MaxLength(maxIDLength)),
+		"round":              Field(Required(), MaxLength(maxNameLength)),
+		"scheduled_at":       Field(Required(), ValidTimestamp()),
+		"time_zone":          Field(TimeZone()),
+		"duration":           Field(ValidDuration()),
+		"interviewers":       Field(Required(), MaxLength(maxNameLength)),
+		"format":             Field(DefinedEnum()),
+		"location":           Field(MaxLength(maxPathLength)),
+	})
+
+	register("api.v1.ListInterviewsRequest", MessageRules{
+		"job_application_id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.UpdateInterviewRequest", MessageRules{
+		"id":           Field(Required(), MaxLength(maxIDLength)),
+		"round":        Field(Required(), MaxLength(maxNameLength)),
+		"scheduled_at": Field(Required(), ValidTimestamp()),
+		"time_zone":    Field(TimeZone()),
+		"duration":     Field(ValidDuration()),
+		"interviewers": Field(Required(), MaxLength(maxNameLength)),
+		"format":       Field(DefinedEnum()),
+		"location":     Field(MaxLength(maxPathLength)),
+		"outcome":      Field(Required(), DefinedEnum()),
+	})
+
+	register("api.v1.DeleteInterviewRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.ListUpcomingInterviewsRequest", MessageRules{
+		"page_size":  Field(NonNegative()),
+		"page_token": Field(MaxLength(maxTokenLength)),
+	})
+
+	register("api.v1.WatchJobApplicationsRequest", MessageRules{
+		"cursor": Field(MaxLength(maxTokenLength)),
+	})
+
+	register("api.v1.UploadDocumentRequest", MessageRules{
+		"kind":         Field(Required(), DefinedEnum()),
+		"name":         Field(Required(), MaxLength(maxNameLength)),
+		"content_type": Field(MaxLength(maxNameLength)),
+		"content":      Field(Required()),
+		"label":        Field(MaxLength(maxNameLength)),
+	})
+
+	register("api.v1.GetDocumentRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.DownloadDocumentRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.DeleteDocumentRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.UpdateDocumentRequest", MessageRules{
+		"id":    Field(Required(), MaxLength(maxIDLength)),
+		"kind":  Field(Required(), DefinedEnum()),
+		"name":  Field(Required(), MaxLength(maxNameLength)),
+		"label": Field(MaxLength(maxNameLength)),
+	})
+
+	register("api.v1.ListDocumentsRequest", MessageRules{
+		"page_size":  Field(NonNegative()),
+		"page_token": Field(MaxLength(maxTokenLength)),
+		"kind":       Field(DefinedEnum()),
+	})
+
+	register("api.v1.ScoreJobApplicationRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.ImportJobApplicationsRequest", MessageRules{
+		"format":  Field(Required(), DefinedEnum()),
+		"content": Field(Required()),
+	})
+
+	register("api.v1.ExportJobApplicationsRequest", MessageRules{
+		"format": Field(Required(), DefinedEnum()),
+	})
+
+	register("api.v1.GetPipelineStatsRequest", MessageRules{
+		"applied_on_from": Field(ValidTimestamp()),
+		"applied_on_to":   Field(ValidTimestamp()),
+	})
+
+	register("api.v1.SearchDocumentsRequest", MessageRules{
+		"query":      Field(Required(), MaxLength(maxQueryLength)),
+		"kind":       Field(DefinedEnum()),
+		"page_size":  Field(NonNegative()),
+		"page_token": Field(MaxLength(maxTokenLength)),
+	})
+
+	register("api.v1.CreateCompanyRequest", MessageRules{
+		"name":     Field(Required(), MaxLength(maxNameLength)),
+		"website":  Field(MaxLength(maxPathLength)),
+		"location": Field(MaxLength(maxNameLength)),
+		"size":     Field(DefinedEnum()),
+		"industry": Field(MaxLength(maxNameLength)),
+		"notes":    Field(MaxLength(maxTextLength)),
+	})
+
+	register("api.v1.UpdateCompanyRequest", MessageRules{
+		"id":       Field(Required(), MaxLength(maxIDLength)),
+		"name":     Field(Required(), MaxLength(maxNameLength)),
+		"website":  Field(MaxLength(maxPathLength)),
+		"location": Field(MaxLength(maxNameLength)),
+		"size":     Field(DefinedEnum()),
+		"industry": Field(MaxLength(maxNameLength)),
+		"notes":    Field(MaxLength(maxTextLength)),
+	})
+
+	register("api.v1.CreateContactRequest", MessageRules{
+		"name":         Field(Required(), MaxLength(maxNameLength)),
+		"role":         Field(MaxLength(maxNameLength)),
+		"email":        Field(MaxLength(maxNameLength)),
+		"phone":        Field(MaxLength(maxNameLength)),
+		"linkedin_url": Field(MaxLength(maxPathLength)),
+		"company_id":   Field(MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.GetContactRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.UpdateContactRequest", MessageRules{
+		"id":           Field(Required(), MaxLength(maxIDLength)),
+		"name":         Field(Required(), MaxLength(maxNameLength)),
+		"role":         Field(MaxLength(maxNameLength)),
+		"email":        Field(MaxLength(maxNameLength)),
+		"phone":        Field(MaxLength(maxNameLength)),
+		"linkedin_url": Field(MaxLength(maxPathLength)),
+		"company_id":   Field(MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.DeleteContactRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.ListContactsRequest", MessageRules{
+		"company_id":         Field(MaxLength(maxIDLength)),
+		"job_application_id": Field(MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.LinkContactRequest", MessageRules{
+		"contact_id":         Field(Required(), MaxLength(maxIDLength)),
+		"job_application_id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.UnlinkContactRequest", MessageRules{
+		"contact_id":         Field(Required(), MaxLength(maxIDLength)),
+		"job_application_id": Field(Required(), MaxLength(maxIDLength)),
+	})
+
+	register("api.v1.GetContactTimelineRequest", MessageRules{
+		"id": Field(Required(), MaxLength(maxIDLength)),
+	})
+}
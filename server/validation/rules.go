@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"kiseki/fractional"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Required rejects empty values: blank strings, unset messages and wrappers,
+// and the zero (UNSPECIFIED) value of enums.
+func Required() Rule {
+	return func(v Value) string {
+		switch v.Field.Kind() {
+		case protoreflect.StringKind:
+			if !v.Set || strings.TrimSpace(v.Value.String()) == "" {
+				return "must not be empty"
+			}
+		case protoreflect.EnumKind:
+			if v.Value.Enum() == 0 {
+				return "must be specified"
+			}
+		default:
+			if !v.Set {
+				return "is required"
+			}
+		}
+		return ""
+	}
+}
+
+// MaxLength rejects strings longer than n characters.
+func MaxLength(n int) Rule {
+	return func(v Value) string {
+		if utf8.RuneCountInString(v.Value.String()) > n {
+			return fmt.Sprintf("must be at most %d characters", n)
+		}
+		return ""
+	}
+}
+
+// DefinedEnum rejects enum numbers that are not defined, which older or
+// misbehaving clients can send.
+func DefinedEnum() Rule {
+	return func(v Value) string {
+		if v.Field.Enum().Values().ByNumber(v.Value.Enum()) == nil {
+			return fmt.Sprintf("%d is not a valid value", v.Value.Enum())
+		}
+		return ""
+	}
+}
+
+// NonNegative rejects negative numbers.
+func NonNegative() Rule {
+	return func(v Value) string {
+		if v.Value.Int() < 0 {
+			return "must not be negative"
+		}
+		return ""
+	}
+}
+
+// FractionalKey rejects non-empty strings that are not valid board positions.
+func FractionalKey() Rule {
+	return func(v Value) string {
+		key := v.Value.String()
+		if key == "" {
+			return ""
+		}
+		if err := fractional.Validate(key); err != nil {
+			return "must be a valid position"
+		}
+		return ""
+	}
+}
+
+// ValidTimestamp rejects timestamps outside the range a
+// google.protobuf.Timestamp may hold. Unset timestamps are allowed.
+func ValidTimestamp() Rule {
+	return func(v Value) string {
+		if !v.Set {
+			return ""
+		}
+		ts, ok := v.Value.Message().Interface().(*timestamppb.Timestamp)
+		if !ok {
+			return ""
+		}
+		if err := ts.CheckValid(); err != nil {
+			return "must be a valid timestamp"
+		}
+		return ""
+	}
+}
+
+// ValidDuration rejects negative durations and durations outside the range a
+// google.protobuf.Duration may hold. Unset durations are allowed.
+func ValidDuration() Rule {
+	return func(v Value) string {
+		if !v.Set {
+			return ""
+		}
+		d, ok := v.Value.Message().Interface().(*durationpb.Duration)
+		if !ok {
+			return ""
+		}
+		if err := d.CheckValid(); err != nil {
+			return "must be a valid duration"
+		}
+		if d.AsDuration() < 0 {
+			return "must not be negative"
+		}
+		return ""
+	}
+}
+
+// TimeZone rejects non-empty strings that are not IANA time zone names.
+func TimeZone() Rule {
+	return func(v Value) string {
+		tz := v.Value.String()
+		if tz == "" {
+			return ""
+		}
+		// LoadLocation also accepts "Local", which means nothing to the client.
+		if _, err := time.LoadLocation(tz); err != nil || tz == "Local" {
+			return "must be an IANA time zone"
+		}
+		return ""
+	}
+}
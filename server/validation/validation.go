@@ -0,0 +1,192 @@
+// Package validation checks API requests against declarative per-field rules
+// before they reach the service layer.
+package validation
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Value is a field being validated. Wrapper types such as
+// google.protobuf.StringValue are unwrapped, so Field and Value describe the
+// wrapped value and Set reports whether the wrapper was present.
+type Value struct {
+	Field protoreflect.FieldDescriptor
+	Value protoreflect.Value
+	Set   bool
+}
+
+// Rule checks a value and returns a description of the problem, or an empty
+// string if the value is valid.
+type Rule func(v Value) string
+
+// FieldRules are the rules for one field.
+type FieldRules struct {
+	rules []Rule
+	// masked rules only apply when the request's update_mask lists the field
+	// or the request has no update_mask.
+	masked bool
+}
+
+// Field returns rules that always apply.
+func Field(rules ...Rule) FieldRules {
+	return FieldRules{rules: rules}
+}
+
+// MaskedField returns rules that only apply to a field being updated: one
+// listed in the request's update_mask, or any field when there is no mask.
+func MaskedField(rules ...Rule) FieldRules {
+	return FieldRules{rules: rules, masked: true}
+}
+
+// MessageRules maps field paths of a message to their rules. Paths use the
+// protobuf field names, with dots to reach into nested messages.
+type MessageRules map[string]FieldRules
+
+// Validate checks msg against the rules registered for its type and returns
+// the violations found. Messages without rules are always valid.
+func Validate(msg proto.Message) []*errdetails.BadRequest_FieldViolation {
+	m := msg.ProtoReflect()
+	rules, ok := registry[m.Descriptor().FullName()]
+	if !ok {
+		return nil
+	}
+
+	mask := updateMask(m)
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, path := range sortedPaths(rules) {
+		fr := rules[path]
+		if fr.masked && mask != nil && !slices.Contains(mask, path) {
+			continue
+		}
+
+		for _, v := range resolve(m, path) {
+			for _, rule := range fr.rules {
+				if desc := rule(v); desc != "" {
+					violations = append(violations, &errdetails.BadRequest_FieldViolation{
+						Field:       path,
+						Description: desc,
+					})
+					// Report one problem per field at a time.
+					break
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+// registry holds the rules for each request message, keyed by full name.
+var registry = map[protoreflect.FullName]MessageRules{}
+
+// register adds rules for the message named name. It panics if the message or
+// any of the paths do not exist, so a typo fails at start up rather than
+// silently skipping validation.
+func register(name protoreflect.FullName, rules MessageRules) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(name)
+	if err != nil {
+		panic(fmt.Sprintf("validation: unknown message %s: %v", name, err))
+	}
+
+	for path := range rules {
+		md := mt.Descriptor()
+		parts := strings.Split(path, ".")
+		for i, part := range parts {
+			fd := md.Fields().ByName(protoreflect.Name(part))
+			if fd == nil {
+				panic(fmt.Sprintf("validation: %s has no field %s", name, path))
+			}
+			if i < len(parts)-1 {
+				if fd.Message() == nil || fd.IsList() || fd.IsMap() {
+					panic(fmt.Sprintf("validation: %s: %s is not a message field", name, path))
+				}
+				md = fd.Message()
+			}
+		}
+	}
+
+	registry[name] = rules
+}
+
+// resolve returns the values at path in m. A path through an unset message
+// resolves to an unset value, so required fields inside it are reported.
+// Lists resolve to one value per element.
+func resolve(m protoreflect.Message, path string) []Value {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(part))
+		if !m.Has(fd) {
+			last := fd.Message().Fields().ByName(protoreflect.Name(parts[len(parts)-1]))
+			if last.IsList() {
+				return nil
+			}
+			return []Value{unwrap(last, last.Default(), false)}
+		}
+		m = m.Get(fd).Message()
+	}
+
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(parts[len(parts)-1]))
+	if fd.IsList() {
+		list := m.Get(fd).List()
+		values := make([]Value, 0, list.Len())
+		for i := 0; i < list.Len(); i++ {
+			values = append(values, unwrap(fd, list.Get(i), true))
+		}
+		return values
+	}
+
+	return []Value{unwrap(fd, m.Get(fd), m.Has(fd))}
+}
+
+// unwrap replaces a wrapper message with the value it wraps.
+func unwrap(fd protoreflect.FieldDescriptor, v protoreflect.Value, set bool) Value {
+	if md := fd.Message(); md != nil && md.FullName().Parent() == "google.protobuf" && strings.HasSuffix(string(md.Name()), "Value") {
+		inner := md.Fields().ByName("value")
+		if inner != nil {
+			if set {
+				return Value{Field: inner, Value: v.Message().Get(inner), Set: true}
+			}
+			return Value{Field: inner, Value: inner.Default(), Set: false}
+		}
+	}
+	return Value{Field: fd, Value: v, Set: set}
+}
+
+// updateMask returns the paths of m's update_mask field, or nil if it has
+// none or it is empty.
+func updateMask(m protoreflect.Message) []string {
+	fd := m.Descriptor().Fields().ByName("update_mask")
+	if fd == nil || fd.Message() == nil || fd.Message().FullName() != "google.protobuf.FieldMask" || !m.Has(fd) {
+		return nil
+	}
+
+	paths := m.Get(fd).Message().Get(fd.Message().Fields().ByName("paths")).List()
+	if paths.Len() == 0 {
+		return nil
+	}
+
+	mask := make([]string, 0, paths.Len())
+	for i := 0; i < paths.Len(); i++ {
+		mask = append(mask, paths.Get(i).String())
+	}
+	return mask
+}
+
+// sortedPaths returns the paths of rules in a stable order so violations are
+// reported consistently.
+func sortedPaths(rules MessageRules) []string {
+	paths := make([]string, 0, len(rules))
+	for path := range rules {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	return paths
+}
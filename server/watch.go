@@ -0,0 +1,29 @@
+package kiseki
+
+// EventPosition is a point in a user's history of job application events.
+// Events are ordered by the transaction that stored them, then by Seq, so an
+// event committed late still comes after every position a reader has seen.
+// A position with no TxID only carries a sequence number, from before events
+// recorded their transaction.
+type EventPosition struct {
+	TxID int64
+	Seq  int64
+}
+
+// ListJobApplicationEventsParams selects the events recorded for a user after
+// a point in their history.
+type ListJobApplicationEventsParams struct {
+	UserID string
+	After  EventPosition
+	Limit  int
+}
+
+// JobApplicationEventSubscriber tells watchers when new job application
+// events have been recorded for a user.
+type JobApplicationEventSubscriber interface {
+	// Subscribe returns a channel that receives a value whenever events may
+	// have been recorded for userID, and a function that ends the
+	// subscription. Signals are coalesced, so a watcher must read every event
+	// after the last one it saw rather than count signals.
+	Subscribe(userID string) (<-chan struct{}, func())
+}
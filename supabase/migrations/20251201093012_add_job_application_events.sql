@@ -0,0 +1,70 @@
+-- Migration: add an append-only history of job application changes
+DO $$ BEGIN IF NOT EXISTS (
+    SELECT
+        1
+    FROM
+        pg_type
+    WHERE
+        typname = 'job_application_event_type'
+) THEN CREATE TYPE job_application_event_type AS ENUM (
+    'UNSPECIFIED',
+    'CREATED',
+    'UPDATED',
+    'STATUS_CHANGED',
+    'DELETED'
+);
+
+END IF;
+
+END $$;
+
+CREATE TABLE IF NOT EXISTS job_application_events (
+    id TEXT PRIMARY KEY,
+    seq BIGSERIAL NOT NULL,
+    job_application_id TEXT NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    type job_application_event_type NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_job_application_events_job_application_id ON job_application_events (job_application_id, created_at, seq);
+
+-- Events are immutable once written
+CREATE OR REPLACE FUNCTION prevent_job_application_event_update() RETURNS TRIGGER AS $$ BEGIN RAISE EXCEPTION 'job_application_events is append-only';
+
+END;
+
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER job_application_events_immutable BEFORE
+UPDATE
+    ON job_application_events FOR EACH ROW EXECUTE FUNCTION prevent_job_application_event_update();
+
+-- Enable Row Level Security
+ALTER TABLE
+    job_application_events ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON job_application_events
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own events
+CREATE POLICY "Users can select their own job application events" ON job_application_events FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only events that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own job application events" ON job_application_events FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
@@ -0,0 +1,50 @@
+-- Migration: add per-user settings
+CREATE TABLE IF NOT EXISTS user_settings (
+    user_id UUID PRIMARY KEY,
+    strict_status_transitions BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Enable Row Level Security
+ALTER TABLE
+    user_settings ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON user_settings
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own settings
+CREATE POLICY "Users can select their own settings" ON user_settings FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only rows that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own settings" ON user_settings FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to UPDATE only their own settings
+CREATE POLICY "Users can update their own settings" ON user_settings FOR
+UPDATE
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    ) WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
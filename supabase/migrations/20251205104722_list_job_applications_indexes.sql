@@ -0,0 +1,33 @@
+-- Migration: indexes backing paginated, filtered and sorted job application lists
+-- Every list is scoped to one user and excludes soft-deleted rows, so the
+-- indexes lead with user_id and are partial on deleted_at IS NULL. Each sort
+-- order ends with id to match the keyset pagination used by the server.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Board order (status, position), replacing the unscoped index
+DROP INDEX IF EXISTS idx_job_applications_status_position;
+
+CREATE INDEX idx_job_applications_user_status_position ON job_applications (user_id, status, position, id)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX idx_job_applications_user_applied_on ON job_applications (user_id, applied_on, id)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX idx_job_applications_user_created_at ON job_applications (user_id, created_at, id)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX idx_job_applications_user_updated_at ON job_applications (user_id, updated_at, id)
+WHERE
+    deleted_at IS NULL;
+
+CREATE INDEX idx_job_applications_user_company ON job_applications (user_id, lower(company), id)
+WHERE
+    deleted_at IS NULL;
+
+-- Case-insensitive substring matches on company
+CREATE INDEX idx_job_applications_company_trgm ON job_applications USING GIN (company gin_trgm_ops)
+WHERE
+    deleted_at IS NULL;
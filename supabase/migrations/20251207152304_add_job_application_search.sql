@@ -0,0 +1,13 @@
+-- Migration: full-text search over job applications
+-- Company and title carry the most weight, followed by the description and notes.
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(company, '')), 'A') || setweight(to_tsvector('english', coalesce(title, '')), 'A') || setweight(
+            to_tsvector('english', coalesce(description, '')),
+            'B'
+        ) || setweight(to_tsvector('english', coalesce(notes, '')), 'C')
+    ) STORED;
+
+CREATE INDEX idx_job_applications_search_vector ON job_applications USING GIN (search_vector);
@@ -0,0 +1,7 @@
+-- Migration: compare positions byte-wise
+-- Positions are fractional-indexing keys, which are ordered by their bytes
+-- ("Z" < "a"). Locale collations ignore case and would order them differently
+-- from the server and the frontend. Changing the collation rebuilds the
+-- indexes that include position.
+ALTER TABLE job_applications
+ALTER COLUMN position TYPE TEXT COLLATE "C";
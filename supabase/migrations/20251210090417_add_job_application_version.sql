@@ -0,0 +1,6 @@
+-- Migration: add a version to job applications for optimistic concurrency
+-- Every update increments the version and only applies if it still matches
+-- the version the client read, so concurrent edits fail instead of silently
+-- overwriting each other.
+ALTER TABLE job_applications
+ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,18 @@
+-- Migration: support restoring and purging deleted job applications
+ALTER TYPE job_application_event_type
+ADD VALUE IF NOT EXISTS 'RESTORED';
+
+-- Trash listing, most recently deleted first
+CREATE INDEX idx_job_applications_user_deleted_at ON job_applications (user_id, deleted_at, id)
+WHERE
+    deleted_at IS NOT NULL;
+
+-- Purging job applications that have been in the trash too long, and
+-- checking whether their CV is still used elsewhere
+CREATE INDEX idx_job_applications_deleted_at ON job_applications (deleted_at)
+WHERE
+    deleted_at IS NOT NULL;
+
+CREATE INDEX idx_job_applications_cv ON job_applications (cv)
+WHERE
+    cv IS NOT NULL;
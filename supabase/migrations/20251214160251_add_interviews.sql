@@ -0,0 +1,109 @@
+-- Migration: add interviews, the rounds scheduled for a job application
+DO $$ BEGIN IF NOT EXISTS (
+    SELECT
+        1
+    FROM
+        pg_type
+    WHERE
+        typname = 'interview_format'
+) THEN CREATE TYPE interview_format AS ENUM (
+    'UNSPECIFIED',
+    'PHONE',
+    'VIDEO',
+    'ONSITE'
+);
+
+END IF;
+
+END $$;
+
+DO $$ BEGIN IF NOT EXISTS (
+    SELECT
+        1
+    FROM
+        pg_type
+    WHERE
+        typname = 'interview_outcome'
+) THEN CREATE TYPE interview_outcome AS ENUM (
+    'UNSPECIFIED',
+    'PENDING',
+    'PASSED',
+    'FAILED',
+    'CANCELLED'
+);
+
+END IF;
+
+END $$;
+
+CREATE TABLE IF NOT EXISTS interviews (
+    id TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    job_application_id TEXT NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    round TEXT NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    time_zone TEXT NOT NULL DEFAULT 'UTC',
+    duration_seconds INTEGER NOT NULL DEFAULT 0,
+    interviewers TEXT[] NOT NULL DEFAULT '{}',
+    format interview_format NOT NULL DEFAULT 'UNSPECIFIED',
+    location TEXT NOT NULL DEFAULT '',
+    outcome interview_outcome NOT NULL DEFAULT 'PENDING',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_interviews_job_application_id ON interviews (job_application_id, scheduled_at, id);
+
+-- Upcoming interviews across all of a user's job applications
+CREATE INDEX idx_interviews_user_scheduled_at ON interviews (user_id, scheduled_at, id);
+
+-- Enable Row Level Security
+ALTER TABLE
+    interviews ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON interviews
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own interviews
+CREATE POLICY "Users can select their own interviews" ON interviews FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only rows that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own interviews" ON interviews FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to UPDATE only their own interviews
+CREATE POLICY "Users can update their own interviews" ON interviews FOR
+UPDATE
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    ) WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to DELETE only their own interviews
+CREATE POLICY "Users can delete their own interviews" ON interviews FOR DELETE USING (
+    (
+        SELECT
+            auth.uid ()
+    ) = user_id
+);
@@ -0,0 +1,17 @@
+-- Migration: announce new job application events so servers can push them to
+-- watching clients. The payload is the user ID; watchers read the events
+-- themselves, which keeps the payload far below the NOTIFY size limit.
+CREATE OR REPLACE FUNCTION notify_job_application_event() RETURNS TRIGGER AS $$ BEGIN PERFORM pg_notify('job_application_events', NEW.user_id::TEXT);
+
+RETURN NULL;
+
+END;
+
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER job_application_events_notify
+AFTER
+INSERT
+    ON job_application_events FOR EACH ROW EXECUTE FUNCTION notify_job_application_event();
+
+CREATE INDEX IF NOT EXISTS idx_job_application_events_user_id_seq ON job_application_events (user_id, seq);
@@ -0,0 +1,83 @@
+-- Migration: add documents, the CVs and cover letters uploaded through the
+-- server, and let job applications refer to them
+DO $$ BEGIN IF NOT EXISTS (
+    SELECT
+        1
+    FROM
+        pg_type
+    WHERE
+        typname = 'document_kind'
+) THEN CREATE TYPE document_kind AS ENUM (
+    'UNSPECIFIED',
+    'CV',
+    'COVER_LETTER'
+);
+
+END IF;
+
+END $$;
+
+CREATE TABLE IF NOT EXISTS documents (
+    id TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    kind document_kind NOT NULL DEFAULT 'UNSPECIFIED',
+    name TEXT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_documents_user_id ON documents (user_id, created_at, id);
+
+-- A document cannot be deleted while a job application refers to it
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN IF NOT EXISTS cv_document_id TEXT REFERENCES documents (id) ON DELETE RESTRICT,
+ADD
+    COLUMN IF NOT EXISTS cover_letter_document_id TEXT REFERENCES documents (id) ON DELETE RESTRICT;
+
+CREATE INDEX idx_job_applications_cv_document_id ON job_applications (cv_document_id)
+WHERE
+    cv_document_id IS NOT NULL;
+
+CREATE INDEX idx_job_applications_cover_letter_document_id ON job_applications (cover_letter_document_id)
+WHERE
+    cover_letter_document_id IS NOT NULL;
+
+-- Enable Row Level Security
+ALTER TABLE
+    documents ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON documents
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own documents
+CREATE POLICY "Users can select their own documents" ON documents FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only rows that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own documents" ON documents FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to DELETE only their own documents
+CREATE POLICY "Users can delete their own documents" ON documents FOR DELETE USING (
+    (
+        SELECT
+            auth.uid ()
+    ) = user_id
+);
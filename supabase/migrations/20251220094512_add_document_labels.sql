@@ -0,0 +1,21 @@
+-- Migration: label documents so versions of the same CV can be told apart,
+-- and let users rename their documents
+ALTER TABLE
+    documents
+ADD
+    COLUMN IF NOT EXISTS label TEXT NOT NULL DEFAULT '';
+
+-- Allow authenticated users to UPDATE only their own documents
+CREATE POLICY "Users can update their own documents" ON documents FOR
+UPDATE
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    ) WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
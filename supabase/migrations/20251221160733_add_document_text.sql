@@ -0,0 +1,15 @@
+-- Migration: keep the text extracted from documents and search it
+-- The name and label carry more weight than the text itself.
+ALTER TABLE
+    documents
+ADD
+    COLUMN IF NOT EXISTS text TEXT NOT NULL DEFAULT '';
+
+ALTER TABLE
+    documents
+ADD
+    COLUMN search_vector tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', name), 'A') || setweight(to_tsvector('english', label), 'A') || setweight(to_tsvector('english', text), 'B')
+    ) STORED;
+
+CREATE INDEX idx_documents_search_vector ON documents USING GIN (search_vector);
@@ -0,0 +1,12 @@
+-- Migration: store how well the CV of a job application matches its description
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN IF NOT EXISTS match_score SMALLINT CHECK (match_score BETWEEN 0 AND 100);
+
+-- Sorting by match score. Job applications that have not been scored count as
+-- -1, below any score: first in ascending order and last in descending order,
+-- the usual way to sort by score.
+CREATE INDEX idx_job_applications_user_match_score ON job_applications (user_id, (coalesce(match_score, -1)), id)
+WHERE
+    deleted_at IS NULL;
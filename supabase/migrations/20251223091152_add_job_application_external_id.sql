@@ -0,0 +1,10 @@
+-- Migration: remember where imported job applications came from, so importing
+-- the same rows again skips them
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN IF NOT EXISTS external_id TEXT;
+
+CREATE UNIQUE INDEX idx_job_applications_user_external_id ON job_applications (user_id, external_id)
+WHERE
+    external_id IS NOT NULL;
@@ -0,0 +1,164 @@
+-- Migration: record every status a job application enters and when, so the
+-- time spent in each stage of the pipeline can be measured
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN IF NOT EXISTS status_changed_at TIMESTAMP;
+
+-- Until now the history was the only record of status changes
+UPDATE
+    job_applications
+SET
+    status_changed_at = coalesce(
+        (
+            SELECT
+                max(e.created_at)
+            FROM
+                job_application_events e
+                CROSS JOIN jsonb_array_elements(e.changes) c
+            WHERE
+                e.job_application_id = job_applications.id
+                AND c ->> 'field' = 'status'
+        ),
+        created_at
+    );
+
+ALTER TABLE
+    job_applications
+ALTER COLUMN
+    status_changed_at
+SET
+    DEFAULT NOW();
+
+ALTER TABLE
+    job_applications
+ALTER COLUMN
+    status_changed_at
+SET
+    NOT NULL;
+
+CREATE TABLE IF NOT EXISTS job_application_status_changes (
+    id BIGSERIAL PRIMARY KEY,
+    job_application_id TEXT NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    -- NULL for the status a job application was created with
+    from_status job_application_status,
+    to_status job_application_status NOT NULL,
+    changed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_job_application_status_changes_job_application_id ON job_application_status_changes (job_application_id, changed_at, id);
+
+CREATE INDEX idx_job_application_status_changes_user_id ON job_application_status_changes (user_id);
+
+-- Carry over the status changes the history recorded
+INSERT INTO
+    job_application_status_changes (
+        job_application_id,
+        user_id,
+        from_status,
+        to_status,
+        changed_at
+    )
+SELECT
+    e.job_application_id,
+    e.user_id,
+    (c ->> 'old_value')::job_application_status,
+    (c ->> 'new_value')::job_application_status,
+    e.created_at
+FROM
+    job_application_events e
+    CROSS JOIN jsonb_array_elements(e.changes) c
+WHERE
+    c ->> 'field' = 'status'
+    AND c ->> 'new_value' IS NOT NULL
+ORDER BY
+    e.seq;
+
+-- Job applications older than the history start in their current status
+INSERT INTO
+    job_application_status_changes (job_application_id, user_id, to_status, changed_at)
+SELECT
+    id,
+    user_id,
+    status,
+    status_changed_at
+FROM
+    job_applications
+WHERE
+    NOT EXISTS (
+        SELECT
+            1
+        FROM
+            job_application_status_changes s
+        WHERE
+            s.job_application_id = job_applications.id
+    );
+
+-- Record status changes however the job application is written
+CREATE OR REPLACE FUNCTION record_job_application_status_change() RETURNS TRIGGER AS $$ BEGIN IF TG_OP = 'INSERT' THEN
+INSERT INTO
+    job_application_status_changes (job_application_id, user_id, to_status, changed_at)
+VALUES
+    (NEW.id, NEW.user_id, NEW.status, NEW.status_changed_at);
+
+ELSIF NEW.status IS DISTINCT FROM OLD.status THEN
+INSERT INTO
+    job_application_status_changes (
+        job_application_id,
+        user_id,
+        from_status,
+        to_status,
+        changed_at
+    )
+VALUES
+    (
+        NEW.id,
+        NEW.user_id,
+        OLD.status,
+        NEW.status,
+        NEW.status_changed_at
+    );
+
+END IF;
+
+RETURN NULL;
+
+END;
+
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER job_applications_record_status_change
+AFTER
+INSERT
+    OR
+UPDATE
+    OF status ON job_applications FOR EACH ROW EXECUTE FUNCTION record_job_application_status_change();
+
+-- Enable Row Level Security
+ALTER TABLE
+    job_application_status_changes ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON job_application_status_changes
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own status changes
+CREATE POLICY "Users can select their own job application status changes" ON job_application_status_changes FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow the trigger to INSERT status changes for the user's own job applications
+CREATE POLICY "Users can insert their own job application status changes" ON job_application_status_changes FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
@@ -0,0 +1,23 @@
+-- Migration: let users pick a day to follow up on a job application, and
+-- choose when quiet job applications are flagged as ghosted or rejected
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN IF NOT EXISTS follow_up_on DATE;
+
+CREATE INDEX idx_job_applications_follow_up_on ON job_applications (user_id, follow_up_on)
+WHERE
+    deleted_at IS NULL
+    AND follow_up_on IS NOT NULL;
+
+-- NULL ghosting rules mean the defaults; an empty array means none
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS ghosting_rules JSONB;
+
+-- 0 turns auto-reject off
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS auto_reject_after_days INT NOT NULL DEFAULT 0;
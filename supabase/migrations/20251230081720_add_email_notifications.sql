@@ -0,0 +1,60 @@
+-- Migration: let users opt in to a weekly digest and follow-up reminders by
+-- email, and unsubscribe from a link in them
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS email TEXT;
+
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS email_digest BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS email_reminders BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS unsubscribe_token TEXT;
+
+ALTER TABLE
+    user_settings
+ADD
+    COLUMN IF NOT EXISTS digest_sent_at TIMESTAMP;
+
+CREATE UNIQUE INDEX idx_user_settings_unsubscribe_token ON user_settings (unsubscribe_token)
+WHERE
+    unsubscribe_token IS NOT NULL;
+
+-- The follow-ups users were reminded of, so each is only emailed once
+CREATE TABLE IF NOT EXISTS follow_up_reminders (
+    job_application_id TEXT NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    reason TEXT NOT NULL,
+    due_at TIMESTAMP NOT NULL,
+    sent_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (job_application_id, reason, due_at)
+);
+
+CREATE INDEX idx_follow_up_reminders_user_id ON follow_up_reminders (user_id);
+
+-- Enable Row Level Security. Reminders are only written by the server.
+ALTER TABLE
+    follow_up_reminders ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON follow_up_reminders
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own reminders
+CREATE POLICY "Users can select their own follow-up reminders" ON follow_up_reminders FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
@@ -0,0 +1,189 @@
+-- Migration: make companies an entity of their own that job applications
+-- link to, so spellings of the same company are counted together
+DO $$ BEGIN IF NOT EXISTS (
+    SELECT
+        1
+    FROM
+        pg_type
+    WHERE
+        typname = 'company_size'
+) THEN CREATE TYPE company_size AS ENUM (
+    'UNSPECIFIED',
+    '1_10',
+    '11_50',
+    '51_200',
+    '201_1000',
+    '1001_5000',
+    '5001_PLUS'
+);
+
+END IF;
+
+END $$;
+
+-- Names that normalise the same are the same company: case, punctuation and
+-- legal suffixes are ignored, so "Google", "google" and "Google LLC" match
+CREATE OR REPLACE FUNCTION normalize_company_name(name TEXT) RETURNS TEXT LANGUAGE SQL IMMUTABLE PARALLEL SAFE AS $$
+SELECT
+    coalesce(
+        nullif(
+            regexp_replace(words, '( (inc|incorporated|llc|llp|ltd|limited|corp|corporation|co|company|gmbh|ag|sa|sas|sarl|bv|nv|plc|pty|oy|ab|spa|srl|kk))+$', ''),
+            ''
+        ),
+        words
+    )
+FROM
+    (
+        SELECT
+            btrim(regexp_replace(lower(name), '[^[:alnum:]]+', ' ', 'g')) AS words
+    ) w;
+
+$$;
+
+CREATE TABLE IF NOT EXISTS companies (
+    id TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    normalized_name TEXT GENERATED ALWAYS AS (normalize_company_name(name)) STORED,
+    website TEXT,
+    location TEXT,
+    size company_size NOT NULL DEFAULT 'UNSPECIFIED',
+    industry TEXT,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, normalized_name)
+);
+
+ALTER TABLE
+    job_applications
+ADD
+    COLUMN IF NOT EXISTS company_id TEXT REFERENCES companies (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_job_applications_company_id ON job_applications (company_id);
+
+-- Merge the company names already written into one company per user and
+-- normalised name, named after its most common spelling
+INSERT INTO
+    companies (id, user_id, name, created_at, updated_at)
+SELECT
+    gen_random_uuid ()::TEXT,
+    user_id,
+    mode() WITHIN GROUP (
+        ORDER BY
+            company
+    ),
+    min(created_at),
+    NOW()
+FROM
+    job_applications
+GROUP BY
+    user_id,
+    normalize_company_name(company) ON CONFLICT DO NOTHING;
+
+-- Link every job application to its company, under the company's name, and
+-- record the change in its history like any other update
+WITH linked AS (
+    UPDATE
+        job_applications
+    SET
+        company_id = c.id,
+        company = c.name,
+        version = job_applications.version + 1
+    FROM
+        job_applications old
+        JOIN companies c ON c.user_id = old.user_id
+        AND c.normalized_name = normalize_company_name(old.company)
+    WHERE
+        old.id = job_applications.id
+    RETURNING
+        job_applications.id,
+        job_applications.user_id,
+        old.company AS old_company,
+        c.name AS company,
+        c.id AS company_id
+)
+INSERT INTO
+    job_application_events (id, job_application_id, user_id, type, changes)
+SELECT
+    gen_random_uuid ()::TEXT,
+    id,
+    user_id,
+    'UPDATED'::job_application_event_type,
+    CASE
+        WHEN old_company IS DISTINCT FROM company THEN jsonb_build_array(
+            jsonb_build_object(
+                'field',
+                'company',
+                'old_value',
+                old_company,
+                'new_value',
+                company
+            )
+        )
+        ELSE '[]'
+    END || jsonb_build_array(
+        jsonb_build_object(
+            'field',
+            'company_id',
+            'old_value',
+            NULL,
+            'new_value',
+            company_id
+        )
+    )
+FROM
+    linked
+ORDER BY
+    id;
+
+-- Enable Row Level Security
+ALTER TABLE
+    companies ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON companies
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own companies
+CREATE POLICY "Users can select their own companies" ON companies FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only rows that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own companies" ON companies FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to UPDATE only their own companies
+CREATE POLICY "Users can update their own companies" ON companies FOR
+UPDATE
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    ) WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to DELETE only their own companies
+CREATE POLICY "Users can delete their own companies" ON companies FOR DELETE USING (
+    (
+        SELECT
+            auth.uid ()
+    ) = user_id
+);
@@ -0,0 +1,131 @@
+-- Migration: add contacts, the recruiters and hiring managers met during a
+-- job search, and the job applications they are involved in
+CREATE TABLE IF NOT EXISTS contacts (
+    id TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    role TEXT,
+    email TEXT,
+    phone TEXT,
+    linkedin_url TEXT,
+    company_id TEXT REFERENCES companies (id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_contacts_user_id ON contacts (user_id, lower(name), id);
+
+CREATE INDEX idx_contacts_company_id ON contacts (company_id);
+
+-- Links between contacts and job applications, many to many
+CREATE TABLE IF NOT EXISTS job_application_contacts (
+    contact_id TEXT NOT NULL REFERENCES contacts (id) ON DELETE CASCADE,
+    job_application_id TEXT NOT NULL REFERENCES job_applications (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (contact_id, job_application_id)
+);
+
+CREATE INDEX idx_job_application_contacts_job_application_id ON job_application_contacts (job_application_id);
+
+-- Enable Row Level Security
+ALTER TABLE
+    contacts ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON contacts
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own contacts
+CREATE POLICY "Users can select their own contacts" ON contacts FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only rows that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own contacts" ON contacts FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to UPDATE only their own contacts
+CREATE POLICY "Users can update their own contacts" ON contacts FOR
+UPDATE
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    ) WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to DELETE only their own contacts
+CREATE POLICY "Users can delete their own contacts" ON contacts FOR DELETE USING (
+    (
+        SELECT
+            auth.uid ()
+    ) = user_id
+);
+
+-- Enable Row Level Security
+ALTER TABLE
+    job_application_contacts ENABLE ROW LEVEL SECURITY;
+
+REVOKE ALL ON job_application_contacts
+FROM
+    public;
+
+-- Allow authenticated users to SELECT only their own contact links
+CREATE POLICY "Users can select their own contact links" ON job_application_contacts FOR
+SELECT
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to INSERT only rows that have user_id = auth.uid()
+CREATE POLICY "Users can insert their own contact links" ON job_application_contacts FOR
+INSERT
+    WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to UPDATE only their own contact links
+CREATE POLICY "Users can update their own contact links" ON job_application_contacts FOR
+UPDATE
+    USING (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    ) WITH CHECK (
+        (
+            SELECT
+                auth.uid ()
+        ) = user_id
+    );
+
+-- Allow authenticated users to DELETE only their own contact links
+CREATE POLICY "Users can delete their own contact links" ON job_application_contacts FOR DELETE USING (
+    (
+        SELECT
+            auth.uid ()
+    ) = user_id
+);
@@ -0,0 +1,81 @@
+-- Migration: make positions unique within a board column
+-- Placing a job application checks that its position is free before saving
+-- it, so two moves at the same time could still pick the same key. With the
+-- constraint the second save fails and the server places it again. It is
+-- deferrable so the rebalancer can pass keys between job applications within
+-- a transaction.
+
+-- Move the duplicates, all but the first job application holding each key,
+-- to the end of their column in their current order. Appending digits to the
+-- greatest key in the column gives keys after every other one; the
+-- rebalancer shortens them later.
+WITH copies AS (
+    SELECT
+        id,
+        user_id,
+        status,
+        position,
+        row_number() OVER (
+            PARTITION BY user_id,
+            status,
+            position
+            ORDER BY
+                id
+        ) AS copy
+    FROM
+        job_applications
+    WHERE
+        deleted_at IS NULL
+),
+duplicates AS (
+    SELECT
+        id,
+        user_id,
+        status,
+        row_number() OVER (
+            PARTITION BY user_id,
+            status
+            ORDER BY
+                position,
+                id
+        ) AS n
+    FROM
+        copies
+    WHERE
+        copy > 1
+),
+last_positions AS (
+    SELECT
+        user_id,
+        status,
+        max(position) AS position
+    FROM
+        job_applications
+    WHERE
+        deleted_at IS NULL
+    GROUP BY
+        user_id,
+        status
+)
+UPDATE
+    job_applications
+SET
+    position = l.position || repeat('V', d.n::INTEGER),
+    version = job_applications.version + 1
+FROM
+    duplicates d
+    JOIN last_positions l ON l.user_id = d.user_id
+    AND l.status = d.status
+WHERE
+    job_applications.id = d.id;
+
+ALTER TABLE
+    job_applications
+ADD
+    CONSTRAINT job_applications_position_key EXCLUDE USING btree (
+        user_id WITH =,
+        status WITH =,
+        position WITH =
+    )
+WHERE
+    (deleted_at IS NULL) DEFERRABLE INITIALLY IMMEDIATE;
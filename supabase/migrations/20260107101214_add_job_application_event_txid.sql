@@ -0,0 +1,13 @@
+-- Migration: record the transaction that stored each job application event
+-- Sequence numbers are taken when an event is inserted, not when its
+-- transaction commits, so a long transaction can commit an event below one a
+-- watcher has already seen. Watchers read events in transaction ID order
+-- instead, and only from transactions older than any still running.
+-- Existing events all get the ID of this migration and keep their order by
+-- sequence number.
+ALTER TABLE
+    job_application_events
+ADD
+    COLUMN IF NOT EXISTS txid xid8 NOT NULL DEFAULT pg_current_xact_id ();
+
+CREATE INDEX IF NOT EXISTS idx_job_application_events_user_id_txid ON job_application_events (user_id, txid, seq);